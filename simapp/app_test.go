@@ -9,13 +9,22 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distr "github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/mint"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 func TestSimAppExport(t *testing.T) {
 	db := dbm.NewMemDB()
-	app := NewSimApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, 0)
+	app := NewSimApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, 0, nil)
 
 	genesisState := NewDefaultGenesisState()
 	stateBytes, err := codec.MarshalJSONIndent(app.Codec(), genesisState)
@@ -31,7 +40,7 @@ func TestSimAppExport(t *testing.T) {
 	app.Commit()
 
 	// Making a new app object with the db, so that initchain hasn't been called
-	app2 := NewSimApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, 0)
+	app2 := NewSimApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, 0, nil)
 	_, _, err = app2.ExportAppStateAndValidators(false, []string{})
 	require.NoError(t, err, "ExportAppStateAndValidators should not have an error")
 }
@@ -39,7 +48,7 @@ func TestSimAppExport(t *testing.T) {
 // ensure that black listed addresses are properly set in bank keeper
 func TestBlackListedAddrs(t *testing.T) {
 	db := dbm.NewMemDB()
-	app := NewSimApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, 0)
+	app := NewSimApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, 0, nil)
 
 	for acc := range maccPerms {
 		require.Equal(t, !allowedReceivingModAcc[acc], app.BankKeeper.BlacklistedAddr(app.SupplyKeeper.GetModuleAddress(acc)))
@@ -50,3 +59,81 @@ func TestGetMaccPerms(t *testing.T) {
 	dup := GetMaccPerms()
 	require.Equal(t, maccPerms, dup, "duplicated module account permissions differed from actual module account permissions")
 }
+
+func TestUpgradeHandler(t *testing.T) {
+	app := Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 1})
+
+	// store the total supply in the legacy layout
+	total := sdk.NewCoins(sdk.NewInt64Coin("atom", 100), sdk.NewInt64Coin("btc", 21))
+	store := ctx.KVStore(app.GetKey(supply.StoreKey))
+	store.Set(supply.LegacySupplyKey, app.Codec().MustMarshalBinaryLengthPrefixed(supply.NewSupply(total)))
+
+	// store a delegation without its index by validator
+	addrs := AddTestAddrs(app, ctx, 2, sdk.ZeroInt())
+	delAddr, valAddr := addrs[0], sdk.ValAddress(addrs[1])
+	delegation := staking.NewDelegation(delAddr, valAddr, sdk.NewDec(10))
+	app.StakingKeeper.SetDelegation(ctx, delegation)
+	ctx.KVStore(app.GetKey(staking.StoreKey)).Delete(staking.GetDelegationByValIndexKey(delAddr, valAddr))
+	require.Empty(t, app.StakingKeeper.GetValidatorDelegations(ctx, valAddr))
+
+	// vote on a proposal in voting period without storing the running tallies
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, gov.NewTextProposal("title", "description"))
+	require.NoError(t, err)
+	proposal.Status = gov.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+	app.GovKeeper.InsertActiveProposalQueue(ctx, proposal.ProposalID, ctx.BlockHeader().Time)
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalID, delAddr, gov.OptionYes))
+	app.GovKeeper.DeleteValidatorTallies(ctx, proposal.ProposalID)
+	_, found := app.GovKeeper.GetValidatorTally(ctx, proposal.ProposalID, valAddr)
+	require.False(t, found)
+
+	// remove the params introduced by the upgrade
+	newParams := map[string][]string{
+		staking.ModuleName: {
+			"KeyRotationFee", "GlobalLiquidStakingCap", "ValidatorLiquidStakingCap", "MinCommissionRate",
+			"DelegationHistoryRetention", "LockTiers", "MaxCommissionChangeRate",
+		},
+		slashing.ModuleName: {"DowntimeOffenseWindow", "SlashFractionDowntimeIncrement", "DowntimeJailDurationIncrement"},
+		distr.ModuleName:    {"autocompoundinterval", "maxautocompoundsperblock"},
+		mint.ModuleName:     {"DistributionProportions", "EpochIdentifier"},
+	}
+	paramStore := ctx.KVStore(app.GetKey(params.StoreKey))
+	for subspace, keys := range newParams {
+		for _, key := range keys {
+			paramStore.Delete([]byte(subspace + "/" + key))
+		}
+	}
+
+	// remove the expedited proposal and proposal cancel ratio params introduced by the upgrade
+	depositParams := app.GovKeeper.GetDepositParams(ctx)
+	depositParams.MinExpeditedDeposit = nil
+	depositParams.ProposalCancelRatio = sdk.Dec{}
+	app.GovKeeper.SetDepositParams(ctx, depositParams)
+	app.GovKeeper.SetVotingParams(ctx, gov.VotingParams{VotingPeriod: gov.DefaultPeriod})
+	tallyParams := app.GovKeeper.GetTallyParams(ctx)
+	tallyParams.ExpeditedThreshold = sdk.Dec{}
+	app.GovKeeper.SetTallyParams(ctx, tallyParams)
+
+	require.NoError(t, app.UpgradeKeeper.ScheduleUpgrade(ctx, upgrade.Plan{Name: UpgradeName, Height: 2}))
+	ctx = ctx.WithBlockHeight(2)
+	upgrade.BeginBlocker(app.UpgradeKeeper, ctx, abci.RequestBeginBlock{})
+	require.Equal(t, int64(2), app.UpgradeKeeper.GetDoneHeight(ctx, UpgradeName))
+
+	require.Nil(t, store.Get(supply.LegacySupplyKey))
+	require.Equal(t, sdk.NewInt(21), app.SupplyKeeper.GetSupplyOf(ctx, "btc"))
+	require.Equal(t, sdk.NewInt(100), app.SupplyKeeper.GetSupplyOf(ctx, "atom"))
+
+	require.Equal(t, []staking.Delegation{delegation}, app.StakingKeeper.GetValidatorDelegations(ctx, valAddr))
+
+	valTally, found := app.GovKeeper.GetValidatorTally(ctx, proposal.ProposalID, valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(10), valTally.Yes)
+	require.Equal(t, staking.DefaultParams(), app.StakingKeeper.GetParams(ctx))
+	require.Equal(t, slashing.DefaultParams(), app.SlashingKeeper.GetParams(ctx))
+	require.Equal(t, distr.DefaultParams(), app.DistrKeeper.GetParams(ctx))
+	require.Equal(t, mint.DefaultParams(), app.MintKeeper.GetParams(ctx))
+	require.Equal(t, gov.DefaultDepositParams(), app.GovKeeper.GetDepositParams(ctx))
+	require.Equal(t, gov.DefaultVotingParams(), app.GovKeeper.GetVotingParams(ctx))
+	require.Equal(t, gov.DefaultTallyParams(), app.GovKeeper.GetTallyParams(ctx))
+}
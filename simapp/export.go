@@ -127,6 +127,10 @@ func (app *SimApp) prepForZeroHeightGenesis(ctx sdk.Context, jailWhiteList []str
 		return false
 	})
 
+	// clear the delegation change log, the heights of the changes do not carry
+	// over to the new chain
+	app.StakingKeeper.DeleteAllDelegationChanges(ctx)
+
 	// Iterate through validators by power descending, reset bond heights, and
 	// update bond intra-tx counters.
 	store := ctx.KVStore(app.keys[staking.StoreKey])
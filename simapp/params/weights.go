@@ -8,16 +8,25 @@ const (
 	DefaultWeightMsgWithdrawDelegationReward    int = 50
 	DefaultWeightMsgWithdrawValidatorCommission int = 50
 	DefaultWeightMsgFundCommunityPool           int = 50
+	DefaultWeightMsgSetAutoCompound             int = 50
+	DefaultWeightMsgSetCommissionWithdrawAddr   int = 20
+	DefaultWeightMsgSetCommissionSplits         int = 20
 	DefaultWeightMsgDeposit                     int = 100
 	DefaultWeightMsgVote                        int = 67
+	DefaultWeightMsgVoteWeighted                int = 33
+	DefaultWeightMsgCancelProposal              int = 10
 	DefaultWeightMsgUnjail                      int = 100
 	DefaultWeightMsgCreateValidator             int = 100
 	DefaultWeightMsgEditValidator               int = 5
 	DefaultWeightMsgDelegate                    int = 100
 	DefaultWeightMsgUndelegate                  int = 100
 	DefaultWeightMsgBeginRedelegate             int = 100
+	DefaultWeightMsgCancelUnbondingDelegation   int = 50
+	DefaultWeightMsgLockDelegation              int = 20
 
 	DefaultWeightCommunitySpendProposal int = 5
+	DefaultWeightFundingStreamProposal  int = 5
+	DefaultWeightCancelStreamProposal   int = 2
 	DefaultWeightTextProposal           int = 5
 	DefaultWeightParamChangeProposal    int = 5
 )
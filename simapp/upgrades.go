@@ -0,0 +1,60 @@
+package simapp
+
+import (
+	"reflect"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distr "github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/epochs"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/mint"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
+)
+
+// registerUpgrade registers the handler and the store loader of the upgrade
+// migrating the store of chains upgrading in place to this version.
+func (app *SimApp) registerUpgrade() {
+	app.UpgradeKeeper.SetUpgradeHandler(UpgradeName, func(ctx sdk.Context, _ upgrade.Plan) {
+		app.SupplyKeeper.MigrateSupplyStore(ctx)
+		app.StakingKeeper.SetDelegationsByValIndex(ctx)
+
+		// set the params introduced by this version to their default value
+		stakingParams := staking.DefaultParams()
+		setMissingParams(ctx, app.subspaces[staking.ModuleName], &stakingParams)
+		slashingParams := slashing.DefaultParams()
+		setMissingParams(ctx, app.subspaces[slashing.ModuleName], &slashingParams)
+		distrParams := distr.DefaultParams()
+		setMissingParams(ctx, app.subspaces[distr.ModuleName], &distrParams)
+		mintParams := mint.DefaultParams()
+		setMissingParams(ctx, app.subspaces[mint.ModuleName], &mintParams)
+		app.GovKeeper.MigrateParams(ctx, gov.DefaultParams())
+		app.GovKeeper.RebuildValidatorTallies(ctx)
+
+		minRate := app.StakingKeeper.MinCommissionRate(ctx)
+		if err := app.StakingKeeper.MigrateMinCommissionRate(ctx, minRate); err != nil {
+			panic(err)
+		}
+		maxChangeRate := app.StakingKeeper.MaxCommissionChangeRate(ctx)
+		if err := app.StakingKeeper.MigrateMaxCommissionChangeRate(ctx, maxChangeRate); err != nil {
+			panic(err)
+		}
+	})
+
+	app.SetStoreLoader(upgrade.UpgradeStoreLoader(
+		app.UpgradeKeeper, UpgradeName, &storetypes.StoreUpgrades{Added: []string{epochs.StoreKey}},
+	))
+}
+
+// setMissingParams stores the params of a param set which are not stored in
+// the subspace yet.
+func setMissingParams(ctx sdk.Context, subspace params.Subspace, ps params.ParamSet) {
+	for _, pair := range ps.ParamSetPairs() {
+		if !subspace.Has(ctx, pair.Key) {
+			subspace.Set(ctx, pair.Key, reflect.ValueOf(pair.Value).Elem().Interface())
+		}
+	}
+}
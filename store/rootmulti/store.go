@@ -165,6 +165,11 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 	// load each Store (note this doesn't panic on unmounted keys now)
 	var newStores = make(map[types.StoreKey]types.CommitKVStore)
 	for key, storeParams := range rs.storesParams {
+		// Stores can only be added if they were not committed before
+		if _, ok := infos[key.Name()]; ok && upgrades.IsAdded(key.Name()) {
+			return fmt.Errorf("failed to add Store %s: it already exists", key.Name())
+		}
+
 		// Load it
 		store, err := rs.loadCommitStoreFromParams(key, rs.getCommitID(infos, key.Name()), storeParams)
 		if err != nil {
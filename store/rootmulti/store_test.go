@@ -269,6 +269,38 @@ func TestMultistoreLoadWithUpgrade(t *testing.T) {
 	checkContains(t, ci.StoreInfos, []string{"store1", "restore2", "store3"})
 }
 
+func TestMultistoreLoadWithAddedStore(t *testing.T) {
+	var db dbm.DB = dbm.NewMemDB()
+	store := newMultiStoreWithMounts(db, types.PruneNothing)
+	require.Nil(t, store.LoadLatestVersion())
+	store.Commit()
+
+	// a new store can be added
+	restore := newMultiStoreWithMounts(db, types.PruneNothing)
+	restore.MountStoreWithDB(types.NewKVStoreKey("store4"), types.StoreTypeIAVL, nil)
+	require.Nil(t, restore.LoadLatestVersionAndUpgrade(&types.StoreUpgrades{Added: []string{"store4"}}))
+
+	k4, v4 := []byte("fourth"), []byte("added")
+	s4, _ := restore.getStoreByName("store4").(types.KVStore)
+	require.NotNil(t, s4)
+	s4.Set(k4, v4)
+	restore.Commit()
+
+	ci, err := getCommitInfo(db, 2)
+	require.NoError(t, err)
+	checkContains(t, ci.StoreInfos, []string{"store1", "store2", "store3", "store4"})
+
+	// but not once it exists
+	reload := newMultiStoreWithMounts(db, types.PruneNothing)
+	reload.MountStoreWithDB(types.NewKVStoreKey("store4"), types.StoreTypeIAVL, nil)
+	require.Error(t, reload.LoadLatestVersionAndUpgrade(&types.StoreUpgrades{Added: []string{"store4"}}))
+	require.Nil(t, reload.LoadLatestVersion())
+
+	s4, _ = reload.getStoreByName("store4").(types.KVStore)
+	require.NotNil(t, s4)
+	require.Equal(t, v4, s4.Get(k4))
+}
+
 func TestParsePath(t *testing.T) {
 	_, _, err := parsePath("foo")
 	require.Error(t, err)
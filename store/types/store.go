@@ -40,6 +40,7 @@ type Queryable interface {
 
 // StoreUpgrades defines a series of transformations to apply the multistore db upon load
 type StoreUpgrades struct {
+	Added   []string      `json:"added"`
 	Renamed []StoreRename `json:"renamed"`
 	Deleted []string      `json:"deleted"`
 }
@@ -52,6 +53,19 @@ type StoreRename struct {
 	NewKey string `json:"new_key"`
 }
 
+// IsAdded returns true if the given key should be added
+func (s *StoreUpgrades) IsAdded(key string) bool {
+	if s == nil {
+		return false
+	}
+	for _, added := range s.Added {
+		if key == added {
+			return true
+		}
+	}
+	return false
+}
+
 // IsDeleted returns true if the given key should be deleted
 func (s *StoreUpgrades) IsDeleted(key string) bool {
 	if s == nil {
@@ -18,8 +18,10 @@ const (
 	DefaultSendEnabled = types.DefaultSendEnabled
 
 	EventTypeTransfer      = types.EventTypeTransfer
+	EventTypeBurn          = types.EventTypeBurn
 	AttributeKeyRecipient  = types.AttributeKeyRecipient
 	AttributeKeySender     = types.AttributeKeySender
+	AttributeKeyBurner     = types.AttributeKeyBurner
 	AttributeValueCategory = types.AttributeValueCategory
 )
 
@@ -30,6 +32,7 @@ var (
 	NewBaseSendKeeper           = keeper.NewBaseSendKeeper
 	NewBaseViewKeeper           = keeper.NewBaseViewKeeper
 	NewQuerier                  = keeper.NewQuerier
+	NewMultiBankHooks           = types.NewMultiBankHooks
 	RegisterCodec               = types.RegisterCodec
 	ErrNoInputs                 = types.ErrNoInputs
 	ErrNoOutputs                = types.ErrNoOutputs
@@ -42,6 +45,7 @@ var (
 	GetGenesisStateFromAppState = types.GetGenesisStateFromAppState
 	NewMsgSend                  = types.NewMsgSend
 	NewMsgMultiSend             = types.NewMsgMultiSend
+	NewMsgBurn                  = types.NewMsgBurn
 	NewInput                    = types.NewInput
 	NewOutput                   = types.NewOutput
 	ValidateInputsOutputs       = types.ValidateInputsOutputs
@@ -65,9 +69,12 @@ type (
 	Balance                 = types.Balance
 	MsgSend                 = types.MsgSend
 	MsgMultiSend            = types.MsgMultiSend
+	MsgBurn                 = types.MsgBurn
 	Input                   = types.Input
 	Output                  = types.Output
 	QueryBalanceParams      = types.QueryBalanceParams
 	QueryAllBalancesParams  = types.QueryAllBalancesParams
 	GenesisBalancesIterator = types.GenesisBalancesIterator
+	BankHooks               = types.BankHooks
+	MultiBankHooks          = types.MultiBankHooks
 )
@@ -400,3 +400,38 @@ func TestMsgMultiSendDependent(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgBurn(t *testing.T) {
+	acc := &auth.BaseAccount{
+		Address: addr1,
+	}
+
+	genAccs := []authexported.GenesisAccount{acc}
+	app := simapp.SetupWithGenesisAccounts(genAccs)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	err := app.BankKeeper.SetBalances(ctx, addr1, coins)
+	require.NoError(t, err)
+	app.SupplyKeeper.SetSupply(ctx, supply.NewSupply(coins))
+
+	app.Commit()
+
+	burnMsg := types.NewMsgBurn(addr1, halfCoins)
+	header := abci.Header{Height: app.LastBlockHeight() + 1}
+	simapp.SignCheckDeliver(t, app.Codec(), app.BaseApp, header, []sdk.Msg{burnMsg}, []uint64{0}, []uint64{0}, true, true, priv1)
+
+	simapp.CheckBalance(t, app, addr1, halfCoins)
+
+	ctx = app.BaseApp.NewContext(true, abci.Header{})
+	require.Equal(t, halfCoins, app.SupplyKeeper.GetSupply(ctx).GetTotal())
+
+	_, broken := supply.TotalSupply(app.SupplyKeeper)(ctx)
+	require.False(t, broken)
+
+	// burning more than the remaining balance must fail
+	burnMsg = types.NewMsgBurn(addr1, coins)
+	header = abci.Header{Height: app.LastBlockHeight() + 1}
+	simapp.SignCheckDeliver(t, app.Codec(), app.BaseApp, header, []sdk.Msg{burnMsg}, []uint64{0}, []uint64{1}, false, false, priv1)
+
+	simapp.CheckBalance(t, app, addr1, halfCoins)
+}
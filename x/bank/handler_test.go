@@ -12,7 +12,7 @@ import (
 )
 
 func TestInvalidMsg(t *testing.T) {
-	h := NewHandler(nil)
+	h := NewHandler(nil, nil)
 
 	res, err := h(sdk.NewContext(nil, abci.Header{}, false, nil), sdk.NewTestMsg())
 	require.Error(t, err)
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/internal/types"
+)
+
+// Implements BankHooks interface
+var _ types.BankHooks = BaseSendKeeper{}
+
+// BeforeSend - call hook if registered
+func (k BaseSendKeeper) BeforeSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) {
+	if k.hooks != nil {
+		k.hooks.BeforeSend(ctx, fromAddr, toAddr, amt)
+	}
+}
+
+// AfterSend - call hook if registered
+func (k BaseSendKeeper) AfterSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) {
+	if k.hooks != nil {
+		k.hooks.AfterSend(ctx, fromAddr, toAddr, amt)
+	}
+}
+
+// AfterBalanceChange - call hook if registered
+func (k BaseSendKeeper) AfterBalanceChange(ctx sdk.Context, addr sdk.AccAddress, balances sdk.Coins) {
+	if k.hooks != nil {
+		k.hooks.AfterBalanceChange(ctx, addr, balances)
+	}
+}
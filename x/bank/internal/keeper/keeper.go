@@ -46,6 +46,15 @@ func NewBaseKeeper(
 	}
 }
 
+// SetHooks sets the bank hooks invoked on balance changes.
+//
+// NOTE: the hooks must be set before the keeper is passed by value to other
+// keepers, otherwise their copies will not invoke them.
+func (k *BaseKeeper) SetHooks(bh types.BankHooks) *BaseKeeper {
+	k.BaseSendKeeper.SetHooks(bh)
+	return k
+}
+
 // DelegateCoins performs delegation by deducting amt coins from an account with
 // address addr. For vesting accounts, delegations amounts are tracked for both
 // vesting and vested coins. The coins are then transferred from the delegator
@@ -62,6 +71,7 @@ func (k BaseKeeper) DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr
 	}
 
 	balances := sdk.NewCoins()
+	newBalances := make(sdk.Coins, 0, len(amt))
 
 	for _, coin := range amt {
 		balance := k.GetBalance(ctx, delegatorAddr, coin.Denom)
@@ -72,9 +82,12 @@ func (k BaseKeeper) DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr
 		}
 
 		balances = balances.Add(balance)
+		newBalances = append(newBalances, balance.Sub(coin))
 		k.SetBalance(ctx, delegatorAddr, balance.Sub(coin))
 	}
 
+	k.AfterBalanceChange(ctx, delegatorAddr, newBalances)
+
 	if err := k.trackDelegation(ctx, delegatorAddr, ctx.BlockHeader().Time, balances, amt); err != nil {
 		return sdkerrors.Wrap(err, "failed to track delegation")
 	}
@@ -153,6 +166,8 @@ type BaseSendKeeper struct {
 
 	// list of addresses that are restricted from receiving transactions
 	blacklistedAddrs map[string]bool
+
+	hooks types.BankHooks
 }
 
 func NewBaseSendKeeper(
@@ -169,6 +184,15 @@ func NewBaseSendKeeper(
 	}
 }
 
+// SetHooks sets the bank hooks invoked on balance changes.
+func (k *BaseSendKeeper) SetHooks(bh types.BankHooks) *BaseSendKeeper {
+	if k.hooks != nil {
+		panic("cannot set bank hooks twice")
+	}
+	k.hooks = bh
+	return k
+}
+
 // InputOutputCoins performs multi-send functionality. It accepts a series of
 // inputs that correspond to a series of outputs. It returns an error if the
 // inputs and outputs don't lineup or if any single transfer of tokens fails.
@@ -214,6 +238,8 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 // SendCoins transfers amt coins from a sending account to a receiving account.
 // An error is returned upon failure.
 func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	k.BeforeSend(ctx, fromAddr, toAddr, amt)
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeTransfer,
@@ -245,6 +271,8 @@ func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAd
 		k.ak.SetAccount(ctx, k.ak.NewAccountWithAddress(ctx, toAddr))
 	}
 
+	k.AfterSend(ctx, fromAddr, toAddr, amt)
+
 	return nil
 }
 
@@ -256,6 +284,7 @@ func (k BaseSendKeeper) SubtractCoins(ctx sdk.Context, addr sdk.AccAddress, amt
 	}
 
 	resultCoins := sdk.NewCoins()
+	newBalances := make(sdk.Coins, 0, len(amt))
 	lockedCoins := k.LockedCoins(ctx, addr)
 
 	for _, coin := range amt {
@@ -270,10 +299,13 @@ func (k BaseSendKeeper) SubtractCoins(ctx sdk.Context, addr sdk.AccAddress, amt
 
 		newBalance := balance.Sub(coin)
 		resultCoins = resultCoins.Add(newBalance)
+		newBalances = append(newBalances, newBalance)
 
 		k.SetBalance(ctx, addr, newBalance)
 	}
 
+	k.AfterBalanceChange(ctx, addr, newBalances)
+
 	return resultCoins, nil
 }
 
@@ -286,15 +318,19 @@ func (k BaseSendKeeper) AddCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.C
 	}
 
 	var resultCoins sdk.Coins
+	newBalances := make(sdk.Coins, 0, len(amt))
 
 	for _, coin := range amt {
 		balance := k.GetBalance(ctx, addr, coin.Denom)
 		newBalance := balance.Add(coin)
 		resultCoins = resultCoins.Add(newBalance)
+		newBalances = append(newBalances, newBalance)
 
 		k.SetBalance(ctx, addr, newBalance)
 	}
 
+	k.AfterBalanceChange(ctx, addr, newBalances)
+
 	return resultCoins, nil
 }
 
@@ -551,6 +587,7 @@ func (k BaseKeeper) trackDelegation(ctx sdk.Context, addr sdk.AccAddress, blockT
 	if ok {
 		// TODO: return error on account.TrackDelegation
 		vacc.TrackDelegation(blockTime, balance, amt)
+		k.ak.SetAccount(ctx, vacc)
 	}
 
 	return nil
@@ -566,6 +603,7 @@ func (k BaseKeeper) trackUndelegation(ctx sdk.Context, addr sdk.AccAddress, amt
 	if ok {
 		// TODO: return error on account.TrackUndelegation
 		vacc.TrackUndelegation(amt)
+		k.ak.SetAccount(ctx, vacc)
 	}
 
 	return nil
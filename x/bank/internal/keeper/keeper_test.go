@@ -13,6 +13,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	"github.com/cosmos/cosmos-sdk/x/bank/internal/keeper"
 	"github.com/cosmos/cosmos-sdk/x/bank/internal/types"
 )
 
@@ -29,6 +30,31 @@ func newBarCoin(amt int64) sdk.Coin {
 	return sdk.NewInt64Coin(barDenom, amt)
 }
 
+// mockBankHooks records every bank hook invocation
+type mockBankHooks struct {
+	beforeSends    []sdk.Coins
+	afterSends     []sdk.Coins
+	balanceChanges map[string]sdk.Coins
+}
+
+var _ types.BankHooks = (*mockBankHooks)(nil)
+
+func newMockBankHooks() *mockBankHooks {
+	return &mockBankHooks{balanceChanges: make(map[string]sdk.Coins)}
+}
+
+func (h *mockBankHooks) BeforeSend(_ sdk.Context, _, _ sdk.AccAddress, amt sdk.Coins) {
+	h.beforeSends = append(h.beforeSends, amt)
+}
+
+func (h *mockBankHooks) AfterSend(_ sdk.Context, _, _ sdk.AccAddress, amt sdk.Coins) {
+	h.afterSends = append(h.afterSends, amt)
+}
+
+func (h *mockBankHooks) AfterBalanceChange(_ sdk.Context, addr sdk.AccAddress, balances sdk.Coins) {
+	h.balanceChanges[addr.String()] = balances
+}
+
 type IntegrationTestSuite struct {
 	suite.Suite
 
@@ -679,6 +705,52 @@ func (suite *IntegrationTestSuite) TestUndelegateCoins_Invalid() {
 	suite.Require().Error(app.BankKeeper.UndelegateCoins(ctx, addrModule, addr1, delCoins))
 }
 
+func (suite *IntegrationTestSuite) TestBankHooks() {
+	app, ctx := suite.app, suite.ctx
+
+	hooks := newMockBankHooks()
+	bankKeeper := keeper.NewBaseKeeper(
+		app.Codec(), app.GetKey(types.StoreKey), app.AccountKeeper, app.ParamsKeeper.Subspace("bankhooks"), nil,
+	)
+	bankKeeper.SetHooks(hooks)
+	suite.Require().Panics(func() { bankKeeper.SetHooks(hooks) })
+
+	origCoins := sdk.NewCoins(newFooCoin(100))
+	sendCoins := sdk.NewCoins(newFooCoin(30))
+
+	addr1 := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	addrModule := sdk.AccAddress([]byte("moduleAcc"))
+
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr1))
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addrModule))
+	suite.Require().NoError(bankKeeper.SetBalances(ctx, addr1, origCoins))
+
+	// sends invoke the send hooks and a balance change for both accounts
+	suite.Require().NoError(bankKeeper.SendCoins(ctx, addr1, addr2, sendCoins))
+	suite.Require().Equal([]sdk.Coins{sendCoins}, hooks.beforeSends)
+	suite.Require().Equal([]sdk.Coins{sendCoins}, hooks.afterSends)
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(70)), hooks.balanceChanges[addr1.String()])
+	suite.Require().Equal(sendCoins, hooks.balanceChanges[addr2.String()])
+
+	// delegations and undelegations invoke balance changes for both accounts
+	suite.Require().NoError(bankKeeper.DelegateCoins(ctx, addr1, addrModule, sendCoins))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(40)), hooks.balanceChanges[addr1.String()])
+	suite.Require().Equal(sendCoins, hooks.balanceChanges[addrModule.String()])
+
+	suite.Require().NoError(bankKeeper.UndelegateCoins(ctx, addrModule, addr1, sendCoins))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(70)), hooks.balanceChanges[addr1.String()])
+	suite.Require().Equal("0foo", hooks.balanceChanges[addrModule.String()].String())
+
+	// direct balance updates, as used by supply mint and burn, invoke balance changes
+	_, err := bankKeeper.SubtractCoins(ctx, addr2, sendCoins)
+	suite.Require().NoError(err)
+	suite.Require().Equal("0foo", hooks.balanceChanges[addr2.String()].String())
+
+	suite.Require().Len(hooks.beforeSends, 1)
+	suite.Require().Len(hooks.afterSends, 1)
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
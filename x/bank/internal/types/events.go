@@ -3,9 +3,11 @@ package types
 // bank module event types
 const (
 	EventTypeTransfer = "transfer"
+	EventTypeBurn     = "burn"
 
 	AttributeKeyRecipient = "recipient"
 	AttributeKeySender    = "sender"
+	AttributeKeyBurner    = "burner"
 
 	AttributeValueCategory = ModuleName
 )
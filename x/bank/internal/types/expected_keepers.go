@@ -16,3 +16,17 @@ type AccountKeeper interface {
 
 	IterateAccounts(ctx sdk.Context, process func(exported.Account) bool)
 }
+
+// SupplyKeeper defines the supply contract that must be fulfilled when
+// handling x/bank burn messages.
+type SupplyKeeper interface {
+	BurnCoinsFromAccount(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// BankHooks event hooks for account balance changes (noalias)
+type BankHooks interface {
+	BeforeSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) // Must be called before coins are sent between accounts
+	AfterSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins)  // Must be called after coins are sent between accounts
+
+	AfterBalanceChange(ctx sdk.Context, addr sdk.AccAddress, balances sdk.Coins) // Must be called after an account balance changes, with the resulting balances of the changed denominations
+}
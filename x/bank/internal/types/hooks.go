@@ -0,0 +1,29 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// combine multiple bank hooks, all hook functions are run in array sequence
+type MultiBankHooks []BankHooks
+
+func NewMultiBankHooks(hooks ...BankHooks) MultiBankHooks {
+	return hooks
+}
+
+// nolint
+func (h MultiBankHooks) BeforeSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) {
+	for i := range h {
+		h[i].BeforeSend(ctx, fromAddr, toAddr, amt)
+	}
+}
+func (h MultiBankHooks) AfterSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) {
+	for i := range h {
+		h[i].AfterSend(ctx, fromAddr, toAddr, amt)
+	}
+}
+func (h MultiBankHooks) AfterBalanceChange(ctx sdk.Context, addr sdk.AccAddress, balances sdk.Coins) {
+	for i := range h {
+		h[i].AfterBalanceChange(ctx, addr, balances)
+	}
+}
@@ -99,6 +99,49 @@ func (msg MsgMultiSend) GetSigners() []sdk.AccAddress {
 	return addrs
 }
 
+// MsgBurn - burns coins held by the sender, reducing the total supply
+type MsgBurn struct {
+	FromAddress sdk.AccAddress `json:"from_address" yaml:"from_address"`
+	Amount      sdk.Coins      `json:"amount" yaml:"amount"`
+}
+
+var _ sdk.Msg = MsgBurn{}
+
+// NewMsgBurn - construct a msg to burn coins held by an account.
+func NewMsgBurn(fromAddr sdk.AccAddress, amount sdk.Coins) MsgBurn {
+	return MsgBurn{FromAddress: fromAddr, Amount: amount}
+}
+
+// Route Implements Msg.
+func (msg MsgBurn) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgBurn) Type() string { return "burn" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgBurn) ValidateBasic() error {
+	if msg.FromAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender address")
+	}
+	if !msg.Amount.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+	if !msg.Amount.IsAllPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgBurn) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgBurn) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
 // Input models transaction input
 type Input struct {
 	Address sdk.AccAddress `json:"address" yaml:"address"`
@@ -260,3 +260,39 @@ func TestMsgSendSigners(t *testing.T) {
 	require.Equal(t, signers, tx.Signers())
 }
 */
+
+func TestMsgBurnValidation(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("from"))
+	atom123 := sdk.NewCoins(sdk.NewInt64Coin("atom", 123))
+	atom0 := sdk.NewCoins(sdk.NewInt64Coin("atom", 0))
+
+	var emptyAddr sdk.AccAddress
+
+	cases := []struct {
+		valid bool
+		tx    MsgBurn
+	}{
+		{true, NewMsgBurn(addr1, atom123)},      // valid burn
+		{false, NewMsgBurn(addr1, atom0)},       // non positive coin
+		{false, NewMsgBurn(emptyAddr, atom123)}, // empty from addr
+	}
+
+	for _, tc := range cases {
+		err := tc.tx.ValidateBasic()
+		if tc.valid {
+			require.Nil(t, err)
+		} else {
+			require.NotNil(t, err)
+		}
+	}
+}
+
+func TestMsgBurnGetSignBytes(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("input"))
+	coins := sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
+	var msg = NewMsgBurn(addr1, coins)
+	res := msg.GetSignBytes()
+
+	expected := `{"type":"cosmos-sdk/MsgBurn","value":{"amount":[{"amount":"10","denom":"atom"}],"from_address":"cosmos1d9h8qat57ljhcm"}}`
+	require.Equal(t, expected, string(res))
+}
@@ -29,4 +29,13 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper)
 	// record the proposer for when we payout on the next block
 	consAddr := sdk.ConsAddress(req.Header.ProposerAddress)
 	k.SetPreviousProposerConsAddr(ctx, consAddr)
+
+	// pay the community pool funding streams
+	k.PayFundingStreams(ctx)
+}
+
+// EndBlocker compounds the rewards of the delegators opted in to
+// auto-compounding
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	k.AutoCompoundRewards(ctx)
 }
@@ -14,6 +14,8 @@ const (
 	RouterKey                        = types.RouterKey
 	QuerierRoute                     = types.QuerierRoute
 	ProposalTypeCommunityPoolSpend   = types.ProposalTypeCommunityPoolSpend
+	ProposalTypeFundingStream        = types.ProposalTypeFundingStream
+	ProposalTypeCancelFundingStream  = types.ProposalTypeCancelFundingStream
 	QueryParams                      = types.QueryParams
 	QueryValidatorOutstandingRewards = types.QueryValidatorOutstandingRewards
 	QueryValidatorCommission         = types.QueryValidatorCommission
@@ -23,8 +25,15 @@ const (
 	QueryDelegatorValidators         = types.QueryDelegatorValidators
 	QueryWithdrawAddr                = types.QueryWithdrawAddr
 	QueryCommunityPool               = types.QueryCommunityPool
+	QueryAutoCompound                = types.QueryAutoCompound
+	QueryCommissionWithdrawInfo      = types.QueryCommissionWithdrawInfo
+	QueryFundingStreams              = types.QueryFundingStreams
+	QueryFundingStream               = types.QueryFundingStream
 	DefaultParamspace                = types.DefaultParamspace
 	TypeMsgFundCommunityPool         = types.TypeMsgFundCommunityPool
+	TypeMsgSetAutoCompound           = types.TypeMsgSetAutoCompound
+	TypeMsgSetCommissionSplits       = types.TypeMsgSetCommissionSplits
+	MaxCommissionSplits              = types.MaxCommissionSplits
 )
 
 var (
@@ -43,6 +52,10 @@ var (
 	GetValidatorCurrentRewardsAddress          = types.GetValidatorCurrentRewardsAddress
 	GetValidatorAccumulatedCommissionAddress   = types.GetValidatorAccumulatedCommissionAddress
 	GetValidatorSlashEventAddressHeight        = types.GetValidatorSlashEventAddressHeight
+	GetAutoCompoundDelegatorAddress            = types.GetAutoCompoundDelegatorAddress
+	GetValidatorCommissionWithdrawAddrAddress  = types.GetValidatorCommissionWithdrawAddrAddress
+	GetValidatorCommissionSplitsAddress        = types.GetValidatorCommissionSplitsAddress
+	GetFundingStreamID                         = types.GetFundingStreamID
 	GetValidatorOutstandingRewardsKey          = types.GetValidatorOutstandingRewardsKey
 	GetDelegatorWithdrawAddrKey                = types.GetDelegatorWithdrawAddrKey
 	GetDelegatorStartingInfoKey                = types.GetDelegatorStartingInfoKey
@@ -53,7 +66,13 @@ var (
 	GetValidatorSlashEventPrefix               = types.GetValidatorSlashEventPrefix
 	GetValidatorSlashEventKeyPrefix            = types.GetValidatorSlashEventKeyPrefix
 	GetValidatorSlashEventKey                  = types.GetValidatorSlashEventKey
+	GetAutoCompoundDelegatorKey                = types.GetAutoCompoundDelegatorKey
+	GetValidatorCommissionWithdrawAddrKey      = types.GetValidatorCommissionWithdrawAddrKey
+	GetValidatorCommissionSplitsKey            = types.GetValidatorCommissionSplitsKey
+	GetFundingStreamKey                        = types.GetFundingStreamKey
 	HandleCommunityPoolSpendProposal           = keeper.HandleCommunityPoolSpendProposal
+	HandleFundingStreamProposal                = keeper.HandleFundingStreamProposal
+	HandleCancelFundingStreamProposal          = keeper.HandleCancelFundingStreamProposal
 	NewQuerier                                 = keeper.NewQuerier
 	MakeTestCodec                              = keeper.MakeTestCodec
 	CreateTestInputDefault                     = keeper.CreateTestInputDefault
@@ -74,6 +93,9 @@ var (
 	ErrBadDistribution                         = types.ErrBadDistribution
 	ErrInvalidProposalAmount                   = types.ErrInvalidProposalAmount
 	ErrEmptyProposalRecipient                  = types.ErrEmptyProposalRecipient
+	ErrInvalidCommissionSplits                 = types.ErrInvalidCommissionSplits
+	ErrInvalidFundingStream                    = types.ErrInvalidFundingStream
+	ErrNoFundingStream                         = types.ErrNoFundingStream
 	InitialFeePool                             = types.InitialFeePool
 	NewGenesisState                            = types.NewGenesisState
 	DefaultGenesisState                        = types.DefaultGenesisState
@@ -82,14 +104,25 @@ var (
 	NewMsgWithdrawDelegatorReward              = types.NewMsgWithdrawDelegatorReward
 	NewMsgWithdrawValidatorCommission          = types.NewMsgWithdrawValidatorCommission
 	MsgFundCommunityPool                       = types.NewMsgFundCommunityPool
+	NewMsgSetAutoCompound                      = types.NewMsgSetAutoCompound
+	NewMsgSetCommissionWithdrawAddress         = types.NewMsgSetCommissionWithdrawAddress
+	NewMsgSetCommissionSplits                  = types.NewMsgSetCommissionSplits
+	NewCommissionSplit                         = types.NewCommissionSplit
 	NewCommunityPoolSpendProposal              = types.NewCommunityPoolSpendProposal
+	NewFundingStreamProposal                   = types.NewFundingStreamProposal
+	NewCancelFundingStreamProposal             = types.NewCancelFundingStreamProposal
+	NewFundingStream                           = types.NewFundingStream
 	NewQueryValidatorOutstandingRewardsParams  = types.NewQueryValidatorOutstandingRewardsParams
 	NewQueryValidatorCommissionParams          = types.NewQueryValidatorCommissionParams
 	NewQueryValidatorSlashesParams             = types.NewQueryValidatorSlashesParams
 	NewQueryDelegationRewardsParams            = types.NewQueryDelegationRewardsParams
 	NewQueryDelegatorParams                    = types.NewQueryDelegatorParams
 	NewQueryDelegatorWithdrawAddrParams        = types.NewQueryDelegatorWithdrawAddrParams
+	NewQueryDelegatorAutoCompoundParams        = types.NewQueryDelegatorAutoCompoundParams
+	NewQueryCommissionWithdrawInfoParams       = types.NewQueryCommissionWithdrawInfoParams
+	NewQueryFundingStreamParams                = types.NewQueryFundingStreamParams
 	NewQueryDelegatorTotalRewardsResponse      = types.NewQueryDelegatorTotalRewardsResponse
+	NewQueryCommissionWithdrawInfoResponse     = types.NewQueryCommissionWithdrawInfoResponse
 	NewDelegationDelegatorReward               = types.NewDelegationDelegatorReward
 	NewValidatorHistoricalRewards              = types.NewValidatorHistoricalRewards
 	NewValidatorCurrentRewards                 = types.NewValidatorCurrentRewards
@@ -106,10 +139,16 @@ var (
 	ValidatorCurrentRewardsPrefix        = types.ValidatorCurrentRewardsPrefix
 	ValidatorAccumulatedCommissionPrefix = types.ValidatorAccumulatedCommissionPrefix
 	ValidatorSlashEventPrefix            = types.ValidatorSlashEventPrefix
+	AutoCompoundDelegatorPrefix          = types.AutoCompoundDelegatorPrefix
+	AutoCompoundCursorKey                = types.AutoCompoundCursorKey
+	ValidatorCommissionSplitsPrefix      = types.ValidatorCommissionSplitsPrefix
+	FundingStreamPrefix                  = types.FundingStreamPrefix
+	NextFundingStreamIDKey               = types.NextFundingStreamIDKey
 	ParamStoreKeyCommunityTax            = types.ParamStoreKeyCommunityTax
 	ParamStoreKeyBaseProposerReward      = types.ParamStoreKeyBaseProposerReward
 	ParamStoreKeyBonusProposerReward     = types.ParamStoreKeyBonusProposerReward
 	ParamStoreKeyWithdrawAddrEnabled     = types.ParamStoreKeyWithdrawAddrEnabled
+	ParamStoreKeyAutoCompoundInterval    = types.ParamStoreKeyAutoCompoundInterval
 	ModuleCdc                            = types.ModuleCdc
 	EventTypeSetWithdrawAddress          = types.EventTypeSetWithdrawAddress
 	EventTypeRewards                     = types.EventTypeRewards
@@ -117,10 +156,23 @@ var (
 	EventTypeWithdrawRewards             = types.EventTypeWithdrawRewards
 	EventTypeWithdrawCommission          = types.EventTypeWithdrawCommission
 	EventTypeProposerReward              = types.EventTypeProposerReward
+	EventTypeSetAutoCompound             = types.EventTypeSetAutoCompound
+	EventTypeAutoCompound                = types.EventTypeAutoCompound
+	EventTypeSetCommissionSplits         = types.EventTypeSetCommissionSplits
+	EventTypeSplitCommission             = types.EventTypeSplitCommission
+	EventTypeFundingStreamPayout         = types.EventTypeFundingStreamPayout
+	EventTypeFundingStreamFinished       = types.EventTypeFundingStreamFinished
 	AttributeKeyWithdrawAddress          = types.AttributeKeyWithdrawAddress
 	AttributeKeyValidator                = types.AttributeKeyValidator
+	AttributeKeyDelegator                = types.AttributeKeyDelegator
+	AttributeKeyEnabled                  = types.AttributeKeyEnabled
+	AttributeKeyRecipient                = types.AttributeKeyRecipient
+	AttributeKeySplits                   = types.AttributeKeySplits
+	AttributeKeyStreamID                 = types.AttributeKeyStreamID
 	AttributeValueCategory               = types.AttributeValueCategory
 	ProposalHandler                      = client.ProposalHandler
+	FundingStreamProposalHandler         = client.FundingStreamProposalHandler
+	CancelFundingStreamProposalHandler   = client.CancelFundingStreamProposalHandler
 )
 
 type (
@@ -135,19 +187,34 @@ type (
 	ValidatorCurrentRewardsRecord          = types.ValidatorCurrentRewardsRecord
 	DelegatorStartingInfoRecord            = types.DelegatorStartingInfoRecord
 	ValidatorSlashEventRecord              = types.ValidatorSlashEventRecord
+	ValidatorCommissionWithdrawInfo        = types.ValidatorCommissionWithdrawInfo
+	ValidatorCommissionSplitsRecord        = types.ValidatorCommissionSplitsRecord
 	Params                                 = types.Params
 	GenesisState                           = types.GenesisState
 	MsgSetWithdrawAddress                  = types.MsgSetWithdrawAddress
 	MsgWithdrawDelegatorReward             = types.MsgWithdrawDelegatorReward
 	MsgWithdrawValidatorCommission         = types.MsgWithdrawValidatorCommission
+	MsgSetAutoCompound                     = types.MsgSetAutoCompound
+	MsgSetCommissionWithdrawAddress        = types.MsgSetCommissionWithdrawAddress
+	MsgSetCommissionSplits                 = types.MsgSetCommissionSplits
+	CommissionSplit                        = types.CommissionSplit
+	CommissionSplits                       = types.CommissionSplits
 	CommunityPoolSpendProposal             = types.CommunityPoolSpendProposal
+	FundingStreamProposal                  = types.FundingStreamProposal
+	CancelFundingStreamProposal            = types.CancelFundingStreamProposal
+	FundingStream                          = types.FundingStream
+	FundingStreams                         = types.FundingStreams
 	QueryValidatorOutstandingRewardsParams = types.QueryValidatorOutstandingRewardsParams
 	QueryValidatorCommissionParams         = types.QueryValidatorCommissionParams
 	QueryValidatorSlashesParams            = types.QueryValidatorSlashesParams
 	QueryDelegationRewardsParams           = types.QueryDelegationRewardsParams
 	QueryDelegatorParams                   = types.QueryDelegatorParams
 	QueryDelegatorWithdrawAddrParams       = types.QueryDelegatorWithdrawAddrParams
+	QueryDelegatorAutoCompoundParams       = types.QueryDelegatorAutoCompoundParams
+	QueryCommissionWithdrawInfoParams      = types.QueryCommissionWithdrawInfoParams
+	QueryFundingStreamParams               = types.QueryFundingStreamParams
 	QueryDelegatorTotalRewardsResponse     = types.QueryDelegatorTotalRewardsResponse
+	QueryCommissionWithdrawInfoResponse    = types.QueryCommissionWithdrawInfoResponse
 	DelegationDelegatorReward              = types.DelegationDelegatorReward
 	ValidatorHistoricalRewards             = types.ValidatorHistoricalRewards
 	ValidatorCurrentRewards                = types.ValidatorCurrentRewards
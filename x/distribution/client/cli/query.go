@@ -34,6 +34,10 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdQueryValidatorSlashes(queryRoute, cdc),
 		GetCmdQueryDelegatorRewards(queryRoute, cdc),
 		GetCmdQueryCommunityPool(queryRoute, cdc),
+		GetCmdQueryAutoCompound(queryRoute, cdc),
+		GetCmdQueryCommissionWithdrawInfo(queryRoute, cdc),
+		GetCmdQueryFundingStreams(queryRoute, cdc),
+		GetCmdQueryFundingStream(queryRoute, cdc),
 	)...)
 
 	return distQueryCmd
@@ -289,3 +293,171 @@ $ %s query distribution community-pool
 		},
 	}
 }
+
+// GetCmdQueryAutoCompound returns the command for fetching whether a delegator
+// is opted in to auto-compounding
+func GetCmdQueryAutoCompound(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "auto-compound [delegator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query whether the staking rewards of a delegator are auto-compounded",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query whether a delegator is opted in to the auto-compounding of its staking rewards.
+
+Example:
+$ %s query distribution auto-compound cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			delAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryDelegatorAutoCompoundParams(delAddr))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryAutoCompound)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var enabled bool
+			cdc.MustUnmarshalJSON(res, &enabled)
+			return cliCtx.PrintOutput(enabled)
+		},
+	}
+}
+
+// GetCmdQueryCommissionWithdrawInfo returns the command for fetching where the
+// commission of a validator is withdrawn to
+func GetCmdQueryCommissionWithdrawInfo(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "commission-withdraw-info [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the commission withdraw address and splits of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the address the commission of a validator is withdrawn to and the splits
+of its commission among other addresses.
+
+Example:
+$ %s query distribution commission-withdraw-info cosmosvaloper1lwjmdnks33xwnmfayc64ycprww49n33mtm92ne
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			validatorAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryCommissionWithdrawInfoParams(validatorAddr))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryCommissionWithdrawInfo)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var info types.QueryCommissionWithdrawInfoResponse
+			if err := cdc.UnmarshalJSON(res, &info); err != nil {
+				return fmt.Errorf("failed to unmarshal response: %w", err)
+			}
+
+			return cliCtx.PrintOutput(info)
+		},
+	}
+}
+
+// GetCmdQueryFundingStreams returns the command for fetching all the community
+// pool funding streams
+func GetCmdQueryFundingStreams(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "funding-streams",
+		Args:  cobra.NoArgs,
+		Short: "Query all the community pool funding streams",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the streams paying recipients from the community pool every block.
+
+Example:
+$ %s query distribution funding-streams
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryFundingStreams)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var streams types.FundingStreams
+			if err := cdc.UnmarshalJSON(res, &streams); err != nil {
+				return fmt.Errorf("failed to unmarshal response: %w", err)
+			}
+
+			return cliCtx.PrintOutput(streams)
+		},
+	}
+}
+
+// GetCmdQueryFundingStream returns the command for fetching a community pool
+// funding stream
+func GetCmdQueryFundingStream(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "funding-stream [stream-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a community pool funding stream",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a stream paying a recipient from the community pool every block.
+
+Example:
+$ %s query distribution funding-stream 1
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			streamID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("stream-id %s not a valid uint: %w", args[0], err)
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryFundingStreamParams(streamID))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryFundingStream)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var stream types.FundingStream
+			if err := cdc.UnmarshalJSON(res, &stream); err != nil {
+				return fmt.Errorf("failed to unmarshal response: %w", err)
+			}
+
+			return cliCtx.PrintOutput(stream)
+		},
+	}
+}
@@ -4,6 +4,7 @@ package cli
 import (
 	"bufio"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -49,6 +50,9 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 		GetCmdSetWithdrawAddr(cdc),
 		GetCmdWithdrawAllRewards(cdc, storeKey),
 		GetCmdFundCommunityPool(cdc),
+		GetCmdSetAutoCompound(cdc),
+		GetCmdSetCommissionWithdrawAddr(cdc),
+		GetCmdSetCommissionSplits(cdc),
 	)...)
 
 	return distTxCmd
@@ -262,6 +266,136 @@ Where proposal.json contains:
 	return cmd
 }
 
+// GetCmdSubmitFundingStreamProposal implements the command to submit a
+// community-pool-funding-stream proposal
+func GetCmdSubmitFundingStreamProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "community-pool-funding-stream [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a community pool funding stream proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to pay a recipient a fixed amount from the community pool every
+block until the total amount has been paid or the end time is reached, along with an initial
+deposit. At least one of the total amount and the end time must be set. The proposal details
+must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal community-pool-funding-stream <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Community Pool Funding Stream",
+  "description": "Pay me some Atoms every block!",
+  "recipient": "cosmos1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq",
+  "amount_per_block": [
+    {
+      "denom": "stake",
+      "amount": "10"
+    }
+  ],
+  "total_amount": [
+    {
+      "denom": "stake",
+      "amount": "100000"
+    }
+  ],
+  "end_time": "2021-01-01T00:00:00Z",
+  "deposit": [
+    {
+      "denom": "stake",
+      "amount": "10000"
+    }
+  ]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			proposal, err := ParseFundingStreamProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewFundingStreamProposal(
+				proposal.Title, proposal.Description, proposal.Recipient,
+				proposal.AmountPerBlock, proposal.TotalAmount, proposal.EndTime,
+			)
+
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdSubmitCancelFundingStreamProposal implements the command to submit a
+// cancel-funding-stream proposal
+func GetCmdSubmitCancelFundingStreamProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-funding-stream [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to cancel a community pool funding stream",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to cancel a community pool funding stream along with an initial
+deposit. The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal cancel-funding-stream <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Cancel Funding Stream",
+  "description": "Stop paying me Atoms",
+  "stream_id": "1",
+  "deposit": [
+    {
+      "denom": "stake",
+      "amount": "10000"
+    }
+  ]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			proposal, err := ParseCancelFundingStreamProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewCancelFundingStreamProposal(proposal.Title, proposal.Description, proposal.StreamID)
+
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
 // GetCmdFundCommunityPool returns a command implementation that supports directly
 // funding the community pool.
 func GetCmdFundCommunityPool(cdc *codec.Codec) *cobra.Command {
@@ -298,3 +432,124 @@ $ %s tx distribution fund-community-pool 100uatom --from mykey
 		},
 	}
 }
+
+// GetCmdSetAutoCompound returns a command implementation that opts a delegator
+// in or out of auto-compounding its staking rewards.
+func GetCmdSetAutoCompound(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-auto-compound [enabled]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Enable or disable the auto-compounding of staking rewards",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Enable or disable the auto-compounding of the staking rewards of a delegator.
+When enabled, the rewards of all the delegations are periodically withdrawn and
+the bond denom part of them is delegated back to the same validators. Rewards are
+only compounded while the delegator is its own withdraw address.
+
+Example:
+$ %s tx distribution set-auto-compound true --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			enabled, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAutoCompound(cliCtx.GetFromAddress(), enabled)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdSetCommissionWithdrawAddr returns a command implementation that changes
+// the address the commission of a validator is withdrawn to.
+func GetCmdSetCommissionWithdrawAddr(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-commission-withdraw-addr [withdraw-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Change the address the commission of a validator is withdrawn to",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the address the commission of a validator is withdrawn to. The part of the
+commission which is not split by the commission splits of the validator is sent to this
+address, which defaults to the withdraw address of the operator.
+
+Example:
+$ %s tx distribution set-commission-withdraw-addr cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			valAddr := sdk.ValAddress(cliCtx.GetFromAddress())
+			withdrawAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetCommissionWithdrawAddress(valAddr, withdrawAddr)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdSetCommissionSplits returns a command implementation that splits the
+// commission of a validator among several addresses by fixed ratios.
+func GetCmdSetCommissionSplits(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-commission-splits [address:ratio,...]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Split the commission of a validator among several addresses by fixed ratios",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Split the withdrawn commission of a validator among several addresses by fixed
+ratios summing up to at most one. The rest of the commission is sent to the commission
+withdraw address of the validator. Omitting the splits removes them.
+
+Example:
+$ %s tx distribution set-commission-splits cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p:0.3,cosmos1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq:0.2 --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			var splitsStr string
+			if len(args) > 0 {
+				splitsStr = args[0]
+			}
+
+			splits, err := ParseCommissionSplits(splitsStr)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetCommissionSplits(sdk.ValAddress(cliCtx.GetFromAddress()), splits)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
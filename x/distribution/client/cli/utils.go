@@ -1,10 +1,14 @@
 package cli
 
 import (
+	"fmt"
 	"io/ioutil"
+	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
 type (
@@ -16,6 +20,25 @@ type (
 		Amount      sdk.Coins      `json:"amount" yaml:"amount"`
 		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
 	}
+
+	// FundingStreamProposalJSON defines a FundingStreamProposal with a deposit
+	FundingStreamProposalJSON struct {
+		Title          string         `json:"title" yaml:"title"`
+		Description    string         `json:"description" yaml:"description"`
+		Recipient      sdk.AccAddress `json:"recipient" yaml:"recipient"`
+		AmountPerBlock sdk.Coins      `json:"amount_per_block" yaml:"amount_per_block"`
+		TotalAmount    sdk.Coins      `json:"total_amount" yaml:"total_amount"`
+		EndTime        time.Time      `json:"end_time" yaml:"end_time"`
+		Deposit        sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+
+	// CancelFundingStreamProposalJSON defines a CancelFundingStreamProposal with a deposit
+	CancelFundingStreamProposalJSON struct {
+		Title       string    `json:"title" yaml:"title"`
+		Description string    `json:"description" yaml:"description"`
+		StreamID    uint64    `json:"stream_id" yaml:"stream_id"`
+		Deposit     sdk.Coins `json:"deposit" yaml:"deposit"`
+	}
 )
 
 // ParseCommunityPoolSpendProposalJSON reads and parses a CommunityPoolSpendProposalJSON from a file.
@@ -33,3 +56,67 @@ func ParseCommunityPoolSpendProposalJSON(cdc *codec.Codec, proposalFile string)
 
 	return proposal, nil
 }
+
+// ParseFundingStreamProposalJSON reads and parses a FundingStreamProposalJSON from a file.
+func ParseFundingStreamProposalJSON(cdc *codec.Codec, proposalFile string) (FundingStreamProposalJSON, error) {
+	proposal := FundingStreamProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParseCancelFundingStreamProposalJSON reads and parses a CancelFundingStreamProposalJSON from a file.
+func ParseCancelFundingStreamProposalJSON(cdc *codec.Codec, proposalFile string) (CancelFundingStreamProposalJSON, error) {
+	proposal := CancelFundingStreamProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParseCommissionSplits parses commission splits from a comma separated list
+// of address:ratio pairs.
+func ParseCommissionSplits(splitsStr string) (types.CommissionSplits, error) {
+	splitsStr = strings.TrimSpace(splitsStr)
+	if len(splitsStr) == 0 {
+		return types.CommissionSplits{}, nil
+	}
+
+	pairs := strings.Split(splitsStr, ",")
+	splits := make(types.CommissionSplits, len(pairs))
+	for i, pair := range pairs {
+		parts := strings.Split(strings.TrimSpace(pair), ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid commission split, expected address:ratio: %s", pair)
+		}
+
+		addr, err := sdk.AccAddressFromBech32(parts[0])
+		if err != nil {
+			return nil, err
+		}
+
+		ratio, err := sdk.NewDecFromStr(parts[1])
+		if err != nil {
+			return nil, err
+		}
+
+		splits[i] = types.NewCommissionSplit(addr, ratio)
+	}
+
+	return splits, nil
+}
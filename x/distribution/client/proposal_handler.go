@@ -9,4 +9,11 @@ import (
 // param change proposal handler
 var (
 	ProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitProposal, rest.ProposalRESTHandler)
+
+	FundingStreamProposalHandler = govclient.NewProposalHandler(
+		cli.GetCmdSubmitFundingStreamProposal, rest.FundingStreamProposalRESTHandler,
+	)
+	CancelFundingStreamProposalHandler = govclient.NewProposalHandler(
+		cli.GetCmdSubmitCancelFundingStreamProposal, rest.CancelFundingStreamProposalRESTHandler,
+	)
 )
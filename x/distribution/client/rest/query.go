@@ -33,6 +33,12 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, queryRoute st
 		delegatorWithdrawalAddrHandlerFn(cliCtx, queryRoute),
 	).Methods("GET")
 
+	// Get whether the rewards are auto-compounded
+	r.HandleFunc(
+		"/distribution/delegators/{delegatorAddr}/auto_compound",
+		delegatorAutoCompoundHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
 	// Validator distribution information
 	r.HandleFunc(
 		"/distribution/validators/{validatorAddr}",
@@ -45,6 +51,12 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, queryRoute st
 		validatorRewardsHandlerFn(cliCtx, queryRoute),
 	).Methods("GET")
 
+	// Commission withdraw address and splits of a single validator
+	r.HandleFunc(
+		"/distribution/validators/{validatorAddr}/commission_withdraw_info",
+		commissionWithdrawInfoHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
 	// Outstanding rewards of a single validator
 	r.HandleFunc(
 		"/distribution/validators/{validatorAddr}/outstanding_rewards",
@@ -63,6 +75,18 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, queryRoute st
 		communityPoolHandler(cliCtx, queryRoute),
 	).Methods("GET")
 
+	// Get the community pool funding streams
+	r.HandleFunc(
+		"/distribution/funding_streams",
+		fundingStreamsHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
+	// Get a single community pool funding stream
+	r.HandleFunc(
+		"/distribution/funding_streams/{streamID}",
+		fundingStreamHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
 }
 
 // HTTP request handler to query the total rewards balance from all delegations
@@ -144,6 +168,58 @@ func delegatorWithdrawalAddrHandlerFn(cliCtx context.CLIContext, queryRoute stri
 	}
 }
 
+// HTTP request handler to query whether a delegator is opted in to
+// auto-compounding
+func delegatorAutoCompoundHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		delegatorAddr, ok := checkDelegatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		cliCtx, ok = rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		bz := cliCtx.Codec.MustMarshalJSON(types.NewQueryDelegatorAutoCompoundParams(delegatorAddr))
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryAutoCompound), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// HTTP request handler to query the commission withdraw address and splits of
+// a validator
+func commissionWithdrawInfoHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		validatorAddr, ok := checkValidatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		cliCtx, ok = rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		bz := cliCtx.Codec.MustMarshalJSON(types.NewQueryCommissionWithdrawInfoParams(validatorAddr))
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryCommissionWithdrawInfo), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 // ValidatorDistInfo defines the properties of
 // validator distribution information response.
 type ValidatorDistInfo struct {
@@ -318,3 +394,47 @@ func checkResponseQueryDelegationRewards(
 
 	return res, height, true
 }
+
+// HTTP request handler to query the community pool funding streams
+func fundingStreamsHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryFundingStreams), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// HTTP request handler to query a community pool funding stream
+func fundingStreamHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		streamID, ok := rest.ParseUint64OrReturnBadRequest(w, mux.Vars(r)["streamID"])
+		if !ok {
+			return
+		}
+
+		cliCtx, ok = rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		bz := cliCtx.Codec.MustMarshalJSON(types.NewQueryFundingStreamParams(streamID))
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryFundingStream), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
@@ -51,3 +51,71 @@ func postProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		authclient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+// FundingStreamProposalRESTHandler returns a ProposalRESTHandler that exposes the community pool funding stream REST
+// handler with a given sub-route.
+func FundingStreamProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "community_pool_funding_stream",
+		Handler:  postFundingStreamProposalHandlerFn(cliCtx),
+	}
+}
+
+// CancelFundingStreamProposalRESTHandler returns a ProposalRESTHandler that exposes the cancel funding stream REST
+// handler with a given sub-route.
+func CancelFundingStreamProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "cancel_funding_stream",
+		Handler:  postCancelFundingStreamProposalHandlerFn(cliCtx),
+	}
+}
+
+func postFundingStreamProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req FundingStreamProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewFundingStreamProposal(
+			req.Title, req.Description, req.Recipient, req.AmountPerBlock, req.TotalAmount, req.EndTime,
+		)
+
+		msg := gov.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		authclient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func postCancelFundingStreamProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CancelFundingStreamProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewCancelFundingStreamProposal(req.Title, req.Description, req.StreamID)
+
+		msg := gov.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		authclient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
@@ -33,12 +33,30 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router, queryRoute strin
 		setDelegatorWithdrawalAddrHandlerFn(cliCtx),
 	).Methods("POST")
 
+	// Enable or disable the auto-compounding of the rewards
+	r.HandleFunc(
+		"/distribution/delegators/{delegatorAddr}/auto_compound",
+		setDelegatorAutoCompoundHandlerFn(cliCtx),
+	).Methods("POST")
+
 	// Withdraw validator rewards and commission
 	r.HandleFunc(
 		"/distribution/validators/{validatorAddr}/rewards",
 		withdrawValidatorRewardsHandlerFn(cliCtx),
 	).Methods("POST")
 
+	// Replace the commission withdrawal address
+	r.HandleFunc(
+		"/distribution/validators/{validatorAddr}/commission_withdraw_address",
+		setCommissionWithdrawalAddrHandlerFn(cliCtx),
+	).Methods("POST")
+
+	// Replace the commission splits
+	r.HandleFunc(
+		"/distribution/validators/{validatorAddr}/commission_splits",
+		setCommissionSplitsHandlerFn(cliCtx),
+	).Methods("POST")
+
 	// Fund the community pool
 	r.HandleFunc(
 		"/distribution/community_pool",
@@ -61,6 +79,16 @@ type (
 		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
 		Amount  sdk.Coins    `json:"amount" yaml:"amount"`
 	}
+
+	setAutoCompoundReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+		Enabled bool         `json:"enabled" yaml:"enabled"`
+	}
+
+	setCommissionSplitsReq struct {
+		BaseReq rest.BaseReq           `json:"base_req" yaml:"base_req"`
+		Splits  types.CommissionSplits `json:"splits" yaml:"splits"`
+	}
 )
 
 // Withdraw delegator rewards
@@ -157,6 +185,36 @@ func setDelegatorWithdrawalAddrHandlerFn(cliCtx context.CLIContext) http.Handler
 	}
 }
 
+// Enable or disable the auto-compounding of the delegator rewards
+func setDelegatorAutoCompoundHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req setAutoCompoundReq
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		// read and validate URL's variables
+		delAddr, ok := checkDelegatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		msg := types.NewMsgSetAutoCompound(delAddr, req.Enabled)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		authclient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
 // Withdraw validator rewards and commission
 func withdrawValidatorRewardsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -188,6 +246,66 @@ func withdrawValidatorRewardsHandlerFn(cliCtx context.CLIContext) http.HandlerFu
 	}
 }
 
+// Replace the commission withdrawal address
+func setCommissionWithdrawalAddrHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req setWithdrawalAddrReq
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		// read and validate URL's variable
+		valAddr, ok := checkValidatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		msg := types.NewMsgSetCommissionWithdrawAddress(valAddr, req.WithdrawAddress)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		authclient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+// Replace the commission splits
+func setCommissionSplitsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req setCommissionSplitsReq
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		// read and validate URL's variable
+		valAddr, ok := checkValidatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		msg := types.NewMsgSetCommissionSplits(valAddr, req.Splits)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		authclient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
 func fundCommunityPoolHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var req fundCommunityPoolReq
@@ -1,6 +1,8 @@
 package rest
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 )
@@ -17,4 +19,29 @@ type (
 		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
 		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
 	}
+
+	// FundingStreamProposalReq defines a community pool funding stream proposal request body.
+	FundingStreamProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title          string         `json:"title" yaml:"title"`
+		Description    string         `json:"description" yaml:"description"`
+		Recipient      sdk.AccAddress `json:"recipient" yaml:"recipient"`
+		AmountPerBlock sdk.Coins      `json:"amount_per_block" yaml:"amount_per_block"`
+		TotalAmount    sdk.Coins      `json:"total_amount" yaml:"total_amount"`
+		EndTime        time.Time      `json:"end_time" yaml:"end_time"`
+		Proposer       sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit        sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+
+	// CancelFundingStreamProposalReq defines a cancel funding stream proposal request body.
+	CancelFundingStreamProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		StreamID    uint64         `json:"stream_id" yaml:"stream_id"`
+		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
 )
@@ -37,6 +37,22 @@ func InitGenesis(ctx sdk.Context, bk types.BankKeeper, supplyKeeper types.Supply
 	for _, evt := range data.ValidatorSlashEvents {
 		keeper.SetValidatorSlashEvent(ctx, evt.ValidatorAddress, evt.Height, evt.Period, evt.Event)
 	}
+	for _, del := range data.AutoCompoundDelegators {
+		keeper.SetDelegatorAutoCompound(ctx, del, true)
+	}
+	if !data.AutoCompoundCursor.Empty() {
+		keeper.SetAutoCompoundCursor(ctx, data.AutoCompoundCursor)
+	}
+	for _, info := range data.CommissionWithdrawInfos {
+		keeper.SetValidatorCommissionWithdrawAddr(ctx, info.ValidatorAddress, info.WithdrawAddress)
+	}
+	for _, record := range data.CommissionSplits {
+		keeper.SetValidatorCommissionSplits(ctx, record.ValidatorAddress, record.Splits)
+	}
+	for _, stream := range data.FundingStreams {
+		keeper.SetFundingStream(ctx, stream)
+	}
+	keeper.SetNextFundingStreamID(ctx, data.NextFundingStreamID)
 
 	moduleHoldings = moduleHoldings.Add(data.FeePool.CommunityPool...)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
@@ -140,5 +156,40 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 		},
 	)
 
-	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	autoCompounds := make([]sdk.AccAddress, 0)
+	keeper.IterateAutoCompoundDelegators(ctx, func(del sdk.AccAddress) (stop bool) {
+		autoCompounds = append(autoCompounds, del)
+		return false
+	})
+
+	cursor, _ := keeper.GetAutoCompoundCursor(ctx)
+
+	cwi := make([]types.ValidatorCommissionWithdrawInfo, 0)
+	keeper.IterateValidatorCommissionWithdrawAddrs(ctx, func(val sdk.ValAddress, addr sdk.AccAddress) (stop bool) {
+		cwi = append(cwi, types.ValidatorCommissionWithdrawInfo{
+			ValidatorAddress: val,
+			WithdrawAddress:  addr,
+		})
+		return false
+	})
+
+	splits := make([]types.ValidatorCommissionSplitsRecord, 0)
+	keeper.IterateValidatorCommissionSplits(ctx, func(val sdk.ValAddress, valSplits types.CommissionSplits) (stop bool) {
+		splits = append(splits, types.ValidatorCommissionSplitsRecord{
+			ValidatorAddress: val,
+			Splits:           valSplits,
+		})
+		return false
+	})
+
+	streams := keeper.GetAllFundingStreams(ctx)
+	if streams == nil {
+		streams = types.FundingStreams{}
+	}
+	nextStreamID := keeper.GetNextFundingStreamID(ctx)
+
+	return types.NewGenesisState(
+		params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes, autoCompounds, cursor, cwi, splits,
+		streams, nextStreamID,
+	)
 }
@@ -1,6 +1,8 @@
 package distribution
 
 import (
+	"strconv"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
@@ -25,6 +27,15 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case types.MsgFundCommunityPool:
 			return handleMsgFundCommunityPool(ctx, msg, k)
 
+		case types.MsgSetAutoCompound:
+			return handleMsgSetAutoCompound(ctx, msg, k)
+
+		case types.MsgSetCommissionWithdrawAddress:
+			return handleMsgSetCommissionWithdrawAddress(ctx, msg, k)
+
+		case types.MsgSetCommissionSplits:
+			return handleMsgSetCommissionSplits(ctx, msg, k)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized distribution message type: %T", msg)
 		}
@@ -100,12 +111,68 @@ func handleMsgFundCommunityPool(ctx sdk.Context, msg types.MsgFundCommunityPool,
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
 
+func handleMsgSetAutoCompound(ctx sdk.Context, msg types.MsgSetAutoCompound, k keeper.Keeper) (*sdk.Result, error) {
+	k.SetDelegatorAutoCompound(ctx, msg.DelegatorAddress, msg.Enabled)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetAutoCompound,
+			sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(msg.Enabled)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgSetCommissionWithdrawAddress(ctx sdk.Context, msg types.MsgSetCommissionWithdrawAddress, k keeper.Keeper) (*sdk.Result, error) {
+	if err := k.SetCommissionWithdrawAddr(ctx, msg.ValidatorAddress, msg.WithdrawAddress); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.ValidatorAddress.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgSetCommissionSplits(ctx sdk.Context, msg types.MsgSetCommissionSplits, k keeper.Keeper) (*sdk.Result, error) {
+	if err := k.SetCommissionSplits(ctx, msg.ValidatorAddress, msg.Splits); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.ValidatorAddress.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
 func NewCommunityPoolSpendProposalHandler(k Keeper) govtypes.Handler {
 	return func(ctx sdk.Context, content govtypes.Content) error {
 		switch c := content.(type) {
 		case types.CommunityPoolSpendProposal:
 			return keeper.HandleCommunityPoolSpendProposal(ctx, k, c)
 
+		case types.FundingStreamProposal:
+			return keeper.HandleFundingStreamProposal(ctx, k, c)
+
+		case types.CancelFundingStreamProposal:
+			return keeper.HandleCancelFundingStreamProposal(ctx, k, c)
+
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized distr proposal content type: %T", c)
 		}
@@ -0,0 +1,178 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/exported"
+)
+
+// check whether a delegator is opted in to auto-compounding
+func (k Keeper) GetDelegatorAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetAutoCompoundDelegatorKey(delAddr))
+}
+
+// set whether a delegator is opted in to auto-compounding
+func (k Keeper) SetDelegatorAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress, enabled bool) {
+	store := ctx.KVStore(k.storeKey)
+	if !enabled {
+		store.Delete(types.GetAutoCompoundDelegatorKey(delAddr))
+		return
+	}
+	store.Set(types.GetAutoCompoundDelegatorKey(delAddr), []byte{})
+}
+
+// iterate over the delegators opted in to auto-compounding
+func (k Keeper) IterateAutoCompoundDelegators(ctx sdk.Context, handler func(del sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.AutoCompoundDelegatorPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		del := types.GetAutoCompoundDelegatorAddress(iter.Key())
+		if handler(del) {
+			break
+		}
+	}
+}
+
+// get the last delegator compounded in the current auto-compounding round,
+// found is false if no round is in progress
+func (k Keeper) GetAutoCompoundCursor(ctx sdk.Context) (delAddr sdk.AccAddress, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.AutoCompoundCursorKey)
+	if b == nil {
+		return nil, false
+	}
+	return sdk.AccAddress(b), true
+}
+
+// set the last delegator compounded in the current auto-compounding round
+func (k Keeper) SetAutoCompoundCursor(ctx sdk.Context, delAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.AutoCompoundCursorKey, delAddr.Bytes())
+}
+
+// delete the auto-compounding cursor, ending the current round
+func (k Keeper) DeleteAutoCompoundCursor(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.AutoCompoundCursorKey)
+}
+
+// AutoCompoundRewards compounds the rewards of the delegators opted in to
+// auto-compounding. A round over all of them starts every auto-compound
+// interval and compounds at most the maximum number of delegators per block,
+// the round then carries on in the following blocks until it is complete.
+func (k Keeper) AutoCompoundRewards(ctx sdk.Context) {
+	interval := k.GetAutoCompoundInterval(ctx)
+	if interval == 0 {
+		k.DeleteAutoCompoundCursor(ctx)
+		return
+	}
+
+	cursor, inRound := k.GetAutoCompoundCursor(ctx)
+	if !inRound && uint64(ctx.BlockHeight())%interval != 0 {
+		return
+	}
+
+	start := types.AutoCompoundDelegatorPrefix
+	if inRound {
+		start = types.GetAutoCompoundDelegatorKey(cursor)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(start, sdk.PrefixEndBytes(types.AutoCompoundDelegatorPrefix))
+
+	maxDelegators := int(k.GetMaxAutoCompoundsPerBlock(ctx))
+	delegators := make([]sdk.AccAddress, 0, maxDelegators)
+	complete := true
+	for ; iter.Valid(); iter.Next() {
+		delAddr := types.GetAutoCompoundDelegatorAddress(iter.Key())
+		if inRound && delAddr.Equals(cursor) {
+			continue
+		}
+		if len(delegators) == maxDelegators {
+			complete = false
+			break
+		}
+		delegators = append(delegators, delAddr)
+	}
+	iter.Close()
+
+	for _, delAddr := range delegators {
+		k.compoundDelegatorRewards(ctx, delAddr)
+	}
+
+	if complete {
+		k.DeleteAutoCompoundCursor(ctx)
+		return
+	}
+	k.SetAutoCompoundCursor(ctx, delegators[len(delegators)-1])
+}
+
+// compoundDelegatorRewards withdraws the rewards of all the delegations of a
+// delegator and delegates the bond denom part of them back to the same
+// validators. Rewards can only be compounded when they are withdrawn to the
+// delegator itself. A delegation which fails to be compounded is left
+// untouched.
+func (k Keeper) compoundDelegatorRewards(ctx sdk.Context, delAddr sdk.AccAddress) {
+	if !k.GetDelegatorWithdrawAddr(ctx, delAddr).Equals(delAddr) {
+		return
+	}
+
+	var valAddrs []sdk.ValAddress
+	k.stakingKeeper.IterateDelegations(ctx, delAddr, func(_ int64, del exported.DelegationI) (stop bool) {
+		valAddrs = append(valAddrs, del.GetValidatorAddr())
+		return false
+	})
+
+	for _, valAddr := range valAddrs {
+		cacheCtx, writeCache := ctx.CacheContext()
+		cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+
+		if err := k.compoundDelegationRewards(cacheCtx, delAddr, valAddr); err != nil {
+			k.Logger(ctx).Info(
+				fmt.Sprintf("failed to compound rewards of delegator %s from validator %s: %s", delAddr, valAddr, err),
+			)
+			continue
+		}
+
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+}
+
+// compoundDelegationRewards withdraws the rewards of a delegation and
+// delegates the bond denom part of them to the validator
+func (k Keeper) compoundDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	rewards, err := k.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+	if err != nil {
+		return err
+	}
+
+	amount := rewards.AmountOf(k.stakingKeeper.BondDenom(ctx))
+	if !amount.IsPositive() {
+		return nil
+	}
+
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrNoValidatorExists
+	}
+
+	if _, err := k.stakingKeeper.Delegate(ctx, delAddr, amount, sdk.Unbonded, validator, true); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAutoCompound,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+		),
+	)
+
+	return nil
+}
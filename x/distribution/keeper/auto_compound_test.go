@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+func TestAutoCompoundRewards(t *testing.T) {
+	ctx, _, bk, k, sk, _ := CreateTestInputDefault(t, false, 1000)
+	sh := staking.NewHandler(sk)
+
+	// set module account coins
+	distrAcc := k.GetDistributionAccount(ctx)
+	err := bk.SetBalances(ctx, distrAcc.GetAddress(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000))))
+	require.NoError(t, err)
+	k.supplyKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// compound a single delegator every block of a round started every 10 blocks
+	params := k.GetParams(ctx)
+	params.AutoCompoundInterval = 10
+	params.MaxAutoCompoundsPerBlock = 1
+	k.SetParams(ctx, params)
+
+	// create validator with 50% commission
+	commission := staking.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	msg := staking.NewMsgCreateValidator(valOpAddr1, valConsPk1,
+		sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)), staking.Description{}, commission, sdk.OneInt())
+
+	res, err := sh(ctx, msg)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// two more delegators, both opted in to auto-compounding
+	for _, delAddr := range []sdk.AccAddress{valAccAddr2, valAccAddr3} {
+		res, err = sh(ctx, staking.NewMsgDelegate(delAddr, valOpAddr1, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))))
+		require.NoError(t, err)
+		require.NotNil(t, res)
+
+		k.SetDelegatorAutoCompound(ctx, delAddr, true)
+		require.True(t, k.GetDelegatorAutoCompound(ctx, delAddr))
+	}
+
+	// the rewards of the second delegator are withdrawn to another address so
+	// they cannot be compounded
+	k.SetDelegatorWithdrawAddr(ctx, valAccAddr3, delAddr1)
+
+	// end block to bond validator
+	staking.EndBlocker(ctx, sk)
+
+	// allocate 100 tokens of rewards to each delegation
+	ctx = ctx.WithBlockHeight(11)
+	val := sk.Validator(ctx, valOpAddr1)
+	k.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.NewInt(600))})
+
+	// no round starts outside of the interval
+	k.AutoCompoundRewards(ctx)
+	_, found := k.GetAutoCompoundCursor(ctx)
+	require.False(t, found)
+	require.Equal(t, sdk.NewDec(100), sk.Delegation(ctx, valAccAddr2, valOpAddr1).GetShares())
+
+	// the round starts with a single delegator compounded
+	ctx = ctx.WithBlockHeight(20)
+	k.AutoCompoundRewards(ctx)
+	_, found = k.GetAutoCompoundCursor(ctx)
+	require.True(t, found)
+
+	// and completes in the next block
+	ctx = ctx.WithBlockHeight(21)
+	k.AutoCompoundRewards(ctx)
+	_, found = k.GetAutoCompoundCursor(ctx)
+	require.False(t, found)
+
+	require.Equal(t, sdk.NewDec(200), sk.Delegation(ctx, valAccAddr2, valOpAddr1).GetShares())
+	require.Equal(t, sdk.NewDec(100), sk.Delegation(ctx, valAccAddr3, valOpAddr1).GetShares())
+
+	// the rewards are fully compounded
+	val = sk.Validator(ctx, valOpAddr1)
+	endingPeriod := k.incrementValidatorPeriod(ctx, val)
+	rewards := k.calculateDelegationRewards(ctx, val, sk.Delegation(ctx, valAccAddr2, valOpAddr1), endingPeriod)
+	require.True(t, rewards.IsZero())
+
+	// opting out stops the compounding
+	k.SetDelegatorAutoCompound(ctx, valAccAddr2, false)
+	require.False(t, k.GetDelegatorAutoCompound(ctx, valAccAddr2))
+}
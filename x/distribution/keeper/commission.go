@@ -0,0 +1,187 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// get the validator commission withdraw address, defaulting to the withdraw
+// address of the validator operator
+func (k Keeper) GetValidatorCommissionWithdrawAddr(ctx sdk.Context, valAddr sdk.ValAddress) sdk.AccAddress {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetValidatorCommissionWithdrawAddrKey(valAddr))
+	if b == nil {
+		return k.GetDelegatorWithdrawAddr(ctx, sdk.AccAddress(valAddr))
+	}
+	return sdk.AccAddress(b)
+}
+
+// set the validator commission withdraw address
+func (k Keeper) SetValidatorCommissionWithdrawAddr(ctx sdk.Context, valAddr sdk.ValAddress, withdrawAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetValidatorCommissionWithdrawAddrKey(valAddr), withdrawAddr.Bytes())
+}
+
+// delete the validator commission withdraw address
+func (k Keeper) DeleteValidatorCommissionWithdrawAddr(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetValidatorCommissionWithdrawAddrKey(valAddr))
+}
+
+// iterate over the set validator commission withdraw addresses
+func (k Keeper) IterateValidatorCommissionWithdrawAddrs(ctx sdk.Context,
+	handler func(val sdk.ValAddress, addr sdk.AccAddress) (stop bool)) {
+
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorCommissionWithdrawAddrPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		addr := sdk.AccAddress(iter.Value())
+		val := types.GetValidatorCommissionWithdrawAddrAddress(iter.Key())
+		if handler(val, addr) {
+			break
+		}
+	}
+}
+
+// get the validator commission splits
+func (k Keeper) GetValidatorCommissionSplits(ctx sdk.Context, valAddr sdk.ValAddress) (splits types.CommissionSplits) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetValidatorCommissionSplitsKey(valAddr))
+	if b == nil {
+		return types.CommissionSplits{}
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(b, &splits)
+	return
+}
+
+// set the validator commission splits, empty splits are deleted
+func (k Keeper) SetValidatorCommissionSplits(ctx sdk.Context, valAddr sdk.ValAddress, splits types.CommissionSplits) {
+	if len(splits) == 0 {
+		k.DeleteValidatorCommissionSplits(ctx, valAddr)
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinaryLengthPrefixed(splits)
+	store.Set(types.GetValidatorCommissionSplitsKey(valAddr), b)
+}
+
+// delete the validator commission splits
+func (k Keeper) DeleteValidatorCommissionSplits(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetValidatorCommissionSplitsKey(valAddr))
+}
+
+// iterate over the validator commission splits
+func (k Keeper) IterateValidatorCommissionSplits(ctx sdk.Context,
+	handler func(val sdk.ValAddress, splits types.CommissionSplits) (stop bool)) {
+
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorCommissionSplitsPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var splits types.CommissionSplits
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &splits)
+		val := types.GetValidatorCommissionSplitsAddress(iter.Key())
+		if handler(val, splits) {
+			break
+		}
+	}
+}
+
+// SetCommissionWithdrawAddr sets a new address that will receive the commission
+// of a validator upon withdrawal
+func (k Keeper) SetCommissionWithdrawAddr(ctx sdk.Context, valAddr sdk.ValAddress, withdrawAddr sdk.AccAddress) error {
+	if err := k.checkCommissionRecipient(ctx, valAddr, withdrawAddr); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetCommissionWithdrawAddress,
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyWithdrawAddress, withdrawAddr.String()),
+		),
+	)
+
+	k.SetValidatorCommissionWithdrawAddr(ctx, valAddr, withdrawAddr)
+	return nil
+}
+
+// SetCommissionSplits sets the splits of the commission of a validator upon
+// withdrawal
+func (k Keeper) SetCommissionSplits(ctx sdk.Context, valAddr sdk.ValAddress, splits types.CommissionSplits) error {
+	if err := splits.Validate(); err != nil {
+		return err
+	}
+	for _, split := range splits {
+		if err := k.checkCommissionRecipient(ctx, valAddr, split.Address); err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetCommissionSplits,
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeySplits, splits.String()),
+		),
+	)
+
+	k.SetValidatorCommissionSplits(ctx, valAddr, splits)
+	return nil
+}
+
+// checkCommissionRecipient checks that the commission of an existing
+// validator can be sent to an address
+func (k Keeper) checkCommissionRecipient(ctx sdk.Context, valAddr sdk.ValAddress, addr sdk.AccAddress) error {
+	if k.stakingKeeper.Validator(ctx, valAddr) == nil {
+		return types.ErrNoValidatorExists
+	}
+
+	if k.blacklistedAddrs[addr.String()] {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is blacklisted from receiving external funds", addr)
+	}
+
+	if !k.GetWithdrawAddrEnabled(ctx) {
+		return types.ErrSetWithdrawAddrDisabled
+	}
+
+	return nil
+}
+
+// sendValidatorCommission sends the withdrawn commission of a validator to the
+// addresses of its commission splits and the rest of it to its commission
+// withdraw address
+func (k Keeper) sendValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddress, commission sdk.Coins) error {
+	splits := k.GetValidatorCommissionSplits(ctx, valAddr)
+	shares, remainder := splits.Split(commission)
+
+	for i, split := range splits {
+		if shares[i].IsZero() {
+			continue
+		}
+
+		err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, split.Address, shares[i])
+		if err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSplitCommission,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, shares[i].String()),
+				sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyRecipient, split.Address.String()),
+			),
+		)
+	}
+
+	if remainder.IsZero() {
+		return nil
+	}
+
+	withdrawAddr := k.GetValidatorCommissionWithdrawAddr(ctx, valAddr)
+	return k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, remainder)
+}
@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+func TestSetCommissionWithdrawAddrAndSplits(t *testing.T) {
+	ctx, _, _, k, sk, _ := CreateTestInputDefault(t, false, 1000)
+	sh := staking.NewHandler(sk)
+
+	splits := types.CommissionSplits{types.NewCommissionSplit(delAddr2, sdk.NewDecWithPrec(5, 1))}
+
+	// the validator must exist
+	require.Error(t, k.SetCommissionWithdrawAddr(ctx, valOpAddr1, delAddr1))
+	require.Error(t, k.SetCommissionSplits(ctx, valOpAddr1, splits))
+
+	commission := staking.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	msg := staking.NewMsgCreateValidator(valOpAddr1, valConsPk1,
+		sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)), staking.Description{}, commission, sdk.OneInt())
+	res, err := sh(ctx, msg)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// the commission is withdrawn to the withdraw address of the operator by default
+	k.SetDelegatorWithdrawAddr(ctx, sdk.AccAddress(valOpAddr1), delAddr3)
+	require.Equal(t, delAddr3, k.GetValidatorCommissionWithdrawAddr(ctx, valOpAddr1))
+
+	// withdraw addresses must be enabled
+	params := k.GetParams(ctx)
+	params.WithdrawAddrEnabled = false
+	k.SetParams(ctx, params)
+	require.Error(t, k.SetCommissionWithdrawAddr(ctx, valOpAddr1, delAddr1))
+	require.Error(t, k.SetCommissionSplits(ctx, valOpAddr1, splits))
+
+	params.WithdrawAddrEnabled = true
+	k.SetParams(ctx, params)
+
+	// blacklisted addresses cannot receive the commission
+	distrAddr := k.GetDistributionAccount(ctx).GetAddress()
+	require.Error(t, k.SetCommissionWithdrawAddr(ctx, valOpAddr1, distrAddr))
+	require.Error(t, k.SetCommissionSplits(ctx, valOpAddr1,
+		types.CommissionSplits{types.NewCommissionSplit(distrAddr, sdk.NewDecWithPrec(5, 1))}))
+
+	// invalid splits are rejected
+	require.Error(t, k.SetCommissionSplits(ctx, valOpAddr1,
+		types.CommissionSplits{types.NewCommissionSplit(delAddr2, sdk.NewDec(2))}))
+
+	require.NoError(t, k.SetCommissionWithdrawAddr(ctx, valOpAddr1, delAddr1))
+	require.Equal(t, delAddr1, k.GetValidatorCommissionWithdrawAddr(ctx, valOpAddr1))
+
+	require.NoError(t, k.SetCommissionSplits(ctx, valOpAddr1, splits))
+	require.Equal(t, splits, k.GetValidatorCommissionSplits(ctx, valOpAddr1))
+
+	// empty splits remove the splits
+	require.NoError(t, k.SetCommissionSplits(ctx, valOpAddr1, types.CommissionSplits{}))
+	require.Empty(t, k.GetValidatorCommissionSplits(ctx, valOpAddr1))
+}
+
+func TestWithdrawValidatorCommissionWithSplits(t *testing.T) {
+	ctx, _, bk, k, sk, _ := CreateTestInputDefault(t, false, 1000)
+	sh := staking.NewHandler(sk)
+
+	// set module account coins
+	distrAcc := k.GetDistributionAccount(ctx)
+	err := bk.SetBalances(ctx, distrAcc.GetAddress(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000))))
+	require.NoError(t, err)
+	k.supplyKeeper.SetModuleAccount(ctx, distrAcc)
+
+	commission := staking.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	msg := staking.NewMsgCreateValidator(valOpAddr1, valConsPk1,
+		sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)), staking.Description{}, commission, sdk.OneInt())
+	res, err := sh(ctx, msg)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// send 30% of the commission to the second and 20% to the third address,
+	// and the rest of it to the first address
+	require.NoError(t, k.SetCommissionWithdrawAddr(ctx, valOpAddr1, delAddr1))
+	require.NoError(t, k.SetCommissionSplits(ctx, valOpAddr1, types.CommissionSplits{
+		types.NewCommissionSplit(delAddr2, sdk.NewDecWithPrec(3, 1)),
+		types.NewCommissionSplit(delAddr3, sdk.NewDecWithPrec(2, 1)),
+	}))
+
+	valCommission := sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.NewInt(101))}
+	k.SetValidatorOutstandingRewards(ctx, valOpAddr1, valCommission)
+	k.SetValidatorAccumulatedCommission(ctx, valOpAddr1, valCommission)
+
+	operatorBalance := bk.GetAllBalances(ctx, sdk.AccAddress(valOpAddr1))
+	balances := make([]sdk.Coins, 3)
+	for i, addr := range []sdk.AccAddress{delAddr1, delAddr2, delAddr3} {
+		balances[i] = bk.GetAllBalances(ctx, addr)
+	}
+
+	withdrawn, err := k.WithdrawValidatorCommission(ctx, valOpAddr1)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(101))), withdrawn)
+
+	// the operator does not receive any commission
+	require.Equal(t, operatorBalance, bk.GetAllBalances(ctx, sdk.AccAddress(valOpAddr1)))
+
+	// the splits are truncated and the remainder is sent to the withdraw address
+	for i, amount := range []int64{51, 30, 20} {
+		addr := []sdk.AccAddress{delAddr1, delAddr2, delAddr3}[i]
+		expected := balances[i].Add(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(amount)))
+		require.Equal(t, expected, bk.GetAllBalances(ctx, addr))
+	}
+}
@@ -159,6 +159,19 @@ func (k Keeper) withdrawDelegationRewards(ctx sdk.Context, val exported.Validato
 
 	// truncate coins, return remainder to community pool
 	coins, remainder := rewards.TruncateDecimal()
+	feePool := k.GetFeePool(ctx)
+	feePool.CommunityPool = feePool.CommunityPool.Add(remainder...)
+
+	// pay the bonus of locked delegations out of the community pool, as far
+	// as the community pool can cover it: the F1 accumulation is unaffected by
+	// locks and no bonus is paid out of an empty community pool
+	multiplier := k.stakingKeeper.DelegationRewardMultiplier(ctx, del.GetDelegatorAddr(), del.GetValidatorAddr())
+	if multiplier.GT(sdk.OneDec()) && !coins.IsZero() {
+		bonusRaw := sdk.NewDecCoinsFromCoins(coins...).MulDecTruncate(multiplier.Sub(sdk.OneDec()))
+		bonus, _ := bonusRaw.Intersect(feePool.CommunityPool).TruncateDecimal()
+		feePool.CommunityPool = feePool.CommunityPool.Sub(sdk.NewDecCoinsFromCoins(bonus...))
+		coins = coins.Add(bonus...)
+	}
 
 	// add coins to user account
 	if !coins.IsZero() {
@@ -172,8 +185,6 @@ func (k Keeper) withdrawDelegationRewards(ctx sdk.Context, val exported.Validato
 	// update the outstanding rewards and the community pool only if the
 	// transaction was successful
 	k.SetValidatorOutstandingRewards(ctx, del.GetValidatorAddr(), outstanding.Sub(rewards))
-	feePool := k.GetFeePool(ctx)
-	feePool.CommunityPool = feePool.CommunityPool.Add(remainder...)
 	k.SetFeePool(ctx, feePool)
 
 	// decrement reference count of starting period
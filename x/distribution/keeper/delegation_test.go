@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -352,6 +353,122 @@ func TestWithdrawDelegationRewardsBasic(t *testing.T) {
 	)
 }
 
+func TestWithdrawDelegationRewardsLocked(t *testing.T) {
+	balancePower := int64(1000)
+	balanceTokens := sdk.TokensFromConsensusPower(balancePower)
+	ctx, _, bk, k, sk, _ := CreateTestInputDefault(t, false, balancePower)
+	sh := staking.NewHandler(sk)
+
+	// set module account coins, which hold the community pool as well
+	distrAcc := k.GetDistributionAccount(ctx)
+	require.NoError(t, bk.SetBalances(ctx, distrAcc.GetAddress(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, balanceTokens))))
+	k.supplyKeeper.SetModuleAccount(ctx, distrAcc)
+
+	communityPool := sdk.TokensFromConsensusPower(100)
+	feePool := k.GetFeePool(ctx)
+	feePool.CommunityPool = sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, communityPool)}
+	k.SetFeePool(ctx, feePool)
+
+	// add a lock tier with a 1.5 reward multiplier
+	stakingParams := sk.GetParams(ctx)
+	stakingParams.LockTiers = []staking.LockTier{staking.NewLockTier(time.Hour, sdk.NewDecWithPrec(15, 1))}
+	sk.SetParams(ctx, stakingParams)
+
+	// create validator with 50% commission
+	power := int64(100)
+	valTokens := sdk.TokensFromConsensusPower(power)
+	commission := staking.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	msg := staking.NewMsgCreateValidator(
+		valOpAddr1, valConsPk1,
+		sdk.NewCoin(sdk.DefaultBondDenom, valTokens),
+		staking.Description{}, commission, sdk.OneInt(),
+	)
+
+	res, err := sh(ctx, msg)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// lock the self delegation
+	res, err = sh(ctx, staking.NewMsgLockDelegation(sdk.AccAddress(valOpAddr1), valOpAddr1, time.Hour))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// end block to bond validator
+	staking.EndBlocker(ctx, sk)
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate some rewards
+	val := sk.Validator(ctx, valOpAddr1)
+	initial := sdk.TokensFromConsensusPower(10)
+	k.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)})
+
+	// withdraw rewards, half of which are paid out of the community pool
+	rewards, err := k.WithdrawDelegationRewards(ctx, sdk.AccAddress(valOpAddr1), valOpAddr1)
+	require.Nil(t, err)
+
+	bonus := initial.QuoRaw(4)
+	require.Equal(t, sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, initial.QuoRaw(2).Add(bonus))}, rewards)
+	require.Equal(t,
+		sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, balanceTokens.Sub(valTokens).Add(rewards.AmountOf(sdk.DefaultBondDenom)))},
+		bk.GetAllBalances(ctx, sdk.AccAddress(valOpAddr1)),
+	)
+	require.Equal(t,
+		sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, communityPool.Sub(bonus))},
+		k.GetFeePool(ctx).CommunityPool,
+	)
+
+	// the bonus is capped by the community pool
+	feePool = k.GetFeePool(ctx)
+	feePool.CommunityPool = sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.NewInt(1))}
+	k.SetFeePool(ctx, feePool)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	k.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)})
+	rewards, err = k.WithdrawDelegationRewards(ctx, sdk.AccAddress(valOpAddr1), valOpAddr1)
+	require.Nil(t, err)
+	require.Equal(t, sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, initial.QuoRaw(2).AddRaw(1))}, rewards)
+	require.True(t, k.GetFeePool(ctx).CommunityPool.IsZero())
+
+	// no bonus is paid out of an empty community pool
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	k.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)})
+	rewards, err = k.WithdrawDelegationRewards(ctx, sdk.AccAddress(valOpAddr1), valOpAddr1)
+	require.Nil(t, err)
+	require.Equal(t, sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, initial.QuoRaw(2))}, rewards)
+	require.True(t, k.GetFeePool(ctx).CommunityPool.IsZero())
+
+	// a matured lock pays the bonus on withdrawal until it is completed
+	feePool = k.GetFeePool(ctx)
+	feePool.CommunityPool = sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, communityPool)}
+	k.SetFeePool(ctx, feePool)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(ctx.BlockHeader().Time.Add(time.Hour))
+	k.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)})
+	rewards, err = k.WithdrawDelegationRewards(ctx, sdk.AccAddress(valOpAddr1), valOpAddr1)
+	require.Nil(t, err)
+	require.Equal(t, sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, initial.QuoRaw(2).Add(bonus))}, rewards)
+
+	// undelegating from a matured lock settles the rewards with its multiplier
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	k.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)})
+	balance := bk.GetBalance(ctx, sdk.AccAddress(valOpAddr1), sdk.DefaultBondDenom).Amount
+	undelegateAmt := sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(1))
+	res, err = sh(ctx, staking.NewMsgUndelegate(sdk.AccAddress(valOpAddr1), valOpAddr1, undelegateAmt))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	require.Equal(t, balance.Add(initial.QuoRaw(2)).Add(bonus), bk.GetBalance(ctx, sdk.AccAddress(valOpAddr1), sdk.DefaultBondDenom).Amount)
+	require.Equal(t,
+		sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, communityPool.Sub(bonus.MulRaw(2)))},
+		k.GetFeePool(ctx).CommunityPool,
+	)
+
+	// completing the lock ends its multiplier
+	staking.EndBlocker(ctx, sk)
+	require.Equal(t, sdk.OneDec(), sk.DelegationRewardMultiplier(ctx, sdk.AccAddress(valOpAddr1), valOpAddr1))
+}
+
 func TestCalculateRewardsAfterManySlashesInSameBlock(t *testing.T) {
 	ctx, _, _, k, sk, _ := CreateTestInputDefault(t, false, 1000)
 	sh := staking.NewHandler(sk)
@@ -648,3 +765,87 @@ func TestCalculateRewardsMultiDelegatorMultWithdraw(t *testing.T) {
 	// commission should be zero
 	require.True(t, k.GetValidatorAccumulatedCommission(ctx, valOpAddr1).IsZero())
 }
+
+func TestWithdrawTokenizedDelegationRewards(t *testing.T) {
+	balancePower := int64(1000)
+	balanceTokens := sdk.TokensFromConsensusPower(balancePower)
+	ctx, _, bk, k, sk, _ := CreateTestInputDefault(t, false, balancePower)
+	sh := staking.NewHandler(sk)
+
+	// set module account coins
+	distrAcc := k.GetDistributionAccount(ctx)
+	require.NoError(t, bk.SetBalances(ctx, distrAcc.GetAddress(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, balanceTokens))))
+	k.supplyKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// create validator with 50% commission
+	power := int64(100)
+	valTokens := sdk.TokensFromConsensusPower(power)
+	commission := staking.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	msg := staking.NewMsgCreateValidator(
+		valOpAddr1, valConsPk1,
+		sdk.NewCoin(sdk.DefaultBondDenom, valTokens),
+		staking.Description{}, commission, sdk.OneInt(),
+	)
+
+	res, err := sh(ctx, msg)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// delegate and tokenize the whole delegation
+	delTokens := sdk.TokensFromConsensusPower(power)
+	amount := sdk.NewCoin(sdk.DefaultBondDenom, delTokens)
+	res, err = sh(ctx, staking.NewMsgDelegate(delAddr1, valOpAddr1, amount))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// end block to bond validator
+	staking.EndBlocker(ctx, sk)
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	res, err = sh(ctx, staking.NewMsgTokenizeShares(delAddr1, valOpAddr1, amount))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// allocate some rewards, half of which go to the tokenized delegation
+	val := sk.Validator(ctx, valOpAddr1)
+	initial := sdk.TokensFromConsensusPower(20)
+	k.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)})
+
+	// another delegator tokenizes a delegation after the rewards accrued
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	res, err = sh(ctx, staking.NewMsgDelegate(delAddr2, valOpAddr1, amount))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	res, err = sh(ctx, staking.NewMsgTokenizeShares(delAddr2, valOpAddr1, amount))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	records := sk.GetTokenizeShareRecordsByValidator(ctx, valOpAddr1)
+	require.Len(t, records, 2)
+
+	// redeeming the new share tokens right away pays no rewards
+	shareTokens := sdk.NewCoin(records[1].GetShareTokenDenom(), delTokens)
+	res, err = sh(ctx, staking.NewMsgRedeemTokensForShares(delAddr2, shareTokens))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	require.Equal(t,
+		sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, balanceTokens.Sub(delTokens))},
+		bk.GetAllBalances(ctx, delAddr2),
+	)
+
+	// the earlier share tokens are paid all the rewards accrued by their record
+	shareTokens = sdk.NewCoin(records[0].GetShareTokenDenom(), delTokens)
+	res, err = sh(ctx, staking.NewMsgRedeemTokensForShares(delAddr1, shareTokens))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	require.Equal(t,
+		sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, balanceTokens.Sub(delTokens).Add(initial.QuoRaw(4)))},
+		bk.GetAllBalances(ctx, delAddr1),
+	)
+
+	// both records are deleted once redeemed
+	require.Empty(t, sk.GetTokenizeShareRecordsByValidator(ctx, valOpAddr1))
+}
@@ -0,0 +1,151 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// get a community pool funding stream
+func (k Keeper) GetFundingStream(ctx sdk.Context, id uint64) (stream types.FundingStream, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetFundingStreamKey(id))
+	if b == nil {
+		return stream, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(b, &stream)
+	return stream, true
+}
+
+// set a community pool funding stream
+func (k Keeper) SetFundingStream(ctx sdk.Context, stream types.FundingStream) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinaryLengthPrefixed(stream)
+	store.Set(types.GetFundingStreamKey(stream.ID), b)
+}
+
+// delete a community pool funding stream
+func (k Keeper) DeleteFundingStream(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetFundingStreamKey(id))
+}
+
+// iterate over the community pool funding streams by id
+func (k Keeper) IterateFundingStreams(ctx sdk.Context, handler func(stream types.FundingStream) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.FundingStreamPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var stream types.FundingStream
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &stream)
+		if handler(stream) {
+			break
+		}
+	}
+}
+
+// get all the community pool funding streams
+func (k Keeper) GetAllFundingStreams(ctx sdk.Context) (streams types.FundingStreams) {
+	k.IterateFundingStreams(ctx, func(stream types.FundingStream) (stop bool) {
+		streams = append(streams, stream)
+		return false
+	})
+	return streams
+}
+
+// get the id of the next funding stream
+func (k Keeper) GetNextFundingStreamID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.NextFundingStreamIDKey)
+	if b == nil {
+		return 1
+	}
+	return binary.BigEndian.Uint64(b)
+}
+
+// set the id of the next funding stream
+func (k Keeper) SetNextFundingStreamID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, id)
+	store.Set(types.NextFundingStreamIDKey, b)
+}
+
+// CreateFundingStream starts a new community pool funding stream and returns
+// its id
+func (k Keeper) CreateFundingStream(
+	ctx sdk.Context, recipient sdk.AccAddress, amountPerBlock, totalAmount sdk.Coins, endTime time.Time,
+) (uint64, error) {
+	if k.blacklistedAddrs[recipient.String()] {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is blacklisted from receiving external funds", recipient)
+	}
+
+	id := k.GetNextFundingStreamID(ctx)
+	stream := types.NewFundingStream(id, recipient, amountPerBlock, totalAmount, endTime)
+	if err := stream.Validate(); err != nil {
+		return 0, err
+	}
+	if stream.IsFinished(ctx.BlockHeader().Time) {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidFundingStream, "end time %s has already passed", endTime)
+	}
+
+	k.SetFundingStream(ctx, stream)
+	k.SetNextFundingStreamID(ctx, id+1)
+	return id, nil
+}
+
+// CancelFundingStream stops a community pool funding stream
+func (k Keeper) CancelFundingStream(ctx sdk.Context, id uint64) error {
+	if _, found := k.GetFundingStream(ctx, id); !found {
+		return sdkerrors.Wrapf(types.ErrNoFundingStream, "%d", id)
+	}
+
+	k.DeleteFundingStream(ctx, id)
+	return nil
+}
+
+// PayFundingStreams pays the recipients of the community pool funding streams
+// for the current block. A payout the community pool cannot cover is skipped,
+// and the streams that have finished are removed.
+func (k Keeper) PayFundingStreams(ctx sdk.Context) {
+	blockTime := ctx.BlockHeader().Time
+	logger := k.Logger(ctx)
+
+	for _, stream := range k.GetAllFundingStreams(ctx) {
+		if !stream.IsFinished(blockTime) {
+			payout := stream.NextPayout()
+			if err := k.DistributeFromFeePool(ctx, payout, stream.Recipient); err != nil {
+				logger.Info(fmt.Sprintf("skipped payout of funding stream %d: %s", stream.ID, err))
+				continue
+			}
+
+			stream.Paid = stream.Paid.Add(payout...)
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeFundingStreamPayout,
+					sdk.NewAttribute(types.AttributeKeyStreamID, fmt.Sprintf("%d", stream.ID)),
+					sdk.NewAttribute(types.AttributeKeyRecipient, stream.Recipient.String()),
+					sdk.NewAttribute(sdk.AttributeKeyAmount, payout.String()),
+				),
+			)
+		}
+
+		if !stream.IsFinished(blockTime) {
+			k.SetFundingStream(ctx, stream)
+			continue
+		}
+
+		k.DeleteFundingStream(ctx, stream.ID)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeFundingStreamFinished,
+				sdk.NewAttribute(types.AttributeKeyStreamID, fmt.Sprintf("%d", stream.ID)),
+				sdk.NewAttribute(types.AttributeKeyRecipient, stream.Recipient.String()),
+			),
+		)
+	}
+}
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+func TestPayFundingStreams(t *testing.T) {
+	ctx, _, bk, k, _, _ := CreateTestInputDefault(t, false, 1000)
+	ctx = ctx.WithBlockTime(time.Unix(100, 0))
+
+	// fund the community pool
+	pool := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	distrAcc := k.GetDistributionAccount(ctx)
+	require.NoError(t, bk.SetBalances(ctx, distrAcc.GetAddress(), pool))
+	k.supplyKeeper.SetModuleAccount(ctx, distrAcc)
+	feePool := k.GetFeePool(ctx)
+	feePool.CommunityPool = sdk.NewDecCoinsFromCoins(pool...)
+	k.SetFeePool(ctx, feePool)
+
+	perBlock := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	balance1 := bk.GetAllBalances(ctx, delAddr1)
+	balance2 := bk.GetAllBalances(ctx, delAddr2)
+	balance3 := bk.GetAllBalances(ctx, delAddr3)
+
+	// blacklisted recipients and past end times are rejected
+	_, err := k.CreateFundingStream(ctx, distrAcc.GetAddress(), perBlock, perBlock, time.Time{})
+	require.Error(t, err)
+	_, err = k.CreateFundingStream(ctx, delAddr1, perBlock, sdk.Coins{}, time.Unix(100, 0))
+	require.Error(t, err)
+
+	// the first stream pays 25 tokens in total, the second one until its end time
+	id1, err := k.CreateFundingStream(ctx, delAddr1, perBlock, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 25)), time.Time{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), id1)
+	id2, err := k.CreateFundingStream(ctx, delAddr2, perBlock, sdk.Coins{}, time.Unix(103, 0))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), id2)
+	require.Len(t, k.GetAllFundingStreams(ctx), 2)
+
+	for i, expected := range []int64{10, 20, 25, 25} {
+		k.PayFundingStreams(ctx.WithBlockTime(time.Unix(int64(100+i), 0)))
+		require.Equal(t, balance1.Add(sdk.NewInt64Coin(sdk.DefaultBondDenom, expected)), bk.GetAllBalances(ctx, delAddr1))
+	}
+
+	// the first stream finished once its total amount was paid, the second one
+	// at its end time
+	_, found := k.GetFundingStream(ctx, id1)
+	require.False(t, found)
+	_, found = k.GetFundingStream(ctx, id2)
+	require.False(t, found)
+	require.Equal(t, balance2.Add(sdk.NewInt64Coin(sdk.DefaultBondDenom, 30)), bk.GetAllBalances(ctx, delAddr2))
+	require.Equal(t, sdk.NewDecCoinsFromCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 45)), k.GetFeePool(ctx).CommunityPool)
+
+	// payouts the community pool cannot cover are skipped
+	id3, err := k.CreateFundingStream(ctx, delAddr3, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)), sdk.Coins{}, time.Unix(200, 0))
+	require.NoError(t, err)
+	k.PayFundingStreams(ctx)
+	require.Equal(t, balance3, bk.GetAllBalances(ctx, delAddr3))
+	stream, found := k.GetFundingStream(ctx, id3)
+	require.True(t, found)
+	require.True(t, stream.Paid.IsZero())
+
+	// cancelled streams are removed
+	require.NoError(t, k.CancelFundingStream(ctx, id3))
+	_, found = k.GetFundingStream(ctx, id3)
+	require.False(t, found)
+	require.True(t, types.ErrNoFundingStream.Is(k.CancelFundingStream(ctx, id3)))
+	require.Equal(t, uint64(4), k.GetNextFundingStreamID(ctx))
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"github.com/tendermint/tendermint/crypto"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -42,13 +44,9 @@ func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, _ sdk.ConsAddress, valAddr
 		feePool.CommunityPool = feePool.CommunityPool.Add(remainder...)
 		h.k.SetFeePool(ctx, feePool)
 
-		// add to validator account
+		// add to validator commission recipients
 		if !coins.IsZero() {
-
-			accAddr := sdk.AccAddress(valAddr)
-			withdrawAddr := h.k.GetDelegatorWithdrawAddr(ctx, accAddr)
-			err := h.k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, coins)
-			if err != nil {
+			if err := h.k.sendValidatorCommission(ctx, valAddr, coins); err != nil {
 				panic(err)
 			}
 		}
@@ -73,6 +71,10 @@ func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, _ sdk.ConsAddress, valAddr
 
 	// clear current rewards
 	h.k.DeleteValidatorCurrentRewards(ctx, valAddr)
+
+	// clear commission withdraw address and splits
+	h.k.DeleteValidatorCommissionWithdrawAddr(ctx, valAddr)
+	h.k.DeleteValidatorCommissionSplits(ctx, valAddr)
 }
 
 // increment period
@@ -100,8 +102,16 @@ func (h Hooks) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, f
 	h.k.updateValidatorSlashFraction(ctx, valAddr, fraction)
 }
 
+// pay out the accumulated commission of a retired validator
+func (h Hooks) AfterValidatorRetired(ctx sdk.Context, _ sdk.ConsAddress, valAddr sdk.ValAddress) {
+	if _, err := h.k.WithdrawValidatorCommission(ctx, valAddr); err != nil && !types.ErrNoValidatorCommission.Is(err) {
+		panic(err)
+	}
+}
+
 // nolint - unused hooks
 func (h Hooks) BeforeValidatorModified(_ sdk.Context, _ sdk.ValAddress)                         {}
 func (h Hooks) AfterValidatorBonded(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress)         {}
 func (h Hooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) {}
 func (h Hooks) BeforeDelegationRemoved(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress)       {}
+func (h Hooks) AfterConsensusPubKeyRotated(_ sdk.Context, _, _ crypto.PubKey, _ sdk.ValAddress) {}
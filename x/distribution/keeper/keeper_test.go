@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
 )
 
 func TestSetWithdrawAddr(t *testing.T) {
@@ -78,6 +79,42 @@ func TestWithdrawValidatorCommission(t *testing.T) {
 	require.True(t, true)
 }
 
+func TestRetiredValidatorCommission(t *testing.T) {
+	ctx, _, bk, k, sk, _ := CreateTestInputDefault(t, false, 1000)
+	sh := staking.NewHandler(sk)
+
+	// create validator with 50% commission
+	commission := staking.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	msg := staking.NewMsgCreateValidator(
+		valOpAddr1, valConsPk1, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)), staking.Description{}, commission, sdk.OneInt(),
+	)
+
+	res, err := sh(ctx, msg)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	staking.EndBlocker(ctx, sk)
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate some rewards
+	distrAcc := k.GetDistributionAccount(ctx)
+	require.NoError(t, bk.SetBalances(ctx, distrAcc.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))))
+	k.supplyKeeper.SetModuleAccount(ctx, distrAcc)
+	k.AllocateTokensToValidator(ctx, sk.Validator(ctx, valOpAddr1), sdk.DecCoins{sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 10)})
+
+	balance := bk.GetBalance(ctx, sdk.AccAddress(valOpAddr1), sdk.DefaultBondDenom).Amount
+
+	// the accumulated commission is paid out when the validator retires, along
+	// with the rewards of its self-delegation
+	res, err = sh(ctx, staking.NewMsgRetireValidator(valOpAddr1))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	require.True(t, k.GetValidatorAccumulatedCommission(ctx, valOpAddr1).IsZero())
+	require.Equal(t, balance.AddRaw(10), bk.GetBalance(ctx, sdk.AccAddress(valOpAddr1), sdk.DefaultBondDenom).Amount)
+}
+
 func TestGetTotalRewards(t *testing.T) {
 	ctx, _, _, keeper, _, _ := CreateTestInputDefault(t, false, 1000) // nolint: dogsled
 
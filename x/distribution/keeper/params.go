@@ -41,3 +41,17 @@ func (k Keeper) GetWithdrawAddrEnabled(ctx sdk.Context) (enabled bool) {
 	k.paramSpace.Get(ctx, types.ParamStoreKeyWithdrawAddrEnabled, &enabled)
 	return enabled
 }
+
+// GetAutoCompoundInterval returns the current number of blocks between the
+// starts of two auto-compounding rounds.
+func (k Keeper) GetAutoCompoundInterval(ctx sdk.Context) (interval uint64) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyAutoCompoundInterval, &interval)
+	return interval
+}
+
+// GetMaxAutoCompoundsPerBlock returns the current maximum number of delegators
+// whose rewards are compounded within a block.
+func (k Keeper) GetMaxAutoCompoundsPerBlock(ctx sdk.Context) (max uint32) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyMaxAutoCompoundsPerBlock, &max)
+	return max
+}
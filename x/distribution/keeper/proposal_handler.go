@@ -23,3 +23,26 @@ func HandleCommunityPoolSpendProposal(ctx sdk.Context, k Keeper, p types.Communi
 	logger.Info(fmt.Sprintf("transferred %s from the community pool to recipient %s", p.Amount, p.Recipient))
 	return nil
 }
+
+// HandleFundingStreamProposal is a handler for executing a passed funding stream proposal
+func HandleFundingStreamProposal(ctx sdk.Context, k Keeper, p types.FundingStreamProposal) error {
+	id, err := k.CreateFundingStream(ctx, p.Recipient, p.AmountPerBlock, p.TotalAmount, p.EndTime)
+	if err != nil {
+		return err
+	}
+
+	logger := k.Logger(ctx)
+	logger.Info(fmt.Sprintf("started funding stream %d of %s per block from the community pool to recipient %s", id, p.AmountPerBlock, p.Recipient))
+	return nil
+}
+
+// HandleCancelFundingStreamProposal is a handler for executing a passed cancel funding stream proposal
+func HandleCancelFundingStreamProposal(ctx sdk.Context, k Keeper, p types.CancelFundingStreamProposal) error {
+	if err := k.CancelFundingStream(ctx, p.StreamID); err != nil {
+		return err
+	}
+
+	logger := k.Logger(ctx)
+	logger.Info(fmt.Sprintf("cancelled funding stream %d", p.StreamID))
+	return nil
+}
@@ -42,6 +42,18 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case types.QueryCommunityPool:
 			return queryCommunityPool(ctx, path[1:], req, k)
 
+		case types.QueryAutoCompound:
+			return queryDelegatorAutoCompound(ctx, path[1:], req, k)
+
+		case types.QueryCommissionWithdrawInfo:
+			return queryCommissionWithdrawInfo(ctx, path[1:], req, k)
+
+		case types.QueryFundingStreams:
+			return queryFundingStreams(ctx, path[1:], req, k)
+
+		case types.QueryFundingStream:
+			return queryFundingStream(ctx, path[1:], req, k)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query path: %s", path[0])
 		}
@@ -240,6 +252,43 @@ func queryDelegatorWithdrawAddress(ctx sdk.Context, _ []string, req abci.Request
 	return bz, nil
 }
 
+func queryDelegatorAutoCompound(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryDelegatorAutoCompoundParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	enabled := k.GetDelegatorAutoCompound(ctx, params.DelegatorAddress)
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, enabled)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryCommissionWithdrawInfo(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryCommissionWithdrawInfoParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	res := types.NewQueryCommissionWithdrawInfoResponse(
+		k.GetValidatorCommissionWithdrawAddr(ctx, params.ValidatorAddress),
+		k.GetValidatorCommissionSplits(ctx, params.ValidatorAddress),
+	)
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, res)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
 func queryCommunityPool(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
 	pool := k.GetFeePoolCommunityCoins(ctx)
 	if pool == nil {
@@ -253,3 +302,37 @@ func queryCommunityPool(ctx sdk.Context, _ []string, req abci.RequestQuery, k Ke
 
 	return bz, nil
 }
+
+func queryFundingStreams(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	streams := k.GetAllFundingStreams(ctx)
+	if streams == nil {
+		streams = types.FundingStreams{}
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, streams)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryFundingStream(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryFundingStreamParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	stream, found := k.GetFundingStream(ctx, params.StreamID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrNoFundingStream, "%d", params.StreamID)
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, stream)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"strings"
+	"time"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -105,6 +106,58 @@ func getQueriedCommunityPool(t *testing.T, ctx sdk.Context, cdc *codec.Codec, qu
 	return
 }
 
+func getQueriedAutoCompound(t *testing.T, ctx sdk.Context, cdc *codec.Codec, querier sdk.Querier, delegatorAddr sdk.AccAddress) (enabled bool) {
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryAutoCompound}, "/"),
+		Data: cdc.MustMarshalJSON(types.NewQueryDelegatorAutoCompoundParams(delegatorAddr)),
+	}
+
+	bz, err := querier(ctx, []string{types.QueryAutoCompound}, query)
+	require.Nil(t, err)
+	require.Nil(t, cdc.UnmarshalJSON(bz, &enabled))
+
+	return
+}
+
+func getQueriedCommissionWithdrawInfo(t *testing.T, ctx sdk.Context, cdc *codec.Codec, querier sdk.Querier, validatorAddr sdk.ValAddress) (info types.QueryCommissionWithdrawInfoResponse) {
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryCommissionWithdrawInfo}, "/"),
+		Data: cdc.MustMarshalJSON(types.NewQueryCommissionWithdrawInfoParams(validatorAddr)),
+	}
+
+	bz, err := querier(ctx, []string{types.QueryCommissionWithdrawInfo}, query)
+	require.Nil(t, err)
+	require.Nil(t, cdc.UnmarshalJSON(bz, &info))
+
+	return
+}
+
+func getQueriedFundingStreams(t *testing.T, ctx sdk.Context, cdc *codec.Codec, querier sdk.Querier) (streams types.FundingStreams) {
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryFundingStreams}, "/"),
+		Data: []byte{},
+	}
+
+	bz, err := querier(ctx, []string{types.QueryFundingStreams}, query)
+	require.Nil(t, err)
+	require.Nil(t, cdc.UnmarshalJSON(bz, &streams))
+
+	return
+}
+
+func getQueriedFundingStream(t *testing.T, ctx sdk.Context, cdc *codec.Codec, querier sdk.Querier, streamID uint64) (stream types.FundingStream) {
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryFundingStream}, "/"),
+		Data: cdc.MustMarshalJSON(types.NewQueryFundingStreamParams(streamID)),
+	}
+
+	bz, err := querier(ctx, []string{types.QueryFundingStream}, query)
+	require.Nil(t, err)
+	require.Nil(t, cdc.UnmarshalJSON(bz, &stream))
+
+	return
+}
+
 func TestQueries(t *testing.T) {
 	cdc := codec.New()
 	types.RegisterCodec(cdc)
@@ -118,6 +171,9 @@ func TestQueries(t *testing.T) {
 		BaseProposerReward:  sdk.NewDecWithPrec(2, 1),
 		BonusProposerReward: sdk.NewDecWithPrec(1, 1),
 		WithdrawAddrEnabled: true,
+
+		AutoCompoundInterval:     10,
+		MaxAutoCompoundsPerBlock: 5,
 	}
 
 	keeper.SetParams(ctx, params)
@@ -127,6 +183,8 @@ func TestQueries(t *testing.T) {
 	require.Equal(t, params.BaseProposerReward, paramsRes.BaseProposerReward)
 	require.Equal(t, params.BonusProposerReward, paramsRes.BonusProposerReward)
 	require.Equal(t, params.WithdrawAddrEnabled, paramsRes.WithdrawAddrEnabled)
+	require.Equal(t, params.AutoCompoundInterval, paramsRes.AutoCompoundInterval)
+	require.Equal(t, params.MaxAutoCompoundsPerBlock, paramsRes.MaxAutoCompoundsPerBlock)
 
 	// test outstanding rewards query
 	outstandingRewards := sdk.DecCoins{{Denom: "mytoken", Amount: sdk.NewDec(3)}, {Denom: "myothertoken", Amount: sdk.NewDecWithPrec(3, 7)}}
@@ -190,4 +248,30 @@ func TestQueries(t *testing.T) {
 	// currently community pool hold nothing so we should return null
 	communityPool := getQueriedCommunityPool(t, ctx, cdc, querier)
 	require.Nil(t, communityPool)
+
+	// test auto-compounding query
+	require.False(t, getQueriedAutoCompound(t, ctx, cdc, querier, delAddr1))
+	keeper.SetDelegatorAutoCompound(ctx, delAddr1, true)
+	require.True(t, getQueriedAutoCompound(t, ctx, cdc, querier, delAddr1))
+
+	// test commission withdraw info query
+	info := getQueriedCommissionWithdrawInfo(t, ctx, cdc, querier, valOpAddr1)
+	require.Equal(t, sdk.AccAddress(valOpAddr1), info.WithdrawAddress)
+	require.Empty(t, info.Splits)
+	splits := types.CommissionSplits{types.NewCommissionSplit(delAddr2, sdk.NewDecWithPrec(5, 1))}
+	keeper.SetValidatorCommissionWithdrawAddr(ctx, valOpAddr1, delAddr1)
+	keeper.SetValidatorCommissionSplits(ctx, valOpAddr1, splits)
+	info = getQueriedCommissionWithdrawInfo(t, ctx, cdc, querier, valOpAddr1)
+	require.Equal(t, types.NewQueryCommissionWithdrawInfoResponse(delAddr1, splits), info)
+
+	// test funding stream queries
+	require.Empty(t, getQueriedFundingStreams(t, ctx, cdc, querier))
+	stream := types.NewFundingStream(1, delAddr1, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), time.Time{})
+	keeper.SetFundingStream(ctx, stream)
+	require.Equal(t, types.FundingStreams{stream}, getQueriedFundingStreams(t, ctx, cdc, querier))
+	require.Equal(t, stream, getQueriedFundingStream(t, ctx, cdc, querier, 1))
+	_, err = querier(ctx, []string{types.QueryFundingStream}, abci.RequestQuery{
+		Data: cdc.MustMarshalJSON(types.NewQueryFundingStreamParams(2)),
+	})
+	require.Error(t, err)
 }
@@ -2,6 +2,7 @@ package simulation
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
 
 	tmkv "github.com/tendermint/tendermint/libs/kv"
@@ -62,6 +63,30 @@ func DecodeStore(cdc *codec.Codec, kvA, kvB tmkv.Pair) string {
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &eventB)
 		return fmt.Sprintf("%v\n%v", eventA, eventB)
 
+	case bytes.Equal(kvA.Key[:1], types.AutoCompoundDelegatorPrefix):
+		return fmt.Sprintf("%v\n%v", types.GetAutoCompoundDelegatorAddress(kvA.Key), types.GetAutoCompoundDelegatorAddress(kvB.Key))
+
+	case bytes.Equal(kvA.Key[:1], types.AutoCompoundCursorKey):
+		return fmt.Sprintf("%v\n%v", sdk.AccAddress(kvA.Value), sdk.AccAddress(kvB.Value))
+
+	case bytes.Equal(kvA.Key[:1], types.ValidatorCommissionWithdrawAddrPrefix):
+		return fmt.Sprintf("%v\n%v", sdk.AccAddress(kvA.Value), sdk.AccAddress(kvB.Value))
+
+	case bytes.Equal(kvA.Key[:1], types.ValidatorCommissionSplitsPrefix):
+		var splitsA, splitsB types.CommissionSplits
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &splitsA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &splitsB)
+		return fmt.Sprintf("%v\n%v", splitsA, splitsB)
+
+	case bytes.Equal(kvA.Key[:1], types.FundingStreamPrefix):
+		var streamA, streamB types.FundingStream
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &streamA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &streamB)
+		return fmt.Sprintf("%v\n%v", streamA, streamB)
+
+	case bytes.Equal(kvA.Key[:1], types.NextFundingStreamIDKey):
+		return fmt.Sprintf("%d\n%d", binary.BigEndian.Uint64(kvA.Value), binary.BigEndian.Uint64(kvB.Value))
+
 	default:
 		panic(fmt.Sprintf("invalid distribution key prefix %X", kvA.Key[:1]))
 	}
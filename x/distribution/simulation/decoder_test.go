@@ -1,8 +1,10 @@
 package simulation
 
 import (
+	"encoding/binary"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -41,6 +43,10 @@ func TestDecodeDistributionStore(t *testing.T) {
 	historicalRewards := types.NewValidatorHistoricalRewards(decCoins, 100)
 	currentRewards := types.NewValidatorCurrentRewards(decCoins, 5)
 	slashEvent := types.NewValidatorSlashEvent(10, sdk.OneDec())
+	stream := types.NewFundingStream(1, delAddr1, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)), time.Time{})
+	nextStreamID := make([]byte, 8)
+	binary.BigEndian.PutUint64(nextStreamID, 2)
+	splits := types.CommissionSplits{types.NewCommissionSplit(delAddr1, sdk.NewDecWithPrec(5, 1))}
 
 	kvPairs := tmkv.Pairs{
 		tmkv.Pair{Key: types.FeePoolKey, Value: cdc.MustMarshalBinaryLengthPrefixed(feePool)},
@@ -52,6 +58,12 @@ func TestDecodeDistributionStore(t *testing.T) {
 		tmkv.Pair{Key: types.GetValidatorCurrentRewardsKey(valAddr1), Value: cdc.MustMarshalBinaryLengthPrefixed(currentRewards)},
 		tmkv.Pair{Key: types.GetValidatorAccumulatedCommissionKey(valAddr1), Value: cdc.MustMarshalBinaryLengthPrefixed(commission)},
 		tmkv.Pair{Key: types.GetValidatorSlashEventKeyPrefix(valAddr1, 13), Value: cdc.MustMarshalBinaryLengthPrefixed(slashEvent)},
+		tmkv.Pair{Key: types.GetAutoCompoundDelegatorKey(delAddr1), Value: []byte{}},
+		tmkv.Pair{Key: types.AutoCompoundCursorKey, Value: delAddr1.Bytes()},
+		tmkv.Pair{Key: types.GetValidatorCommissionWithdrawAddrKey(valAddr1), Value: delAddr1.Bytes()},
+		tmkv.Pair{Key: types.GetValidatorCommissionSplitsKey(valAddr1), Value: cdc.MustMarshalBinaryLengthPrefixed(splits)},
+		tmkv.Pair{Key: types.GetFundingStreamKey(1), Value: cdc.MustMarshalBinaryLengthPrefixed(stream)},
+		tmkv.Pair{Key: types.NextFundingStreamIDKey, Value: nextStreamID},
 		tmkv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
 	}
 
@@ -68,6 +80,12 @@ func TestDecodeDistributionStore(t *testing.T) {
 		{"ValidatorCurrentRewards", fmt.Sprintf("%v\n%v", currentRewards, currentRewards)},
 		{"ValidatorAccumulatedCommission", fmt.Sprintf("%v\n%v", commission, commission)},
 		{"ValidatorSlashEvent", fmt.Sprintf("%v\n%v", slashEvent, slashEvent)},
+		{"AutoCompoundDelegator", fmt.Sprintf("%v\n%v", delAddr1, delAddr1)},
+		{"AutoCompoundCursor", fmt.Sprintf("%v\n%v", delAddr1, delAddr1)},
+		{"ValidatorCommissionWithdrawAddr", fmt.Sprintf("%v\n%v", delAddr1, delAddr1)},
+		{"ValidatorCommissionSplits", fmt.Sprintf("%v\n%v", splits, splits)},
+		{"FundingStream", fmt.Sprintf("%v\n%v", stream, stream)},
+		{"NextFundingStreamID", "2\n2"},
 		{"other", ""},
 	}
 	for i, tt := range tests {
@@ -19,6 +19,9 @@ const (
 	BaseProposerReward  = "base_proposer_reward"
 	BonusProposerReward = "bonus_proposer_reward"
 	WithdrawEnabled     = "withdraw_enabled"
+
+	AutoCompoundInterval     = "auto_compound_interval"
+	MaxAutoCompoundsPerBlock = "max_auto_compounds_per_block"
 )
 
 // GenCommunityTax randomized CommunityTax
@@ -41,6 +44,17 @@ func GenWithdrawEnabled(r *rand.Rand) bool {
 	return r.Int63n(101) <= 95 // 95% chance of withdraws being enabled
 }
 
+// GenAutoCompoundInterval returns a randomized AutoCompoundInterval parameter.
+func GenAutoCompoundInterval(r *rand.Rand) uint64 {
+	return uint64(r.Intn(20))
+}
+
+// GenMaxAutoCompoundsPerBlock returns a randomized MaxAutoCompoundsPerBlock
+// parameter.
+func GenMaxAutoCompoundsPerBlock(r *rand.Rand) uint32 {
+	return uint32(r.Intn(10) + 1)
+}
+
 // RandomizedGenState generates a random GenesisState for distribution
 func RandomizedGenState(simState *module.SimulationState) {
 	var communityTax sdk.Dec
@@ -67,6 +81,18 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { withdrawEnabled = GenWithdrawEnabled(r) },
 	)
 
+	var autoCompoundInterval uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, AutoCompoundInterval, &autoCompoundInterval, simState.Rand,
+		func(r *rand.Rand) { autoCompoundInterval = GenAutoCompoundInterval(r) },
+	)
+
+	var maxAutoCompoundsPerBlock uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxAutoCompoundsPerBlock, &maxAutoCompoundsPerBlock, simState.Rand,
+		func(r *rand.Rand) { maxAutoCompoundsPerBlock = GenMaxAutoCompoundsPerBlock(r) },
+	)
+
 	distrGenesis := types.GenesisState{
 		FeePool: types.InitialFeePool(),
 		Params: types.Params{
@@ -74,7 +100,11 @@ func RandomizedGenState(simState *module.SimulationState) {
 			BaseProposerReward:  baseProposerReward,
 			BonusProposerReward: bonusProposerReward,
 			WithdrawAddrEnabled: withdrawEnabled,
+
+			AutoCompoundInterval:     autoCompoundInterval,
+			MaxAutoCompoundsPerBlock: maxAutoCompoundsPerBlock,
 		},
+		NextFundingStreamID: 1,
 	}
 
 	fmt.Printf("Selected randomly generated distribution parameters:\n%s\n", codec.MustMarshalJSONIndent(simState.Cdc, distrGenesis))
@@ -21,6 +21,9 @@ const (
 	OpWeightMsgWithdrawDelegationReward    = "op_weight_msg_withdraw_delegation_reward"
 	OpWeightMsgWithdrawValidatorCommission = "op_weight_msg_withdraw_validator_commission"
 	OpWeightMsgFundCommunityPool           = "op_weight_msg_fund_community_pool"
+	OpWeightMsgSetAutoCompound             = "op_weight_msg_set_auto_compound"
+	OpWeightMsgSetCommissionWithdrawAddr   = "op_weight_msg_set_commission_withdraw_addr"
+	OpWeightMsgSetCommissionSplits         = "op_weight_msg_set_commission_splits"
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights
@@ -57,6 +60,27 @@ func WeightedOperations(
 		},
 	)
 
+	var weightMsgSetAutoCompound int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSetAutoCompound, &weightMsgSetAutoCompound, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetAutoCompound = simappparams.DefaultWeightMsgSetAutoCompound
+		},
+	)
+
+	var weightMsgSetCommissionWithdrawAddr int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSetCommissionWithdrawAddr, &weightMsgSetCommissionWithdrawAddr, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetCommissionWithdrawAddr = simappparams.DefaultWeightMsgSetCommissionWithdrawAddr
+		},
+	)
+
+	var weightMsgSetCommissionSplits int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSetCommissionSplits, &weightMsgSetCommissionSplits, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetCommissionSplits = simappparams.DefaultWeightMsgSetCommissionSplits
+		},
+	)
+
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgSetWithdrawAddress,
@@ -74,6 +98,18 @@ func WeightedOperations(
 			weightMsgFundCommunityPool,
 			SimulateMsgFundCommunityPool(ak, bk, k, sk),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgSetAutoCompound,
+			SimulateMsgSetAutoCompound(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSetCommissionWithdrawAddr,
+			SimulateMsgSetCommissionWithdrawAddress(ak, bk, k, sk),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSetCommissionSplits,
+			SimulateMsgSetCommissionSplits(ak, bk, k, sk),
+		),
 	}
 }
 
@@ -264,3 +300,157 @@ func SimulateMsgFundCommunityPool(ak types.AccountKeeper, bk types.BankKeeper, k
 		return simulation.NewOperationMsg(msg, true, ""), nil, nil
 	}
 }
+
+// SimulateMsgSetAutoCompound generates a MsgSetAutoCompound with random values.
+func SimulateMsgSetAutoCompound(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		simAccount, _ := simulation.RandomAcc(r, accs)
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		fees, err := simulation.RandomFees(r, ctx, spendable)
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		// opt in more often than out so that the rewards get compounded
+		msg := types.NewMsgSetAutoCompound(simAccount.Address, r.Intn(4) != 0)
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgSetCommissionWithdrawAddress generates a MsgSetCommissionWithdrawAddress with random values.
+func SimulateMsgSetCommissionWithdrawAddress(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper, sk stakingkeeper.Keeper) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		if !k.GetWithdrawAddrEnabled(ctx) {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		validator, ok := stakingkeeper.RandomValidator(r, sk, ctx)
+		if !ok {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		simAccount, found := simulation.FindAccount(accs, sdk.AccAddress(validator.GetOperator()))
+		if !found {
+			return simulation.NoOpMsg(types.ModuleName), nil, fmt.Errorf("validator %s not found", validator.GetOperator())
+		}
+
+		simToAccount, _ := simulation.RandomAcc(r, accs)
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		fees, err := simulation.RandomFees(r, ctx, spendable)
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		msg := types.NewMsgSetCommissionWithdrawAddress(validator.GetOperator(), simToAccount.Address)
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgSetCommissionSplits generates a MsgSetCommissionSplits with random values.
+func SimulateMsgSetCommissionSplits(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper, sk stakingkeeper.Keeper) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		if !k.GetWithdrawAddrEnabled(ctx) {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		validator, ok := stakingkeeper.RandomValidator(r, sk, ctx)
+		if !ok {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		simAccount, found := simulation.FindAccount(accs, sdk.AccAddress(validator.GetOperator()))
+		if !found {
+			return simulation.NoOpMsg(types.ModuleName), nil, fmt.Errorf("validator %s not found", validator.GetOperator())
+		}
+
+		// split among distinct random accounts, each ratio being at most one
+		// over the number of splits so that the ratios sum up to at most one
+		numSplits := r.Intn(4)
+		splits := make(types.CommissionSplits, 0, numSplits)
+		seen := make(map[string]bool)
+		for i := 0; i < numSplits; i++ {
+			splitAccount, _ := simulation.RandomAcc(r, accs)
+			if seen[splitAccount.Address.String()] {
+				continue
+			}
+			seen[splitAccount.Address.String()] = true
+
+			ratio := sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 1, 100)), 2).QuoInt64(int64(numSplits))
+			if !ratio.IsPositive() {
+				continue
+			}
+			splits = append(splits, types.NewCommissionSplit(splitAccount.Address, ratio))
+		}
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		fees, err := simulation.RandomFees(r, ctx, spendable)
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		msg := types.NewMsgSetCommissionSplits(validator.GetOperator(), splits)
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
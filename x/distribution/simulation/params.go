@@ -14,6 +14,8 @@ const (
 	keyCommunityTax        = "communitytax"
 	keyBaseProposerReward  = "baseproposerreward"
 	keyBonusProposerReward = "bonusproposerreward"
+
+	keyAutoCompoundInterval = "autocompoundinterval"
 )
 
 // ParamChanges defines the parameters that can be modified by param change proposals
@@ -35,5 +37,10 @@ func ParamChanges(r *rand.Rand) []simulation.ParamChange {
 				return fmt.Sprintf("\"%s\"", GenBonusProposerReward(r))
 			},
 		),
+		simulation.NewSimParamChange(types.ModuleName, keyAutoCompoundInterval,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenAutoCompoundInterval(r))
+			},
+		),
 	}
 }
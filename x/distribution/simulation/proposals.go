@@ -2,6 +2,7 @@ package simulation
 
 import (
 	"math/rand"
+	"time"
 
 	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -11,8 +12,15 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 )
 
-// OpWeightSubmitCommunitySpendProposal app params key for community spend proposal
-const OpWeightSubmitCommunitySpendProposal = "op_weight_submit_community_spend_proposal"
+// Simulation operation weights constants
+const (
+	// OpWeightSubmitCommunitySpendProposal app params key for community spend proposal
+	OpWeightSubmitCommunitySpendProposal = "op_weight_submit_community_spend_proposal"
+	// OpWeightSubmitFundingStreamProposal app params key for funding stream proposal
+	OpWeightSubmitFundingStreamProposal = "op_weight_submit_funding_stream_proposal"
+	// OpWeightSubmitCancelFundingStreamProposal app params key for cancel funding stream proposal
+	OpWeightSubmitCancelFundingStreamProposal = "op_weight_submit_cancel_funding_stream_proposal"
+)
 
 // ProposalContents defines the module weighted proposals' contents
 func ProposalContents(k keeper.Keeper) []simulation.WeightedProposalContent {
@@ -22,6 +30,16 @@ func ProposalContents(k keeper.Keeper) []simulation.WeightedProposalContent {
 			DefaultWeight:      simappparams.DefaultWeightCommunitySpendProposal,
 			ContentSimulatorFn: SimulateCommunityPoolSpendProposalContent(k),
 		},
+		{
+			AppParamsKey:       OpWeightSubmitFundingStreamProposal,
+			DefaultWeight:      simappparams.DefaultWeightFundingStreamProposal,
+			ContentSimulatorFn: SimulateFundingStreamProposalContent(k),
+		},
+		{
+			AppParamsKey:       OpWeightSubmitCancelFundingStreamProposal,
+			DefaultWeight:      simappparams.DefaultWeightCancelStreamProposal,
+			ContentSimulatorFn: SimulateCancelFundingStreamProposalContent(k),
+		},
 	}
 }
 
@@ -49,3 +67,53 @@ func SimulateCommunityPoolSpendProposalContent(k keeper.Keeper) simulation.Conte
 		)
 	}
 }
+
+// SimulateFundingStreamProposalContent generates random community pool funding
+// stream proposal content
+func SimulateFundingStreamProposalContent(k keeper.Keeper) simulation.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simulation.Account) govtypes.Content {
+		simAccount, _ := simulation.RandomAcc(r, accs)
+
+		balance := k.GetFeePool(ctx).CommunityPool
+		if balance.Empty() {
+			return nil
+		}
+
+		// pay a small part of the pool every block, up to a random number of blocks
+		denomIndex := r.Intn(len(balance))
+		poolAmount := balance[denomIndex].Amount.TruncateInt()
+		amount, err := simulation.RandPositiveInt(r, poolAmount.QuoRaw(100).AddRaw(1))
+		if err != nil {
+			return nil
+		}
+
+		amountPerBlock := sdk.NewCoins(sdk.NewCoin(balance[denomIndex].Denom, amount))
+		totalAmount := sdk.NewCoins(sdk.NewCoin(balance[denomIndex].Denom, amount.MulRaw(int64(simulation.RandIntBetween(r, 1, 50)))))
+
+		return types.NewFundingStreamProposal(
+			simulation.RandStringOfLength(r, 10),
+			simulation.RandStringOfLength(r, 100),
+			simAccount.Address,
+			amountPerBlock,
+			totalAmount,
+			time.Time{},
+		)
+	}
+}
+
+// SimulateCancelFundingStreamProposalContent generates random cancel funding
+// stream proposal content
+func SimulateCancelFundingStreamProposalContent(k keeper.Keeper) simulation.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simulation.Account) govtypes.Content {
+		streams := k.GetAllFundingStreams(ctx)
+		if len(streams) == 0 {
+			return nil
+		}
+
+		return types.NewCancelFundingStreamProposal(
+			simulation.RandStringOfLength(r, 10),
+			simulation.RandStringOfLength(r, 100),
+			streams[r.Intn(len(streams))].ID,
+		)
+	}
+}
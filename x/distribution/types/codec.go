@@ -9,7 +9,12 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgWithdrawDelegatorReward{}, "cosmos-sdk/MsgWithdrawDelegationReward", nil)
 	cdc.RegisterConcrete(MsgWithdrawValidatorCommission{}, "cosmos-sdk/MsgWithdrawValidatorCommission", nil)
 	cdc.RegisterConcrete(MsgSetWithdrawAddress{}, "cosmos-sdk/MsgModifyWithdrawAddress", nil)
+	cdc.RegisterConcrete(MsgSetAutoCompound{}, "cosmos-sdk/MsgSetAutoCompound", nil)
+	cdc.RegisterConcrete(MsgSetCommissionWithdrawAddress{}, "cosmos-sdk/MsgSetCommissionWithdrawAddress", nil)
+	cdc.RegisterConcrete(MsgSetCommissionSplits{}, "cosmos-sdk/MsgSetCommissionSplits", nil)
 	cdc.RegisterConcrete(CommunityPoolSpendProposal{}, "cosmos-sdk/CommunityPoolSpendProposal", nil)
+	cdc.RegisterConcrete(FundingStreamProposal{}, "cosmos-sdk/FundingStreamProposal", nil)
+	cdc.RegisterConcrete(CancelFundingStreamProposal{}, "cosmos-sdk/CancelFundingStreamProposal", nil)
 }
 
 // generic sealed codec to be used throughout module
@@ -0,0 +1,97 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxCommissionSplits is the maximum number of addresses the commission of a
+// validator can be split among
+const MaxCommissionSplits = 10
+
+// CommissionSplit defines the fixed ratio of the withdrawn commission of a
+// validator which is sent to an address
+type CommissionSplit struct {
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+	Ratio   sdk.Dec        `json:"ratio" yaml:"ratio"`
+}
+
+// NewCommissionSplit creates a new CommissionSplit instance
+func NewCommissionSplit(addr sdk.AccAddress, ratio sdk.Dec) CommissionSplit {
+	return CommissionSplit{
+		Address: addr,
+		Ratio:   ratio,
+	}
+}
+
+func (s CommissionSplit) String() string {
+	return fmt.Sprintf("%s:%s", s.Address, s.Ratio)
+}
+
+// CommissionSplits defines the splits of the withdrawn commission of a
+// validator, the part of the commission which is not split is sent to the
+// commission withdraw address of the validator
+type CommissionSplits []CommissionSplit
+
+func (splits CommissionSplits) String() string {
+	out := make([]string, len(splits))
+	for i, split := range splits {
+		out[i] = split.String()
+	}
+	return strings.Join(out, ",")
+}
+
+// Validate performs a basic validation of the commission splits. The ratios
+// must be positive and sum up to at most one, and the addresses must be
+// distinct.
+func (splits CommissionSplits) Validate() error {
+	if len(splits) > MaxCommissionSplits {
+		return sdkerrors.Wrapf(ErrInvalidCommissionSplits, "more than %d splits", MaxCommissionSplits)
+	}
+
+	total := sdk.ZeroDec()
+	seen := make(map[string]bool)
+	for _, split := range splits {
+		if split.Address.Empty() {
+			return sdkerrors.Wrap(ErrInvalidCommissionSplits, "split address cannot be empty")
+		}
+		if seen[split.Address.String()] {
+			return sdkerrors.Wrapf(ErrInvalidCommissionSplits, "duplicate split address %s", split.Address)
+		}
+		seen[split.Address.String()] = true
+
+		if split.Ratio.IsNil() || !split.Ratio.IsPositive() {
+			return sdkerrors.Wrapf(ErrInvalidCommissionSplits, "split ratio must be positive: %s", split.Ratio)
+		}
+		total = total.Add(split.Ratio)
+	}
+
+	if total.GT(sdk.OneDec()) {
+		return sdkerrors.Wrapf(ErrInvalidCommissionSplits, "split ratios sum up to more than one: %s", total)
+	}
+
+	return nil
+}
+
+// Split splits the commission by the ratios of the splits, truncating each
+// share. It returns the shares in the order of the splits and the remainder of
+// the commission which is not split.
+func (splits CommissionSplits) Split(commission sdk.Coins) (shares []sdk.Coins, remainder sdk.Coins) {
+	remainder = commission
+	shares = make([]sdk.Coins, len(splits))
+	for i, split := range splits {
+		var share sdk.Coins
+		for _, coin := range commission {
+			amount := coin.Amount.ToDec().Mul(split.Ratio).TruncateInt()
+			if amount.IsPositive() {
+				share = append(share, sdk.NewCoin(coin.Denom, amount))
+			}
+		}
+		shares[i] = share
+		remainder = remainder.Sub(share)
+	}
+	return shares, remainder
+}
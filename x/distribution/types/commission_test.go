@@ -0,0 +1,61 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestCommissionSplitsValidate(t *testing.T) {
+	tooMany := make(CommissionSplits, MaxCommissionSplits+1)
+	for i := range tooMany {
+		addr := make(sdk.AccAddress, sdk.AddrLen)
+		addr[0] = byte(i + 1)
+		tooMany[i] = NewCommissionSplit(addr, sdk.NewDecWithPrec(1, 2))
+	}
+
+	tests := []struct {
+		name       string
+		splits     CommissionSplits
+		expectPass bool
+	}{
+		{"empty", CommissionSplits{}, true},
+		{"whole commission", CommissionSplits{NewCommissionSplit(delAddr1, sdk.OneDec())}, true},
+		{"part of commission", CommissionSplits{NewCommissionSplit(delAddr1, sdk.NewDecWithPrec(3, 1)), NewCommissionSplit(delAddr2, sdk.NewDecWithPrec(2, 1))}, true},
+		{"too many splits", tooMany, false},
+		{"empty address", CommissionSplits{NewCommissionSplit(emptyDelAddr, sdk.OneDec())}, false},
+		{"duplicate address", CommissionSplits{NewCommissionSplit(delAddr1, sdk.NewDecWithPrec(3, 1)), NewCommissionSplit(delAddr1, sdk.NewDecWithPrec(2, 1))}, false},
+		{"zero ratio", CommissionSplits{NewCommissionSplit(delAddr1, sdk.ZeroDec())}, false},
+		{"negative ratio", CommissionSplits{NewCommissionSplit(delAddr1, sdk.NewDec(-1))}, false},
+		{"nil ratio", CommissionSplits{NewCommissionSplit(delAddr1, sdk.Dec{})}, false},
+		{"ratios above one", CommissionSplits{NewCommissionSplit(delAddr1, sdk.NewDecWithPrec(6, 1)), NewCommissionSplit(delAddr2, sdk.NewDecWithPrec(5, 1))}, false},
+	}
+	for _, tc := range tests {
+		if tc.expectPass {
+			require.NoError(t, tc.splits.Validate(), tc.name)
+		} else {
+			require.Error(t, tc.splits.Validate(), tc.name)
+		}
+	}
+}
+
+func TestCommissionSplitsSplit(t *testing.T) {
+	splits := CommissionSplits{
+		NewCommissionSplit(delAddr1, sdk.NewDecWithPrec(5, 1)),
+		NewCommissionSplit(delAddr2, sdk.NewDecWithPrec(25, 2)),
+	}
+	commission := sdk.NewCoins(sdk.NewInt64Coin("atom", 101), sdk.NewInt64Coin("stake", 3))
+
+	shares, remainder := splits.Split(commission)
+	require.Len(t, shares, 2)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 50), sdk.NewInt64Coin("stake", 1)), shares[0])
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 25)), shares[1])
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 26), sdk.NewInt64Coin("stake", 2)), remainder)
+
+	// no splits leave the whole commission
+	shares, remainder = CommissionSplits{}.Split(commission)
+	require.Empty(t, shares)
+	require.Equal(t, commission, remainder)
+}
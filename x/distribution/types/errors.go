@@ -18,4 +18,7 @@ var (
 	ErrEmptyProposalRecipient  = sdkerrors.Register(ModuleName, 10, "invalid community pool spend proposal recipient")
 	ErrNoValidatorExists       = sdkerrors.Register(ModuleName, 11, "validator does not exist")
 	ErrNoDelegationExists      = sdkerrors.Register(ModuleName, 12, "delegation does not exist")
+	ErrInvalidCommissionSplits = sdkerrors.Register(ModuleName, 13, "invalid commission splits")
+	ErrInvalidFundingStream    = sdkerrors.Register(ModuleName, 14, "invalid community pool funding stream")
+	ErrNoFundingStream         = sdkerrors.Register(ModuleName, 15, "community pool funding stream does not exist")
 )
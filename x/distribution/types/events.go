@@ -8,9 +8,22 @@ const (
 	EventTypeWithdrawRewards    = "withdraw_rewards"
 	EventTypeWithdrawCommission = "withdraw_commission"
 	EventTypeProposerReward     = "proposer_reward"
+	EventTypeSetAutoCompound    = "set_auto_compound"
+	EventTypeAutoCompound       = "auto_compound"
+
+	EventTypeSetCommissionWithdrawAddress = "set_commission_withdraw_address"
+	EventTypeSetCommissionSplits          = "set_commission_splits"
+	EventTypeSplitCommission              = "split_commission"
+	EventTypeFundingStreamPayout          = "funding_stream_payout"
+	EventTypeFundingStreamFinished        = "funding_stream_finished"
 
 	AttributeKeyWithdrawAddress = "withdraw_address"
 	AttributeKeyValidator       = "validator"
+	AttributeKeyDelegator       = "delegator"
+	AttributeKeyEnabled         = "enabled"
+	AttributeKeyRecipient       = "recipient"
+	AttributeKeySplits          = "splits"
+	AttributeKeyStreamID        = "stream_id"
 
 	AttributeValueCategory = ModuleName
 )
@@ -58,6 +58,15 @@ type StakingKeeper interface {
 	GetLastValidatorPower(ctx sdk.Context, valAddr sdk.ValAddress) int64
 
 	GetAllSDKDelegations(ctx sdk.Context) []staking.Delegation
+
+	BondDenom(ctx sdk.Context) string
+	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (validator staking.Validator, found bool)
+	Delegate(ctx sdk.Context, delAddr sdk.AccAddress, bondAmt sdk.Int, tokenSrc sdk.BondStatus,
+		validator staking.Validator, subtractAccount bool) (newShares sdk.Dec, err error)
+
+	// DelegationRewardMultiplier returns the multiplier applied to the rewards
+	// of a delegation, which is greater than one while the delegation is locked.
+	DelegationRewardMultiplier(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) sdk.Dec
 }
 
 // StakingHooks event hooks for staking validator object (noalias)
@@ -0,0 +1,115 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FundingStream pays a recipient a fixed amount from the community pool every
+// block until the total amount has been paid or the end time is reached. An
+// empty total amount or a zero end time leaves the stream unbounded by it.
+type FundingStream struct {
+	ID             uint64         `json:"id" yaml:"id"`
+	Recipient      sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	AmountPerBlock sdk.Coins      `json:"amount_per_block" yaml:"amount_per_block"`
+	TotalAmount    sdk.Coins      `json:"total_amount" yaml:"total_amount"`
+	EndTime        time.Time      `json:"end_time" yaml:"end_time"`
+	Paid           sdk.Coins      `json:"paid" yaml:"paid"`
+}
+
+// NewFundingStream creates a new FundingStream instance
+func NewFundingStream(
+	id uint64, recipient sdk.AccAddress, amountPerBlock, totalAmount sdk.Coins, endTime time.Time,
+) FundingStream {
+	return FundingStream{
+		ID:             id,
+		Recipient:      recipient,
+		AmountPerBlock: amountPerBlock,
+		TotalAmount:    totalAmount,
+		EndTime:        endTime,
+	}
+}
+
+// NextPayout returns the amount paid to the recipient in the next block, which
+// is capped by the rest of the total amount
+func (fs FundingStream) NextPayout() sdk.Coins {
+	if fs.TotalAmount.Empty() {
+		return fs.AmountPerBlock
+	}
+
+	remaining := fs.TotalAmount.Sub(fs.Paid)
+	var payout sdk.Coins
+	for _, coin := range fs.AmountPerBlock {
+		amount := sdk.MinInt(coin.Amount, remaining.AmountOf(coin.Denom))
+		if amount.IsPositive() {
+			payout = append(payout, sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+	return payout
+}
+
+// IsFinished returns whether the total amount has been paid, or nothing of it
+// can be paid anymore, or the end time has been reached
+func (fs FundingStream) IsFinished(blockTime time.Time) bool {
+	if !fs.TotalAmount.Empty() && (fs.Paid.IsAllGTE(fs.TotalAmount) || fs.NextPayout().Empty()) {
+		return true
+	}
+	return !fs.EndTime.IsZero() && !blockTime.Before(fs.EndTime)
+}
+
+// Validate performs a basic validation of the funding stream parameters
+func (fs FundingStream) Validate() error {
+	return validateFundingStream(fs.Recipient, fs.AmountPerBlock, fs.TotalAmount, fs.EndTime)
+}
+
+func (fs FundingStream) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Funding Stream %d:
+  Recipient:        %s
+  Amount Per Block: %s
+  Total Amount:     %s
+  End Time:         %s
+  Paid:             %s`,
+		fs.ID, fs.Recipient, fs.AmountPerBlock, fs.TotalAmount, fs.EndTime, fs.Paid,
+	))
+}
+
+// FundingStreams is a collection of FundingStream
+type FundingStreams []FundingStream
+
+func (streams FundingStreams) String() string {
+	out := make([]string, len(streams))
+	for i, stream := range streams {
+		out[i] = stream.String()
+	}
+	return strings.Join(out, "\n")
+}
+
+func validateFundingStream(recipient sdk.AccAddress, amountPerBlock, totalAmount sdk.Coins, endTime time.Time) error {
+	if recipient.Empty() {
+		return ErrEmptyProposalRecipient
+	}
+	if amountPerBlock.Empty() || !amountPerBlock.IsValid() {
+		return ErrInvalidFundingStream
+	}
+	if !totalAmount.IsValid() {
+		return ErrInvalidFundingStream
+	}
+	if totalAmount.Empty() && endTime.IsZero() {
+		return ErrInvalidFundingStream
+	}
+	// every coin paid per block must be capped by the total amount
+	if !totalAmount.Empty() && !totalAmount.IsAllGTE(amountPerBlock) {
+		return ErrInvalidFundingStream
+	}
+	// every coin of the total amount must be paid per block, or it would never
+	// be paid in full
+	for _, coin := range totalAmount {
+		if amountPerBlock.AmountOf(coin.Denom).IsZero() {
+			return ErrInvalidFundingStream
+		}
+	}
+	return nil
+}
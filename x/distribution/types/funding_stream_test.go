@@ -0,0 +1,72 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestFundingStreamValidate(t *testing.T) {
+	perBlock := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	total := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	endTime := time.Unix(100, 0)
+
+	tests := []struct {
+		name       string
+		stream     FundingStream
+		expectPass bool
+	}{
+		{"total amount", NewFundingStream(1, delAddr1, perBlock, total, time.Time{}), true},
+		{"end time", NewFundingStream(1, delAddr1, perBlock, sdk.Coins{}, endTime), true},
+		{"total amount and end time", NewFundingStream(1, delAddr1, perBlock, total, endTime), true},
+		{"empty recipient", NewFundingStream(1, emptyDelAddr, perBlock, total, endTime), false},
+		{"empty amount per block", NewFundingStream(1, delAddr1, sdk.Coins{}, total, endTime), false},
+		{"unbounded", NewFundingStream(1, delAddr1, perBlock, sdk.Coins{}, time.Time{}), false},
+		{"amount per block above total", NewFundingStream(1, delAddr1, total, perBlock, endTime), false},
+		{"denom not in total", NewFundingStream(1, delAddr1, sdk.NewCoins(sdk.NewInt64Coin("atom", 1)), total, endTime), false},
+		{"denom not paid per block", NewFundingStream(1, delAddr1, perBlock, total.Add(sdk.NewInt64Coin("atom", 100)), time.Time{}), false},
+	}
+	for _, tc := range tests {
+		if tc.expectPass {
+			require.NoError(t, tc.stream.Validate(), tc.name)
+		} else {
+			require.Error(t, tc.stream.Validate(), tc.name)
+		}
+	}
+}
+
+func TestFundingStreamPayout(t *testing.T) {
+	perBlock := sdk.NewCoins(sdk.NewInt64Coin("atom", 5), sdk.NewInt64Coin("stake", 10))
+	total := sdk.NewCoins(sdk.NewInt64Coin("atom", 100), sdk.NewInt64Coin("stake", 25))
+	stream := NewFundingStream(1, delAddr1, perBlock, total, time.Time{})
+
+	require.Equal(t, perBlock, stream.NextPayout())
+	require.False(t, stream.IsFinished(time.Unix(100, 0)))
+
+	// the payout is capped by the rest of the total amount
+	stream.Paid = sdk.NewCoins(sdk.NewInt64Coin("atom", 10), sdk.NewInt64Coin("stake", 20))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 5), sdk.NewInt64Coin("stake", 5)), stream.NextPayout())
+
+	stream.Paid = sdk.NewCoins(sdk.NewInt64Coin("atom", 15), sdk.NewInt64Coin("stake", 25))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 5)), stream.NextPayout())
+	require.False(t, stream.IsFinished(time.Unix(100, 0)))
+
+	stream.Paid = total
+	require.True(t, stream.IsFinished(time.Unix(100, 0)))
+
+	// a stream whose total amount has a denom not paid per block finishes once
+	// nothing else is left to pay
+	stream = NewFundingStream(1, delAddr1, perBlock, total.Add(sdk.NewInt64Coin("osmo", 10)), time.Time{})
+	stream.Paid = total
+	require.Empty(t, stream.NextPayout())
+	require.True(t, stream.IsFinished(time.Unix(100, 0)))
+
+	// a stream bounded by its end time only pays the full amount per block
+	stream = NewFundingStream(1, delAddr1, perBlock, sdk.Coins{}, time.Unix(100, 0))
+	require.Equal(t, perBlock, stream.NextPayout())
+	require.False(t, stream.IsFinished(time.Unix(99, 0)))
+	require.True(t, stream.IsFinished(time.Unix(100, 0)))
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -51,6 +53,18 @@ type ValidatorSlashEventRecord struct {
 	Event            ValidatorSlashEvent `json:"validator_slash_event" yaml:"validator_slash_event"`
 }
 
+// used for import / export via genesis json
+type ValidatorCommissionWithdrawInfo struct {
+	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+	WithdrawAddress  sdk.AccAddress `json:"withdraw_address" yaml:"withdraw_address"`
+}
+
+// used for import / export via genesis json
+type ValidatorCommissionSplitsRecord struct {
+	ValidatorAddress sdk.ValAddress   `json:"validator_address" yaml:"validator_address"`
+	Splits           CommissionSplits `json:"splits" yaml:"splits"`
+}
+
 // GenesisState - all distribution state that must be provided at genesis
 type GenesisState struct {
 	Params                          Params                                 `json:"params" yaml:"params"`
@@ -63,12 +77,21 @@ type GenesisState struct {
 	ValidatorCurrentRewards         []ValidatorCurrentRewardsRecord        `json:"validator_current_rewards" yaml:"validator_current_rewards"`
 	DelegatorStartingInfos          []DelegatorStartingInfoRecord          `json:"delegator_starting_infos" yaml:"delegator_starting_infos"`
 	ValidatorSlashEvents            []ValidatorSlashEventRecord            `json:"validator_slash_events" yaml:"validator_slash_events"`
+	AutoCompoundDelegators          []sdk.AccAddress                       `json:"auto_compound_delegators" yaml:"auto_compound_delegators"`
+	AutoCompoundCursor              sdk.AccAddress                         `json:"auto_compound_cursor" yaml:"auto_compound_cursor"`
+	CommissionWithdrawInfos         []ValidatorCommissionWithdrawInfo      `json:"commission_withdraw_infos" yaml:"commission_withdraw_infos"`
+	CommissionSplits                []ValidatorCommissionSplitsRecord      `json:"commission_splits" yaml:"commission_splits"`
+	FundingStreams                  []FundingStream                        `json:"funding_streams" yaml:"funding_streams"`
+	NextFundingStreamID             uint64                                 `json:"next_funding_stream_id" yaml:"next_funding_stream_id"`
 }
 
 func NewGenesisState(
 	params Params, fp FeePool, dwis []DelegatorWithdrawInfo, pp sdk.ConsAddress, r []ValidatorOutstandingRewardsRecord,
 	acc []ValidatorAccumulatedCommissionRecord, historical []ValidatorHistoricalRewardsRecord,
 	cur []ValidatorCurrentRewardsRecord, dels []DelegatorStartingInfoRecord, slashes []ValidatorSlashEventRecord,
+	autoCompounds []sdk.AccAddress, autoCompoundCursor sdk.AccAddress,
+	commissionWithdrawInfos []ValidatorCommissionWithdrawInfo, commissionSplits []ValidatorCommissionSplitsRecord,
+	fundingStreams []FundingStream, nextFundingStreamID uint64,
 ) GenesisState {
 
 	return GenesisState{
@@ -82,6 +105,12 @@ func NewGenesisState(
 		ValidatorCurrentRewards:         cur,
 		DelegatorStartingInfos:          dels,
 		ValidatorSlashEvents:            slashes,
+		AutoCompoundDelegators:          autoCompounds,
+		AutoCompoundCursor:              autoCompoundCursor,
+		CommissionWithdrawInfos:         commissionWithdrawInfos,
+		CommissionSplits:                commissionSplits,
+		FundingStreams:                  fundingStreams,
+		NextFundingStreamID:             nextFundingStreamID,
 	}
 }
 
@@ -98,6 +127,11 @@ func DefaultGenesisState() GenesisState {
 		ValidatorCurrentRewards:         []ValidatorCurrentRewardsRecord{},
 		DelegatorStartingInfos:          []DelegatorStartingInfoRecord{},
 		ValidatorSlashEvents:            []ValidatorSlashEventRecord{},
+		AutoCompoundDelegators:          []sdk.AccAddress{},
+		CommissionWithdrawInfos:         []ValidatorCommissionWithdrawInfo{},
+		CommissionSplits:                []ValidatorCommissionSplitsRecord{},
+		FundingStreams:                  []FundingStream{},
+		NextFundingStreamID:             1,
 	}
 }
 
@@ -106,5 +140,28 @@ func ValidateGenesis(gs GenesisState) error {
 	if err := gs.Params.ValidateBasic(); err != nil {
 		return err
 	}
+	for _, delAddr := range gs.AutoCompoundDelegators {
+		if delAddr.Empty() {
+			return fmt.Errorf("auto-compounding delegator address cannot be empty")
+		}
+	}
+	for _, info := range gs.CommissionWithdrawInfos {
+		if info.WithdrawAddress.Empty() {
+			return fmt.Errorf("commission withdraw address of validator %s cannot be empty", info.ValidatorAddress)
+		}
+	}
+	for _, record := range gs.CommissionSplits {
+		if err := record.Splits.Validate(); err != nil {
+			return fmt.Errorf("invalid commission splits of validator %s: %w", record.ValidatorAddress, err)
+		}
+	}
+	for _, stream := range gs.FundingStreams {
+		if err := stream.Validate(); err != nil {
+			return fmt.Errorf("invalid funding stream %d: %w", stream.ID, err)
+		}
+		if stream.ID >= gs.NextFundingStreamID {
+			return fmt.Errorf("funding stream id %d is not lower than the next funding stream id %d", stream.ID, gs.NextFundingStreamID)
+		}
+	}
 	return gs.FeePool.ValidateGenesis()
 }
@@ -40,6 +40,18 @@ const (
 // - 0x07<valAddr_Bytes>: ValidatorCurrentRewards
 //
 // - 0x08<valAddr_Bytes><height>: ValidatorSlashEvent
+//
+// - 0x09<accAddr_Bytes>: []byte{}
+//
+// - 0x0A: sdk.AccAddress
+//
+// - 0x0B<valAddr_Bytes>: sdk.AccAddress
+//
+// - 0x0C<valAddr_Bytes>: CommissionSplits
+//
+// - 0x0D<streamID_Bytes>: FundingStream
+//
+// - 0x0E: uint64
 var (
 	FeePoolKey                        = []byte{0x00} // key for global distribution state
 	ProposerKey                       = []byte{0x01} // key for the proposer operator address
@@ -51,6 +63,13 @@ var (
 	ValidatorCurrentRewardsPrefix        = []byte{0x06} // key for current validator rewards
 	ValidatorAccumulatedCommissionPrefix = []byte{0x07} // key for accumulated validator commission
 	ValidatorSlashEventPrefix            = []byte{0x08} // key for validator slash fraction
+	AutoCompoundDelegatorPrefix          = []byte{0x09} // key for delegators opted in to auto-compounding
+	AutoCompoundCursorKey                = []byte{0x0A} // key for the last delegator compounded in the current round
+
+	ValidatorCommissionWithdrawAddrPrefix = []byte{0x0B} // key for validator commission withdraw address
+	ValidatorCommissionSplitsPrefix       = []byte{0x0C} // key for validator commission splits
+	FundingStreamPrefix                   = []byte{0x0D} // key for community pool funding streams
+	NextFundingStreamIDKey                = []byte{0x0E} // key for the id of the next funding stream
 )
 
 // gets an address from a validator's outstanding rewards key
@@ -119,6 +138,42 @@ func GetValidatorAccumulatedCommissionAddress(key []byte) (valAddr sdk.ValAddres
 	return sdk.ValAddress(addr)
 }
 
+// gets the address from an auto-compounding delegator key
+func GetAutoCompoundDelegatorAddress(key []byte) (delAddr sdk.AccAddress) {
+	addr := key[1:]
+	if len(addr) != sdk.AddrLen {
+		panic("unexpected key length")
+	}
+	return sdk.AccAddress(addr)
+}
+
+// gets the address from a validator's commission withdraw address key
+func GetValidatorCommissionWithdrawAddrAddress(key []byte) (valAddr sdk.ValAddress) {
+	addr := key[1:]
+	if len(addr) != sdk.AddrLen {
+		panic("unexpected key length")
+	}
+	return sdk.ValAddress(addr)
+}
+
+// gets the address from a validator's commission splits key
+func GetValidatorCommissionSplitsAddress(key []byte) (valAddr sdk.ValAddress) {
+	addr := key[1:]
+	if len(addr) != sdk.AddrLen {
+		panic("unexpected key length")
+	}
+	return sdk.ValAddress(addr)
+}
+
+// gets the id from a funding stream key
+func GetFundingStreamID(key []byte) (id uint64) {
+	b := key[1:]
+	if len(b) != 8 {
+		panic("unexpected key length")
+	}
+	return binary.BigEndian.Uint64(b)
+}
+
 // gets the height from a validator's slash event key
 func GetValidatorSlashEventAddressHeight(key []byte) (valAddr sdk.ValAddress, height uint64) {
 	addr := key[1 : 1+sdk.AddrLen]
@@ -191,3 +246,25 @@ func GetValidatorSlashEventKey(v sdk.ValAddress, height, period uint64) []byte {
 	prefix := GetValidatorSlashEventKeyPrefix(v, height)
 	return append(prefix, periodBz...)
 }
+
+// gets the key for a delegator opted in to auto-compounding
+func GetAutoCompoundDelegatorKey(delAddr sdk.AccAddress) []byte {
+	return append(AutoCompoundDelegatorPrefix, delAddr.Bytes()...)
+}
+
+// gets the key for a validator's commission withdraw address
+func GetValidatorCommissionWithdrawAddrKey(v sdk.ValAddress) []byte {
+	return append(ValidatorCommissionWithdrawAddrPrefix, v.Bytes()...)
+}
+
+// gets the key for a validator's commission splits
+func GetValidatorCommissionSplitsKey(v sdk.ValAddress) []byte {
+	return append(ValidatorCommissionSplitsPrefix, v.Bytes()...)
+}
+
+// gets the key for a funding stream
+func GetFundingStreamKey(id uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, id)
+	return append(FundingStreamPrefix, b...)
+}
@@ -167,3 +167,154 @@ func (msg MsgFundCommunityPool) ValidateBasic() error {
 
 	return nil
 }
+
+const TypeMsgSetAutoCompound = "set_auto_compound"
+
+var _ sdk.Msg = &MsgSetAutoCompound{}
+
+// MsgSetAutoCompound defines a Msg type that allows a delegator to opt in or
+// out of the periodic compounding of its staking rewards.
+type MsgSetAutoCompound struct {
+	DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
+	Enabled          bool           `json:"enabled" yaml:"enabled"`
+}
+
+// NewMsgSetAutoCompound returns a new MsgSetAutoCompound with a delegator and
+// whether its rewards are compounded.
+func NewMsgSetAutoCompound(delAddr sdk.AccAddress, enabled bool) MsgSetAutoCompound {
+	return MsgSetAutoCompound{
+		DelegatorAddress: delAddr,
+		Enabled:          enabled,
+	}
+}
+
+// Route returns the MsgSetAutoCompound message route.
+func (msg MsgSetAutoCompound) Route() string { return ModuleName }
+
+// Type returns the MsgSetAutoCompound message type.
+func (msg MsgSetAutoCompound) Type() string { return TypeMsgSetAutoCompound }
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgSetAutoCompound) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelegatorAddress}
+}
+
+// GetSignBytes returns the raw bytes for a MsgSetAutoCompound message that
+// the expected signer needs to sign.
+func (msg MsgSetAutoCompound) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs basic MsgSetAutoCompound message validation.
+func (msg MsgSetAutoCompound) ValidateBasic() error {
+	if msg.DelegatorAddress.Empty() {
+		return ErrEmptyDelegatorAddr
+	}
+
+	return nil
+}
+
+const TypeMsgSetCommissionWithdrawAddress = "set_commission_withdraw_address"
+
+var _ sdk.Msg = &MsgSetCommissionWithdrawAddress{}
+
+// MsgSetCommissionWithdrawAddress defines a Msg type that allows a validator
+// to change the address its withdrawn commission is sent to.
+type MsgSetCommissionWithdrawAddress struct {
+	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+	WithdrawAddress  sdk.AccAddress `json:"withdraw_address" yaml:"withdraw_address"`
+}
+
+// NewMsgSetCommissionWithdrawAddress returns a new MsgSetCommissionWithdrawAddress
+// with a validator and a commission withdraw address.
+func NewMsgSetCommissionWithdrawAddress(valAddr sdk.ValAddress, withdrawAddr sdk.AccAddress) MsgSetCommissionWithdrawAddress {
+	return MsgSetCommissionWithdrawAddress{
+		ValidatorAddress: valAddr,
+		WithdrawAddress:  withdrawAddr,
+	}
+}
+
+// Route returns the MsgSetCommissionWithdrawAddress message route.
+func (msg MsgSetCommissionWithdrawAddress) Route() string { return ModuleName }
+
+// Type returns the MsgSetCommissionWithdrawAddress message type.
+func (msg MsgSetCommissionWithdrawAddress) Type() string {
+	return TypeMsgSetCommissionWithdrawAddress
+}
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgSetCommissionWithdrawAddress) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.ValidatorAddress.Bytes())}
+}
+
+// GetSignBytes returns the raw bytes for a MsgSetCommissionWithdrawAddress
+// message that the expected signer needs to sign.
+func (msg MsgSetCommissionWithdrawAddress) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs basic MsgSetCommissionWithdrawAddress message
+// validation.
+func (msg MsgSetCommissionWithdrawAddress) ValidateBasic() error {
+	if msg.ValidatorAddress.Empty() {
+		return ErrEmptyValidatorAddr
+	}
+	if msg.WithdrawAddress.Empty() {
+		return ErrEmptyWithdrawAddr
+	}
+
+	return nil
+}
+
+const TypeMsgSetCommissionSplits = "set_commission_splits"
+
+var _ sdk.Msg = &MsgSetCommissionSplits{}
+
+// MsgSetCommissionSplits defines a Msg type that allows a validator to split
+// its withdrawn commission among several addresses by fixed ratios. Empty
+// splits send the whole commission to the commission withdraw address.
+type MsgSetCommissionSplits struct {
+	ValidatorAddress sdk.ValAddress   `json:"validator_address" yaml:"validator_address"`
+	Splits           CommissionSplits `json:"splits" yaml:"splits"`
+}
+
+// NewMsgSetCommissionSplits returns a new MsgSetCommissionSplits with a
+// validator and the splits of its commission.
+func NewMsgSetCommissionSplits(valAddr sdk.ValAddress, splits CommissionSplits) MsgSetCommissionSplits {
+	return MsgSetCommissionSplits{
+		ValidatorAddress: valAddr,
+		Splits:           splits,
+	}
+}
+
+// Route returns the MsgSetCommissionSplits message route.
+func (msg MsgSetCommissionSplits) Route() string { return ModuleName }
+
+// Type returns the MsgSetCommissionSplits message type.
+func (msg MsgSetCommissionSplits) Type() string { return TypeMsgSetCommissionSplits }
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgSetCommissionSplits) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.ValidatorAddress.Bytes())}
+}
+
+// GetSignBytes returns the raw bytes for a MsgSetCommissionSplits message
+// that the expected signer needs to sign.
+func (msg MsgSetCommissionSplits) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs basic MsgSetCommissionSplits message validation.
+func (msg MsgSetCommissionSplits) ValidateBasic() error {
+	if msg.ValidatorAddress.Empty() {
+		return ErrEmptyValidatorAddr
+	}
+
+	return msg.Splits.Validate()
+}
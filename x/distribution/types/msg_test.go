@@ -93,3 +93,49 @@ func TestMsgDepositIntoCommunityPool(t *testing.T) {
 		}
 	}
 }
+
+// test ValidateBasic for MsgSetCommissionWithdrawAddress
+func TestMsgSetCommissionWithdrawAddress(t *testing.T) {
+	tests := []struct {
+		validatorAddr sdk.ValAddress
+		withdrawAddr  sdk.AccAddress
+		expectPass    bool
+	}{
+		{valAddr1, delAddr1, true},
+		{emptyValAddr, delAddr1, false},
+		{valAddr1, emptyDelAddr, false},
+		{emptyValAddr, emptyDelAddr, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgSetCommissionWithdrawAddress(tc.validatorAddr, tc.withdrawAddr)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
+// test ValidateBasic for MsgSetCommissionSplits
+func TestMsgSetCommissionSplits(t *testing.T) {
+	half := sdk.NewDecWithPrec(5, 1)
+	tests := []struct {
+		validatorAddr sdk.ValAddress
+		splits        CommissionSplits
+		expectPass    bool
+	}{
+		{valAddr1, CommissionSplits{}, true},
+		{valAddr1, CommissionSplits{NewCommissionSplit(delAddr1, half), NewCommissionSplit(delAddr2, half)}, true},
+		{emptyValAddr, CommissionSplits{}, false},
+		{valAddr1, CommissionSplits{NewCommissionSplit(delAddr1, half), NewCommissionSplit(delAddr1, half)}, false},
+		{valAddr1, CommissionSplits{NewCommissionSplit(emptyDelAddr, half)}, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgSetCommissionSplits(tc.validatorAddr, tc.splits)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
@@ -20,6 +20,9 @@ var (
 	ParamStoreKeyBaseProposerReward  = []byte("baseproposerreward")
 	ParamStoreKeyBonusProposerReward = []byte("bonusproposerreward")
 	ParamStoreKeyWithdrawAddrEnabled = []byte("withdrawaddrenabled")
+
+	ParamStoreKeyAutoCompoundInterval     = []byte("autocompoundinterval")
+	ParamStoreKeyMaxAutoCompoundsPerBlock = []byte("maxautocompoundsperblock")
 )
 
 // Params defines the set of distribution parameters.
@@ -28,6 +31,13 @@ type Params struct {
 	BaseProposerReward  sdk.Dec `json:"base_proposer_reward" yaml:"base_proposer_reward"`
 	BonusProposerReward sdk.Dec `json:"bonus_proposer_reward" yaml:"bonus_proposer_reward"`
 	WithdrawAddrEnabled bool    `json:"withdraw_addr_enabled" yaml:"withdraw_addr_enabled"`
+
+	// AutoCompoundInterval is the number of blocks between the starts of two
+	// auto-compounding rounds, zero disables auto-compounding
+	AutoCompoundInterval uint64 `json:"auto_compound_interval" yaml:"auto_compound_interval"`
+	// MaxAutoCompoundsPerBlock is the maximum number of delegators whose rewards
+	// are compounded within a single block
+	MaxAutoCompoundsPerBlock uint32 `json:"max_auto_compounds_per_block" yaml:"max_auto_compounds_per_block"`
 }
 
 // ParamKeyTable returns the parameter key table.
@@ -42,6 +52,9 @@ func DefaultParams() Params {
 		BaseProposerReward:  sdk.NewDecWithPrec(1, 2), // 1%
 		BonusProposerReward: sdk.NewDecWithPrec(4, 2), // 4%
 		WithdrawAddrEnabled: true,
+
+		AutoCompoundInterval:     100,
+		MaxAutoCompoundsPerBlock: 100,
 	}
 }
 
@@ -57,6 +70,8 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		params.NewParamSetPair(ParamStoreKeyBaseProposerReward, &p.BaseProposerReward, validateBaseProposerReward),
 		params.NewParamSetPair(ParamStoreKeyBonusProposerReward, &p.BonusProposerReward, validateBonusProposerReward),
 		params.NewParamSetPair(ParamStoreKeyWithdrawAddrEnabled, &p.WithdrawAddrEnabled, validateWithdrawAddrEnabled),
+		params.NewParamSetPair(ParamStoreKeyAutoCompoundInterval, &p.AutoCompoundInterval, validateAutoCompoundInterval),
+		params.NewParamSetPair(ParamStoreKeyMaxAutoCompoundsPerBlock, &p.MaxAutoCompoundsPerBlock, validateMaxAutoCompoundsPerBlock),
 	}
 }
 
@@ -82,6 +97,11 @@ func (p Params) ValidateBasic() error {
 			"sum of base and bonus proposer reward cannot greater than one: %s", v,
 		)
 	}
+	if p.MaxAutoCompoundsPerBlock == 0 {
+		return fmt.Errorf(
+			"max auto-compounds per block should be positive: %d", p.MaxAutoCompoundsPerBlock,
+		)
+	}
 
 	return nil
 }
@@ -151,3 +171,25 @@ func validateWithdrawAddrEnabled(i interface{}) error {
 
 	return nil
 }
+
+func validateAutoCompoundInterval(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMaxAutoCompoundsPerBlock(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("max auto-compounds per block must be positive: %d", v)
+	}
+
+	return nil
+}
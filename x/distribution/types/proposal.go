@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -11,14 +12,26 @@ import (
 const (
 	// ProposalTypeCommunityPoolSpend defines the type for a CommunityPoolSpendProposal
 	ProposalTypeCommunityPoolSpend = "CommunityPoolSpend"
+	// ProposalTypeFundingStream defines the type for a FundingStreamProposal
+	ProposalTypeFundingStream = "FundingStream"
+	// ProposalTypeCancelFundingStream defines the type for a CancelFundingStreamProposal
+	ProposalTypeCancelFundingStream = "CancelFundingStream"
 )
 
-// Assert CommunityPoolSpendProposal implements govtypes.Content at compile-time
-var _ govtypes.Content = CommunityPoolSpendProposal{}
+// Assert the proposals implement govtypes.Content at compile-time
+var (
+	_ govtypes.Content = CommunityPoolSpendProposal{}
+	_ govtypes.Content = FundingStreamProposal{}
+	_ govtypes.Content = CancelFundingStreamProposal{}
+)
 
 func init() {
 	govtypes.RegisterProposalType(ProposalTypeCommunityPoolSpend)
 	govtypes.RegisterProposalTypeCodec(CommunityPoolSpendProposal{}, "cosmos-sdk/CommunityPoolSpendProposal")
+	govtypes.RegisterProposalType(ProposalTypeFundingStream)
+	govtypes.RegisterProposalTypeCodec(FundingStreamProposal{}, "cosmos-sdk/FundingStreamProposal")
+	govtypes.RegisterProposalType(ProposalTypeCancelFundingStream)
+	govtypes.RegisterProposalTypeCodec(CancelFundingStreamProposal{}, "cosmos-sdk/CancelFundingStreamProposal")
 }
 
 // CommunityPoolSpendProposal spends from the community pool
@@ -73,3 +86,98 @@ func (csp CommunityPoolSpendProposal) String() string {
 `, csp.Title, csp.Description, csp.Recipient, csp.Amount))
 	return b.String()
 }
+
+// FundingStreamProposal starts a stream paying a recipient a fixed amount from
+// the community pool every block until the total amount has been paid or the
+// end time is reached
+type FundingStreamProposal struct {
+	Title          string         `json:"title" yaml:"title"`
+	Description    string         `json:"description" yaml:"description"`
+	Recipient      sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	AmountPerBlock sdk.Coins      `json:"amount_per_block" yaml:"amount_per_block"`
+	TotalAmount    sdk.Coins      `json:"total_amount" yaml:"total_amount"`
+	EndTime        time.Time      `json:"end_time" yaml:"end_time"`
+}
+
+// NewFundingStreamProposal creates a new funding stream proposal.
+func NewFundingStreamProposal(
+	title, description string, recipient sdk.AccAddress, amountPerBlock, totalAmount sdk.Coins, endTime time.Time,
+) FundingStreamProposal {
+	return FundingStreamProposal{title, description, recipient, amountPerBlock, totalAmount, endTime}
+}
+
+// GetTitle returns the title of a funding stream proposal.
+func (fsp FundingStreamProposal) GetTitle() string { return fsp.Title }
+
+// GetDescription returns the description of a funding stream proposal.
+func (fsp FundingStreamProposal) GetDescription() string { return fsp.Description }
+
+// ProposalRoute returns the routing key of a funding stream proposal.
+func (fsp FundingStreamProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a funding stream proposal.
+func (fsp FundingStreamProposal) ProposalType() string { return ProposalTypeFundingStream }
+
+// ValidateBasic runs basic stateless validity checks
+func (fsp FundingStreamProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(fsp)
+	if err != nil {
+		return err
+	}
+
+	return validateFundingStream(fsp.Recipient, fsp.AmountPerBlock, fsp.TotalAmount, fsp.EndTime)
+}
+
+// String implements the Stringer interface.
+func (fsp FundingStreamProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Funding Stream Proposal:
+  Title:            %s
+  Description:      %s
+  Recipient:        %s
+  Amount Per Block: %s
+  Total Amount:     %s
+  End Time:         %s
+`, fsp.Title, fsp.Description, fsp.Recipient, fsp.AmountPerBlock, fsp.TotalAmount, fsp.EndTime))
+	return b.String()
+}
+
+// CancelFundingStreamProposal cancels a community pool funding stream
+type CancelFundingStreamProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+	StreamID    uint64 `json:"stream_id" yaml:"stream_id"`
+}
+
+// NewCancelFundingStreamProposal creates a new cancel funding stream proposal.
+func NewCancelFundingStreamProposal(title, description string, streamID uint64) CancelFundingStreamProposal {
+	return CancelFundingStreamProposal{title, description, streamID}
+}
+
+// GetTitle returns the title of a cancel funding stream proposal.
+func (cfp CancelFundingStreamProposal) GetTitle() string { return cfp.Title }
+
+// GetDescription returns the description of a cancel funding stream proposal.
+func (cfp CancelFundingStreamProposal) GetDescription() string { return cfp.Description }
+
+// ProposalRoute returns the routing key of a cancel funding stream proposal.
+func (cfp CancelFundingStreamProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a cancel funding stream proposal.
+func (cfp CancelFundingStreamProposal) ProposalType() string { return ProposalTypeCancelFundingStream }
+
+// ValidateBasic runs basic stateless validity checks
+func (cfp CancelFundingStreamProposal) ValidateBasic() error {
+	return govtypes.ValidateAbstract(cfp)
+}
+
+// String implements the Stringer interface.
+func (cfp CancelFundingStreamProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Cancel Funding Stream Proposal:
+  Title:       %s
+  Description: %s
+  Stream ID:   %d
+`, cfp.Title, cfp.Description, cfp.StreamID))
+	return b.String()
+}
@@ -13,6 +13,10 @@ const (
 	QueryDelegatorValidators         = "delegator_validators"
 	QueryWithdrawAddr                = "withdraw_addr"
 	QueryCommunityPool               = "community_pool"
+	QueryAutoCompound                = "auto_compound"
+	QueryCommissionWithdrawInfo      = "commission_withdraw_info"
+	QueryFundingStreams              = "funding_streams"
+	QueryFundingStream               = "funding_stream"
 )
 
 // params for query 'custom/distr/validator_outstanding_rewards'
@@ -90,3 +94,33 @@ type QueryDelegatorWithdrawAddrParams struct {
 func NewQueryDelegatorWithdrawAddrParams(delegatorAddr sdk.AccAddress) QueryDelegatorWithdrawAddrParams {
 	return QueryDelegatorWithdrawAddrParams{DelegatorAddress: delegatorAddr}
 }
+
+// params for query 'custom/distr/auto_compound'
+type QueryDelegatorAutoCompoundParams struct {
+	DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
+}
+
+// NewQueryDelegatorAutoCompoundParams creates a new instance of QueryDelegatorAutoCompoundParams.
+func NewQueryDelegatorAutoCompoundParams(delegatorAddr sdk.AccAddress) QueryDelegatorAutoCompoundParams {
+	return QueryDelegatorAutoCompoundParams{DelegatorAddress: delegatorAddr}
+}
+
+// params for query 'custom/distr/commission_withdraw_info'
+type QueryCommissionWithdrawInfoParams struct {
+	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+}
+
+// NewQueryCommissionWithdrawInfoParams creates a new instance of QueryCommissionWithdrawInfoParams.
+func NewQueryCommissionWithdrawInfoParams(validatorAddr sdk.ValAddress) QueryCommissionWithdrawInfoParams {
+	return QueryCommissionWithdrawInfoParams{ValidatorAddress: validatorAddr}
+}
+
+// params for query 'custom/distr/funding_stream'
+type QueryFundingStreamParams struct {
+	StreamID uint64 `json:"stream_id" yaml:"stream_id"`
+}
+
+// NewQueryFundingStreamParams creates a new instance of QueryFundingStreamParams.
+func NewQueryFundingStreamParams(streamID uint64) QueryFundingStreamParams {
+	return QueryFundingStreamParams{StreamID: streamID}
+}
@@ -44,3 +44,28 @@ func NewDelegationDelegatorReward(valAddr sdk.ValAddress,
 	reward sdk.DecCoins) DelegationDelegatorReward {
 	return DelegationDelegatorReward{ValidatorAddress: valAddr, Reward: reward}
 }
+
+// QueryCommissionWithdrawInfoResponse defines the properties of
+// QueryCommissionWithdrawInfo query's response.
+type QueryCommissionWithdrawInfoResponse struct {
+	WithdrawAddress sdk.AccAddress   `json:"withdraw_address" yaml:"withdraw_address"`
+	Splits          CommissionSplits `json:"splits" yaml:"splits"`
+}
+
+// NewQueryCommissionWithdrawInfoResponse constructs a QueryCommissionWithdrawInfoResponse
+func NewQueryCommissionWithdrawInfoResponse(withdrawAddr sdk.AccAddress,
+	splits CommissionSplits) QueryCommissionWithdrawInfoResponse {
+	return QueryCommissionWithdrawInfoResponse{WithdrawAddress: withdrawAddr, Splits: splits}
+}
+
+func (res QueryCommissionWithdrawInfoResponse) String() string {
+	out := "Commission Withdraw Info:\n"
+	out += fmt.Sprintf("  WithdrawAddress: %s\n", res.WithdrawAddress)
+	out += "  Splits:"
+	for _, split := range res.Splits {
+		out += fmt.Sprintf(`
+	Address: %s
+	Ratio: %s`, split.Address, split.Ratio)
+	}
+	return strings.TrimSpace(out)
+}
@@ -0,0 +1,64 @@
+package epochs
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BeginBlocker advances every registered epoch. An epoch that has not started
+// yet starts once the block time reaches its start time. A running epoch that
+// is over is ended and the next one is started in the same block. The stored
+// epoch is updated before the hooks run so that hooks observe the new state.
+func BeginBlocker(ctx sdk.Context, k Keeper) {
+	// collect the epochs first as the store must not be written to while
+	// iterating over it
+	for _, epoch := range k.GetAllEpochInfos(ctx) {
+		switch {
+		case epoch.ShouldStart(ctx.BlockTime()):
+			epoch.EpochCountingStarted = true
+			epoch.CurrentEpoch = 1
+			epoch.CurrentEpochStartTime = ctx.BlockTime()
+			epoch.CurrentEpochStartHeight = ctx.BlockHeight()
+			k.SetEpochInfo(ctx, epoch)
+
+			emitEpochStartEvent(ctx, epoch)
+			k.BeforeEpochStart(ctx, epoch.Identifier, epoch.CurrentEpoch)
+
+		case epoch.ShouldEnd(ctx.BlockTime(), ctx.BlockHeight()):
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					EventTypeEpochEnd,
+					sdk.NewAttribute(AttributeKeyEpochIdentifier, epoch.Identifier),
+					sdk.NewAttribute(AttributeKeyEpochNumber, fmt.Sprintf("%d", epoch.CurrentEpoch)),
+				),
+			)
+			k.AfterEpochEnd(ctx, epoch.Identifier, epoch.CurrentEpoch)
+
+			// time based epochs keep their schedule regardless of block times,
+			// so an epoch which ended late does not shift the following ones
+			if epoch.IsBlockBased() {
+				epoch.CurrentEpochStartTime = ctx.BlockTime()
+			} else {
+				epoch.CurrentEpochStartTime = epoch.CurrentEpochStartTime.Add(epoch.Duration)
+			}
+			epoch.CurrentEpoch++
+			epoch.CurrentEpochStartHeight = ctx.BlockHeight()
+			k.SetEpochInfo(ctx, epoch)
+
+			emitEpochStartEvent(ctx, epoch)
+			k.BeforeEpochStart(ctx, epoch.Identifier, epoch.CurrentEpoch)
+		}
+	}
+}
+
+func emitEpochStartEvent(ctx sdk.Context, epoch EpochInfo) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeEpochStart,
+			sdk.NewAttribute(AttributeKeyEpochIdentifier, epoch.Identifier),
+			sdk.NewAttribute(AttributeKeyEpochNumber, fmt.Sprintf("%d", epoch.CurrentEpoch)),
+			sdk.NewAttribute(AttributeKeyEpochStartTime, epoch.CurrentEpochStartTime.String()),
+		),
+	)
+}
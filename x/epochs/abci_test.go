@@ -0,0 +1,132 @@
+package epochs_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epochs"
+)
+
+type hookCall struct {
+	hook       string
+	identifier string
+	number     int64
+}
+
+// mockHooks records every epoch hook call in order
+type mockHooks struct {
+	calls []hookCall
+}
+
+func (h *mockHooks) AfterEpochEnd(_ sdk.Context, identifier string, epochNumber int64) {
+	h.calls = append(h.calls, hookCall{"end", identifier, epochNumber})
+}
+
+func (h *mockHooks) BeforeEpochStart(_ sdk.Context, identifier string, epochNumber int64) {
+	h.calls = append(h.calls, hookCall{"start", identifier, epochNumber})
+}
+
+func setupEpochs(t *testing.T, genesisTime time.Time, epochInfos ...epochs.EpochInfo) (epochs.Keeper, sdk.Context, *mockHooks) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 1, Time: genesisTime})
+
+	for _, e := range app.EpochsKeeper.GetAllEpochInfos(ctx) {
+		app.EpochsKeeper.DeleteEpochInfo(ctx, e.Identifier)
+	}
+	for _, e := range epochInfos {
+		require.NoError(t, app.EpochsKeeper.AddEpochInfo(ctx, e))
+	}
+
+	// the app keeper already has the hooks of the app registered
+	hooks := &mockHooks{}
+	keeper := epochs.NewKeeper(app.Codec(), app.GetKey(epochs.StoreKey))
+	keeper.SetHooks(hooks)
+
+	return keeper, ctx, hooks
+}
+
+func nextBlock(ctx sdk.Context, blockTime time.Time) sdk.Context {
+	return ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(blockTime)
+}
+
+func TestBeginBlockerTimeBasedEpoch(t *testing.T) {
+	genesisTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	startTime := genesisTime.Add(time.Hour)
+	k, ctx, hooks := setupEpochs(t, genesisTime, epochs.NewEpochInfo("day", startTime, 24*time.Hour))
+
+	// the epoch does not start before its start time
+	epochs.BeginBlocker(ctx, k)
+	require.Empty(t, hooks.calls)
+
+	ctx = nextBlock(ctx, startTime.Add(time.Minute))
+	epochs.BeginBlocker(ctx, k)
+	require.Equal(t, []hookCall{{"start", "day", 1}}, hooks.calls)
+
+	epoch, found := k.GetEpochInfo(ctx, "day")
+	require.True(t, found)
+	require.True(t, epoch.EpochCountingStarted)
+	require.Equal(t, int64(1), epoch.CurrentEpoch)
+	require.Equal(t, startTime.Add(time.Minute), epoch.CurrentEpochStartTime)
+	require.Equal(t, int64(2), epoch.CurrentEpochStartHeight)
+
+	// nothing happens until the epoch is over
+	ctx = nextBlock(ctx, startTime.Add(24*time.Hour))
+	epochs.BeginBlocker(ctx, k)
+	require.Len(t, hooks.calls, 1)
+
+	// a late block ends the epoch without shifting the epoch schedule
+	ctx = nextBlock(ctx, startTime.Add(26*time.Hour))
+	epochs.BeginBlocker(ctx, k)
+	require.Equal(t, []hookCall{{"start", "day", 1}, {"end", "day", 1}, {"start", "day", 2}}, hooks.calls)
+
+	epoch, _ = k.GetEpochInfo(ctx, "day")
+	require.Equal(t, int64(2), epoch.CurrentEpoch)
+	require.Equal(t, startTime.Add(24*time.Hour+time.Minute), epoch.CurrentEpochStartTime)
+	require.Equal(t, int64(4), epoch.CurrentEpochStartHeight)
+
+	currentEpoch, err := k.GetCurrentEpoch(ctx, "day")
+	require.NoError(t, err)
+	require.Equal(t, int64(2), currentEpoch)
+}
+
+func TestBeginBlockerBlockBasedEpoch(t *testing.T) {
+	genesisTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	k, ctx, hooks := setupEpochs(t, genesisTime, epochs.NewBlockEpochInfo("blocks", genesisTime, 3))
+
+	// blocks 1, 4 and 7 start a new epoch
+	for i := 0; i < 7; i++ {
+		if i > 0 {
+			ctx = nextBlock(ctx, ctx.BlockTime().Add(time.Second))
+		}
+		epochs.BeginBlocker(ctx, k)
+	}
+
+	require.Equal(t, []hookCall{
+		{"start", "blocks", 1},
+		{"end", "blocks", 1}, {"start", "blocks", 2},
+		{"end", "blocks", 2}, {"start", "blocks", 3},
+	}, hooks.calls)
+
+	epoch, _ := k.GetEpochInfo(ctx, "blocks")
+	require.Equal(t, int64(3), epoch.CurrentEpoch)
+	require.Equal(t, int64(7), epoch.CurrentEpochStartHeight)
+
+	// the epoch boundaries emit start and end events
+	var starts, ends int
+	for _, event := range ctx.EventManager().Events() {
+		switch event.Type {
+		case epochs.EventTypeEpochStart:
+			starts++
+		case epochs.EventTypeEpochEnd:
+			ends++
+		}
+	}
+	require.Equal(t, 3, starts)
+	require.Equal(t, 2, ends)
+}
@@ -0,0 +1,51 @@
+package epochs
+
+// nolint
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/epochs/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/epochs/internal/types"
+)
+
+const (
+	ModuleName                  = types.ModuleName
+	StoreKey                    = types.StoreKey
+	QuerierRoute                = types.QuerierRoute
+	QueryEpochInfos             = types.QueryEpochInfos
+	QueryCurrentEpoch           = types.QueryCurrentEpoch
+	DayEpochIdentifier          = types.DayEpochIdentifier
+	WeekEpochIdentifier         = types.WeekEpochIdentifier
+	EventTypeEpochStart         = types.EventTypeEpochStart
+	EventTypeEpochEnd           = types.EventTypeEpochEnd
+	AttributeKeyEpochIdentifier = types.AttributeKeyEpochIdentifier
+	AttributeKeyEpochNumber     = types.AttributeKeyEpochNumber
+	AttributeKeyEpochStartTime  = types.AttributeKeyEpochStartTime
+)
+
+var (
+	NewKeeper  = keeper.NewKeeper
+	NewQuerier = keeper.NewQuerier
+
+	NewEpochInfo               = types.NewEpochInfo
+	NewBlockEpochInfo          = types.NewBlockEpochInfo
+	NewMultiEpochHooks         = types.NewMultiEpochHooks
+	NewGenesisState            = types.NewGenesisState
+	DefaultGenesisState        = types.DefaultGenesisState
+	NewQueryCurrentEpochParams = types.NewQueryCurrentEpochParams
+	GetEpochInfoKey            = types.GetEpochInfoKey
+
+	ModuleCdc          = types.ModuleCdc
+	KeyPrefixEpochInfo = types.KeyPrefixEpochInfo
+	ErrInvalidEpoch    = types.ErrInvalidEpoch
+	ErrEpochNotFound   = types.ErrEpochNotFound
+	ErrEpochExists     = types.ErrEpochExists
+)
+
+type (
+	Keeper                  = keeper.Keeper
+	EpochInfo               = types.EpochInfo
+	EpochHooks              = types.EpochHooks
+	MultiEpochHooks         = types.MultiEpochHooks
+	GenesisState            = types.GenesisState
+	QueryCurrentEpochParams = types.QueryCurrentEpochParams
+)
@@ -0,0 +1,101 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/epochs/internal/types"
+)
+
+// GetQueryCmd returns the cli query commands for the epochs module.
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	epochsQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the epochs module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	epochsQueryCmd.AddCommand(
+		flags.GetCommands(
+			GetCmdQueryEpochInfos(cdc),
+			GetCmdQueryCurrentEpoch(cdc),
+		)...,
+	)
+
+	return epochsQueryCmd
+}
+
+// GetCmdQueryEpochInfos implements a command to return all registered epochs.
+func GetCmdQueryEpochInfos(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "epoch-infos",
+		Short: "Query all registered epochs",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryEpochInfos)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var epochs []types.EpochInfo
+			if err := cdc.UnmarshalJSON(res, &epochs); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(epochs)
+		},
+	}
+}
+
+// GetCmdQueryCurrentEpoch implements a command to return the number of the
+// running epoch with the given identifier.
+func GetCmdQueryCurrentEpoch(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "current-epoch [identifier]",
+		Short: "Query the number of the running epoch with the given identifier",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the number of the running epoch with the given identifier.
+Zero is returned for an epoch which has not started yet.
+
+Example:
+$ %s query epochs current-epoch week
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, err := cdc.MarshalJSON(types.NewQueryCurrentEpochParams(args[0]))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCurrentEpoch)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var currentEpoch int64
+			if err := cdc.UnmarshalJSON(res, &currentEpoch); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(currentEpoch)
+		},
+	}
+}
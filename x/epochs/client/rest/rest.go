@@ -0,0 +1,18 @@
+package rest
+
+import (
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+)
+
+// REST variable names
+// nolint
+const (
+	RestParamEpochIdentifier = "identifier"
+)
+
+// RegisterRoutes registers epochs module REST handlers on the provided router.
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	registerQueryRoutes(cliCtx, r)
+}
@@ -0,0 +1,24 @@
+/*
+Package epochs implements a Cosmos SDK module that tracks named, periodically
+recurring epochs and notifies other modules on epoch boundaries. It allows
+modules to run expensive logic once per epoch instead of once per block.
+
+An epoch is either time based, e.g. a day or a week, or block based, in which
+case it lasts a fixed number of blocks. Every epoch is identified by a unique
+string identifier. At the beginning of each block the module ends every epoch
+which is over, calling the AfterEpochEnd hook, and starts the next one, calling
+the BeforeEpochStart hook.
+
+Modules interested in epoch boundaries implement the EpochHooks interface and
+are registered with the epochs keeper:
+
+	app.EpochsKeeper = *epochsKeeper.SetHooks(
+	  epochs.NewMultiEpochHooks(
+	    mint.NewEpochHooks(app.MintKeeper, epochsKeeper, inflationCalculator),
+	  ),
+	)
+
+Hooks receive the epoch identifier and should ignore the epochs they are not
+interested in.
+*/
+package epochs
@@ -0,0 +1,31 @@
+package epochs
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the epochs module's state from a provided genesis
+// state.
+func InitGenesis(ctx sdk.Context, k Keeper, gs GenesisState) {
+	if err := gs.Validate(); err != nil {
+		panic(fmt.Sprintf("failed to validate %s genesis state: %s", ModuleName, err))
+	}
+
+	for _, epoch := range gs.Epochs {
+		if err := k.AddEpochInfo(ctx, epoch); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis returns the epochs module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	epochs := k.GetAllEpochInfos(ctx)
+	if epochs == nil {
+		epochs = []EpochInfo{}
+	}
+
+	return NewGenesisState(epochs)
+}
@@ -0,0 +1,39 @@
+package epochs_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/x/epochs"
+)
+
+func TestExportImportGenesis(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Time: time.Now().UTC()})
+
+	require.Equal(t, epochs.DefaultGenesisState(), epochs.ExportGenesis(ctx, app.EpochsKeeper))
+
+	epochs.BeginBlocker(ctx, app.EpochsKeeper)
+	exported := epochs.ExportGenesis(ctx, app.EpochsKeeper)
+	for _, e := range exported.Epochs {
+		require.True(t, e.EpochCountingStarted)
+		require.Equal(t, int64(1), e.CurrentEpoch)
+	}
+
+	newApp := simapp.Setup(false)
+	newCtx := newApp.BaseApp.NewContext(false, abci.Header{})
+	for _, e := range newApp.EpochsKeeper.GetAllEpochInfos(newCtx) {
+		newApp.EpochsKeeper.DeleteEpochInfo(newCtx, e.Identifier)
+	}
+
+	epochs.InitGenesis(newCtx, newApp.EpochsKeeper, exported)
+	require.Equal(t, exported, epochs.ExportGenesis(newCtx, newApp.EpochsKeeper))
+
+	// importing an epoch twice panics
+	require.Panics(t, func() { epochs.InitGenesis(newCtx, newApp.EpochsKeeper, exported) })
+}
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epochs/internal/types"
+)
+
+// Implements EpochHooks interface
+var _ types.EpochHooks = Keeper{}
+
+// AfterEpochEnd - call hook if registered
+func (k Keeper) AfterEpochEnd(ctx sdk.Context, identifier string, epochNumber int64) {
+	if k.hooks != nil {
+		k.hooks.AfterEpochEnd(ctx, identifier, epochNumber)
+	}
+}
+
+// BeforeEpochStart - call hook if registered
+func (k Keeper) BeforeEpochStart(ctx sdk.Context, identifier string, epochNumber int64) {
+	if k.hooks != nil {
+		k.hooks.BeforeEpochStart(ctx, identifier, epochNumber)
+	}
+}
@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/epochs/internal/types"
+)
+
+// Keeper defines the epochs module's keeper. The keeper tracks the state of
+// every registered epoch and notifies the registered hooks on epoch boundaries.
+type Keeper struct {
+	cdc      *codec.Codec
+	storeKey sdk.StoreKey
+	hooks    types.EpochHooks
+}
+
+// NewKeeper creates a new epochs Keeper instance
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey) Keeper {
+	return Keeper{
+		cdc:      cdc,
+		storeKey: storeKey,
+	}
+}
+
+// SetHooks sets the epoch hooks. The hooks may only be set once.
+func (k *Keeper) SetHooks(eh types.EpochHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set epoch hooks twice")
+	}
+
+	k.hooks = eh
+	return k
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetEpochInfo returns the epoch with the given identifier, if it exists.
+func (k Keeper) GetEpochInfo(ctx sdk.Context, identifier string) (epoch types.EpochInfo, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetEpochInfoKey(identifier))
+	if bz == nil {
+		return epoch, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &epoch)
+	return epoch, true
+}
+
+// SetEpochInfo sets the epoch, keyed by its identifier.
+func (k Keeper) SetEpochInfo(ctx sdk.Context, epoch types.EpochInfo) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(epoch)
+	store.Set(types.GetEpochInfoKey(epoch.Identifier), bz)
+}
+
+// DeleteEpochInfo removes the epoch with the given identifier.
+func (k Keeper) DeleteEpochInfo(ctx sdk.Context, identifier string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetEpochInfoKey(identifier))
+}
+
+// AddEpochInfo registers a new epoch. It returns an error if the epoch is
+// invalid or an epoch with the same identifier already exists.
+func (k Keeper) AddEpochInfo(ctx sdk.Context, epoch types.EpochInfo) error {
+	if err := epoch.Validate(); err != nil {
+		return err
+	}
+	if _, found := k.GetEpochInfo(ctx, epoch.Identifier); found {
+		return sdkerrors.Wrap(types.ErrEpochExists, epoch.Identifier)
+	}
+
+	k.SetEpochInfo(ctx, epoch)
+	return nil
+}
+
+// IterateEpochInfos iterates over all stored epochs in identifier order and
+// performs a callback function.
+func (k Keeper) IterateEpochInfos(ctx sdk.Context, cb func(epoch types.EpochInfo) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEpochInfo)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var epoch types.EpochInfo
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &epoch)
+
+		if cb(epoch) {
+			break
+		}
+	}
+}
+
+// GetAllEpochInfos returns all stored epochs.
+func (k Keeper) GetAllEpochInfos(ctx sdk.Context) (epochs []types.EpochInfo) {
+	k.IterateEpochInfos(ctx, func(epoch types.EpochInfo) bool {
+		epochs = append(epochs, epoch)
+		return false
+	})
+
+	return epochs
+}
+
+// GetCurrentEpoch returns the number of the running epoch with the given
+// identifier. It returns zero if the epoch has not started counting yet.
+func (k Keeper) GetCurrentEpoch(ctx sdk.Context, identifier string) (int64, error) {
+	epoch, found := k.GetEpochInfo(ctx, identifier)
+	if !found {
+		return 0, sdkerrors.Wrap(types.ErrEpochNotFound, identifier)
+	}
+
+	return epoch.CurrentEpoch, nil
+}
@@ -0,0 +1,51 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epochs/internal/types"
+)
+
+// returns context and an app with the default epochs
+func createTestApp(isCheckTx bool) (*simapp.SimApp, sdk.Context) {
+	app := simapp.Setup(isCheckTx)
+	ctx := app.BaseApp.NewContext(isCheckTx, abci.Header{})
+
+	return app, ctx
+}
+
+func TestEpochInfos(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	// the default epochs are set at genesis
+	epochs := app.EpochsKeeper.GetAllEpochInfos(ctx)
+	require.Equal(t, types.DefaultGenesisState().Epochs, epochs)
+
+	epoch := types.NewBlockEpochInfo("blocks", time.Time{}, 10)
+	require.NoError(t, app.EpochsKeeper.AddEpochInfo(ctx, epoch))
+	require.Error(t, app.EpochsKeeper.AddEpochInfo(ctx, epoch))
+	require.Error(t, app.EpochsKeeper.AddEpochInfo(ctx, types.NewBlockEpochInfo("invalid", time.Time{}, 0)))
+
+	stored, found := app.EpochsKeeper.GetEpochInfo(ctx, epoch.Identifier)
+	require.True(t, found)
+	require.Equal(t, epoch, stored)
+	require.Len(t, app.EpochsKeeper.GetAllEpochInfos(ctx), 3)
+
+	currentEpoch, err := app.EpochsKeeper.GetCurrentEpoch(ctx, epoch.Identifier)
+	require.NoError(t, err)
+	require.Zero(t, currentEpoch)
+
+	app.EpochsKeeper.DeleteEpochInfo(ctx, epoch.Identifier)
+	_, found = app.EpochsKeeper.GetEpochInfo(ctx, epoch.Identifier)
+	require.False(t, found)
+
+	_, err = app.EpochsKeeper.GetCurrentEpoch(ctx, epoch.Identifier)
+	require.Error(t, err)
+}
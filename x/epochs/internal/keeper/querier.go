@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/epochs/internal/types"
+)
+
+// NewQuerier returns an epochs Querier handler.
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		switch path[0] {
+		case types.QueryEpochInfos:
+			return queryEpochInfos(ctx, k)
+
+		case types.QueryCurrentEpoch:
+			return queryCurrentEpoch(ctx, req, k)
+
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query path: %s", path[0])
+		}
+	}
+}
+
+func queryEpochInfos(ctx sdk.Context, k Keeper) ([]byte, error) {
+	epochs := k.GetAllEpochInfos(ctx)
+	if epochs == nil {
+		epochs = []types.EpochInfo{}
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, epochs)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryCurrentEpoch(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryCurrentEpochParams
+
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	currentEpoch, err := k.GetCurrentEpoch(ctx, params.Identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, currentEpoch)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
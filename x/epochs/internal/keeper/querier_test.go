@@ -0,0 +1,52 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	keep "github.com/cosmos/cosmos-sdk/x/epochs/internal/keeper"
+	"github.com/cosmos/cosmos-sdk/x/epochs/internal/types"
+)
+
+func TestQueryEpochInfos(t *testing.T) {
+	app, ctx := createTestApp(false)
+	querier := keep.NewQuerier(app.EpochsKeeper)
+
+	res, err := querier(ctx, []string{types.QueryEpochInfos}, abci.RequestQuery{})
+	require.NoError(t, err)
+
+	var epochs []types.EpochInfo
+	require.NoError(t, app.Codec().UnmarshalJSON(res, &epochs))
+	require.Equal(t, app.EpochsKeeper.GetAllEpochInfos(ctx), epochs)
+
+	_, err = querier(ctx, []string{"foo"}, abci.RequestQuery{})
+	require.Error(t, err)
+}
+
+func TestQueryCurrentEpoch(t *testing.T) {
+	app, ctx := createTestApp(false)
+	querier := keep.NewQuerier(app.EpochsKeeper)
+
+	epoch, found := app.EpochsKeeper.GetEpochInfo(ctx, types.WeekEpochIdentifier)
+	require.True(t, found)
+	epoch.EpochCountingStarted = true
+	epoch.CurrentEpoch = 3
+	app.EpochsKeeper.SetEpochInfo(ctx, epoch)
+
+	query := abci.RequestQuery{
+		Data: app.Codec().MustMarshalJSON(types.NewQueryCurrentEpochParams(types.WeekEpochIdentifier)),
+	}
+	res, err := querier(ctx, []string{types.QueryCurrentEpoch}, query)
+	require.NoError(t, err)
+
+	var currentEpoch int64
+	require.NoError(t, app.Codec().UnmarshalJSON(res, &currentEpoch))
+	require.Equal(t, int64(3), currentEpoch)
+
+	query.Data = app.Codec().MustMarshalJSON(types.NewQueryCurrentEpochParams("foo"))
+	_, err = querier(ctx, []string{types.QueryCurrentEpoch}, query)
+	require.Error(t, err)
+}
@@ -0,0 +1,14 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// ModuleCdc defines the generic sealed codec to be used throughout this module
+var ModuleCdc *codec.Codec
+
+func init() {
+	ModuleCdc = codec.New()
+	codec.RegisterCrypto(ModuleCdc)
+	ModuleCdc.Seal()
+}
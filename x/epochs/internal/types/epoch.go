@@ -0,0 +1,97 @@
+package types
+
+import (
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// EpochInfo defines a named, periodically recurring epoch. An epoch is either
+// time based, in which case it lasts Duration, or block based, in which case it
+// lasts BlockDuration blocks. Exactly one of the two must be set.
+type EpochInfo struct {
+	Identifier    string        `json:"identifier" yaml:"identifier"`         // unique name of the epoch
+	StartTime     time.Time     `json:"start_time" yaml:"start_time"`         // time at which the first epoch may start
+	Duration      time.Duration `json:"duration" yaml:"duration"`             // length of a time based epoch
+	BlockDuration int64         `json:"block_duration" yaml:"block_duration"` // length of a block based epoch
+
+	CurrentEpoch            int64     `json:"current_epoch" yaml:"current_epoch"`                           // number of the running epoch
+	CurrentEpochStartTime   time.Time `json:"current_epoch_start_time" yaml:"current_epoch_start_time"`     // time at which the running epoch started
+	CurrentEpochStartHeight int64     `json:"current_epoch_start_height" yaml:"current_epoch_start_height"` // height at which the running epoch started
+	EpochCountingStarted    bool      `json:"epoch_counting_started" yaml:"epoch_counting_started"`         // whether the first epoch has started
+}
+
+// NewEpochInfo creates a new time based EpochInfo which starts counting at the
+// first block at or after startTime.
+func NewEpochInfo(identifier string, startTime time.Time, duration time.Duration) EpochInfo {
+	return EpochInfo{
+		Identifier: identifier,
+		StartTime:  startTime,
+		Duration:   duration,
+	}
+}
+
+// NewBlockEpochInfo creates a new block based EpochInfo which starts counting at
+// the first block at or after startTime.
+func NewBlockEpochInfo(identifier string, startTime time.Time, blockDuration int64) EpochInfo {
+	return EpochInfo{
+		Identifier:    identifier,
+		StartTime:     startTime,
+		BlockDuration: blockDuration,
+	}
+}
+
+// IsBlockBased returns true if the epoch length is measured in blocks.
+func (e EpochInfo) IsBlockBased() bool {
+	return e.BlockDuration > 0
+}
+
+// ShouldStart returns true if the epoch has not started counting yet and the
+// given block time is at or after its start time.
+func (e EpochInfo) ShouldStart(blockTime time.Time) bool {
+	return !e.EpochCountingStarted && !blockTime.Before(e.StartTime)
+}
+
+// ShouldEnd returns true if the running epoch is over at the given block time
+// and height.
+func (e EpochInfo) ShouldEnd(blockTime time.Time, blockHeight int64) bool {
+	if !e.EpochCountingStarted {
+		return false
+	}
+
+	if e.IsBlockBased() {
+		return blockHeight >= e.CurrentEpochStartHeight+e.BlockDuration
+	}
+
+	return !blockTime.Before(e.CurrentEpochStartTime.Add(e.Duration))
+}
+
+// Validate performs a stateless validation of the epoch.
+func (e EpochInfo) Validate() error {
+	if strings.TrimSpace(e.Identifier) == "" {
+		return sdkerrors.Wrap(ErrInvalidEpoch, "epoch identifier cannot be blank")
+	}
+	if e.Duration < 0 || e.BlockDuration < 0 {
+		return sdkerrors.Wrapf(ErrInvalidEpoch, "epoch %s has a negative duration", e.Identifier)
+	}
+	if (e.Duration == 0) == (e.BlockDuration == 0) {
+		return sdkerrors.Wrapf(ErrInvalidEpoch, "epoch %s must set exactly one of duration and block duration", e.Identifier)
+	}
+	if e.CurrentEpoch < 0 {
+		return sdkerrors.Wrapf(ErrInvalidEpoch, "epoch %s has a negative current epoch", e.Identifier)
+	}
+	if e.EpochCountingStarted && e.CurrentEpoch == 0 {
+		return sdkerrors.Wrapf(ErrInvalidEpoch, "started epoch %s must have a positive current epoch", e.Identifier)
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface for EpochInfo.
+func (e EpochInfo) String() string {
+	bz, _ := yaml.Marshal(e)
+	return string(bz)
+}
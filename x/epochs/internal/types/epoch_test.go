@@ -0,0 +1,69 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEpochInfoValidate(t *testing.T) {
+	now := time.Now().UTC()
+
+	started := NewEpochInfo("day", now, 24*time.Hour)
+	started.EpochCountingStarted = true
+	started.CurrentEpoch = 1
+
+	notCounted := NewEpochInfo("day", now, 24*time.Hour)
+	notCounted.EpochCountingStarted = true
+
+	tests := []struct {
+		name      string
+		epoch     EpochInfo
+		expectErr bool
+	}{
+		{"time based", NewEpochInfo("day", now, 24*time.Hour), false},
+		{"block based", NewBlockEpochInfo("blocks", now, 10), false},
+		{"started", started, false},
+		{"blank identifier", NewEpochInfo(" ", now, 24*time.Hour), true},
+		{"no duration", NewEpochInfo("day", now, 0), true},
+		{"negative duration", NewEpochInfo("day", now, -time.Hour), true},
+		{"negative block duration", NewBlockEpochInfo("blocks", now, -1), true},
+		{"both durations", EpochInfo{Identifier: "day", Duration: time.Hour, BlockDuration: 10}, true},
+		{"started without epoch number", notCounted, true},
+	}
+
+	for _, tc := range tests {
+		err := tc.epoch.Validate()
+		if tc.expectErr {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestEpochInfoShouldStartAndEnd(t *testing.T) {
+	now := time.Now().UTC()
+
+	epoch := NewEpochInfo("day", now, 24*time.Hour)
+	require.False(t, epoch.ShouldStart(now.Add(-time.Second)))
+	require.True(t, epoch.ShouldStart(now))
+	require.False(t, epoch.ShouldEnd(now.Add(48*time.Hour), 100))
+
+	epoch.EpochCountingStarted = true
+	epoch.CurrentEpoch = 1
+	epoch.CurrentEpochStartTime = now
+	epoch.CurrentEpochStartHeight = 5
+	require.False(t, epoch.ShouldStart(now))
+	require.False(t, epoch.ShouldEnd(now.Add(24*time.Hour-time.Second), 100))
+	require.True(t, epoch.ShouldEnd(now.Add(24*time.Hour), 6))
+
+	blockEpoch := NewBlockEpochInfo("blocks", now, 10)
+	blockEpoch.EpochCountingStarted = true
+	blockEpoch.CurrentEpoch = 1
+	blockEpoch.CurrentEpochStartTime = now
+	blockEpoch.CurrentEpochStartHeight = 5
+	require.False(t, blockEpoch.ShouldEnd(now.Add(48*time.Hour), 14))
+	require.True(t, blockEpoch.ShouldEnd(now, 15))
+}
@@ -0,0 +1,13 @@
+// DONTCOVER
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/epochs module sentinel errors
+var (
+	ErrInvalidEpoch  = sdkerrors.Register(ModuleName, 1, "invalid epoch")
+	ErrEpochNotFound = sdkerrors.Register(ModuleName, 2, "epoch does not exist")
+	ErrEpochExists   = sdkerrors.Register(ModuleName, 3, "epoch already exists")
+)
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+// default epoch identifiers
+const (
+	DayEpochIdentifier  = "day"
+	WeekEpochIdentifier = "week"
+)
+
+// GenesisState defines the epochs module's genesis state.
+type GenesisState struct {
+	Epochs []EpochInfo `json:"epochs" yaml:"epochs"`
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(epochs []EpochInfo) GenesisState {
+	return GenesisState{
+		Epochs: epochs,
+	}
+}
+
+// DefaultGenesisState returns the epochs module's default genesis state. It
+// contains a daily and a weekly epoch which both start counting at the first
+// block.
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState([]EpochInfo{
+		NewEpochInfo(DayEpochIdentifier, time.Time{}, 24*time.Hour),
+		NewEpochInfo(WeekEpochIdentifier, time.Time{}, 7*24*time.Hour),
+	})
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]bool, len(gs.Epochs))
+	for _, e := range gs.Epochs {
+		if err := e.Validate(); err != nil {
+			return err
+		}
+		if seen[e.Identifier] {
+			return fmt.Errorf("duplicate epoch identifier %s", e.Identifier)
+		}
+		seen[e.Identifier] = true
+	}
+
+	return nil
+}
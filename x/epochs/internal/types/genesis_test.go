@@ -0,0 +1,22 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	require.NoError(t, DefaultGenesisState().Validate())
+	require.NoError(t, NewGenesisState(nil).Validate())
+
+	duplicate := NewGenesisState([]EpochInfo{
+		NewEpochInfo("day", time.Time{}, 24*time.Hour),
+		NewBlockEpochInfo("day", time.Time{}, 10),
+	})
+	require.Error(t, duplicate.Validate())
+
+	invalid := NewGenesisState([]EpochInfo{NewEpochInfo("day", time.Time{}, 0)})
+	require.Error(t, invalid.Validate())
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EpochHooks event hooks for epoch boundaries. Modules that need to run logic
+// once per epoch instead of once per block implement this interface and are
+// registered with the epochs keeper.
+type EpochHooks interface {
+	AfterEpochEnd(ctx sdk.Context, identifier string, epochNumber int64)    // Must be called when an epoch ends
+	BeforeEpochStart(ctx sdk.Context, identifier string, epochNumber int64) // Must be called when an epoch starts
+}
+
+// combine multiple epoch hooks, all hook functions are run in array sequence
+type MultiEpochHooks []EpochHooks
+
+func NewMultiEpochHooks(hooks ...EpochHooks) MultiEpochHooks {
+	return hooks
+}
+
+// nolint
+func (h MultiEpochHooks) AfterEpochEnd(ctx sdk.Context, identifier string, epochNumber int64) {
+	for i := range h {
+		h[i].AfterEpochEnd(ctx, identifier, epochNumber)
+	}
+}
+func (h MultiEpochHooks) BeforeEpochStart(ctx sdk.Context, identifier string, epochNumber int64) {
+	for i := range h {
+		h[i].BeforeEpochStart(ctx, identifier, epochNumber)
+	}
+}
@@ -0,0 +1,22 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "epochs"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixEpochInfo = []byte{0x00}
+)
+
+// GetEpochInfoKey returns the store key of the epoch with the given identifier.
+func GetEpochInfoKey(identifier string) []byte {
+	return append(KeyPrefixEpochInfo, []byte(identifier)...)
+}
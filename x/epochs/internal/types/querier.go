@@ -0,0 +1,17 @@
+package types
+
+// Querier routes for the epochs module
+const (
+	QueryEpochInfos   = "epoch_infos"
+	QueryCurrentEpoch = "current_epoch"
+)
+
+// QueryCurrentEpochParams defines the parameters necessary for querying the
+// current number of an epoch.
+type QueryCurrentEpochParams struct {
+	Identifier string `json:"identifier" yaml:"identifier"`
+}
+
+func NewQueryCurrentEpochParams(identifier string) QueryCurrentEpochParams {
+	return QueryCurrentEpochParams{Identifier: identifier}
+}
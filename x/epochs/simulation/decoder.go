@@ -0,0 +1,24 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	tmkv "github.com/tendermint/tendermint/libs/kv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/epochs/internal/types"
+)
+
+// DecodeStore unmarshals the KVPair's Value to the corresponding epochs type
+func DecodeStore(cdc *codec.Codec, kvA, kvB tmkv.Pair) string {
+	switch {
+	case bytes.Equal(kvA.Key[:1], types.KeyPrefixEpochInfo):
+		var epochA, epochB types.EpochInfo
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &epochA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &epochB)
+		return fmt.Sprintf("%v\n%v", epochA, epochB)
+	default:
+		panic(fmt.Sprintf("invalid epochs key prefix %X", kvA.Key[:1]))
+	}
+}
@@ -0,0 +1,56 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/epochs/internal/types"
+)
+
+// Simulation parameter constants
+const (
+	ShortEpochDuration = "short_epoch_duration"
+	BlockEpochDuration = "block_epoch_duration"
+)
+
+// GenShortEpochDuration randomized duration of a short time based epoch
+func GenShortEpochDuration(r *rand.Rand) time.Duration {
+	return time.Duration(r.Intn(60)+1) * time.Minute
+}
+
+// GenBlockEpochDuration randomized duration of a block based epoch
+func GenBlockEpochDuration(r *rand.Rand) int64 {
+	return int64(r.Intn(20) + 1)
+}
+
+// RandomizedGenState generates a random GenesisState for epochs. Besides the
+// default epochs it contains a short time based and a block based epoch, so
+// that epoch boundaries are crossed during a simulation.
+func RandomizedGenState(simState *module.SimulationState) {
+	var shortEpochDuration time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, ShortEpochDuration, &shortEpochDuration, simState.Rand,
+		func(r *rand.Rand) { shortEpochDuration = GenShortEpochDuration(r) },
+	)
+
+	var blockEpochDuration int64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, BlockEpochDuration, &blockEpochDuration, simState.Rand,
+		func(r *rand.Rand) { blockEpochDuration = GenBlockEpochDuration(r) },
+	)
+
+	epochs := append(
+		types.DefaultGenesisState().Epochs,
+		types.NewEpochInfo("short", simState.GenTimestamp, shortEpochDuration),
+		types.NewBlockEpochInfo("blocks", simState.GenTimestamp, blockEpochDuration),
+	)
+	epochsGenesis := types.NewGenesisState(epochs)
+
+	fmt.Printf("Selected randomly generated epochs:\n%s\n", codec.MustMarshalJSONIndent(simState.Cdc, epochsGenesis))
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(epochsGenesis)
+}
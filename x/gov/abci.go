@@ -7,6 +7,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
+// maxPrunedVotesPerBlock bounds the number of votes of ended proposals deleted
+// at the end of every block
+const maxPrunedVotesPerBlock = 1000
+
 // EndBlocker called every block, process inflation, update validator set.
 func EndBlocker(ctx sdk.Context, keeper Keeper) {
 	logger := keeper.Logger(ctx)
@@ -28,7 +32,7 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) {
 			fmt.Sprintf("proposal %d (%s) didn't meet minimum deposit of %s (had only %s); deleted",
 				proposal.ProposalID,
 				proposal.GetTitle(),
-				keeper.GetDepositParams(ctx).MinDeposit,
+				keeper.GetDepositParams(ctx).GetMinDeposit(proposal.IsExpedited),
 				proposal.TotalDeposit,
 			),
 		)
@@ -41,6 +45,37 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) {
 
 		passes, burnDeposits, tallyResults := keeper.Tally(ctx, proposal)
 
+		// An expedited proposal which does not pass is converted to a regular
+		// proposal. It keeps its deposits and votes and is tallied again with
+		// the regular threshold once the regular voting period has ended.
+		if proposal.IsExpedited && !passes {
+			keeper.RemoveFromActiveProposalQueue(ctx, proposal.ProposalID, proposal.VotingEndTime)
+
+			proposal.IsExpedited = false
+			proposal.VotingEndTime = proposal.VotingStartTime.Add(keeper.GetVotingParams(ctx).VotingPeriod)
+
+			keeper.SetProposal(ctx, proposal)
+			keeper.InsertActiveProposalQueue(ctx, proposal.ProposalID, proposal.VotingEndTime)
+
+			logger.Info(
+				fmt.Sprintf(
+					"expedited proposal %d (%s) tallied; result: rejected, converted to a regular proposal ending at %s",
+					proposal.ProposalID, proposal.GetTitle(), proposal.VotingEndTime,
+				),
+			)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeActiveProposal,
+					sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalID)),
+					sdk.NewAttribute(types.AttributeKeyProposalResult, types.AttributeValueExpeditedProposalRejected),
+				),
+			)
+			return false
+		}
+
+		keeper.DeleteVotes(ctx, proposal.ProposalID)
+
 		if burnDeposits {
 			keeper.DeleteDeposits(ctx, proposal.ProposalID)
 		} else {
@@ -52,9 +87,13 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) {
 			cacheCtx, writeCache := ctx.CacheContext()
 
 			// The proposal handler may execute state mutating logic depending
-			// on the proposal content. If the handler fails, no state mutation
-			// is written and the error message is logged.
+			// on the proposal content, after which the proposal messages are
+			// executed by the governance module account. If either fails, no
+			// state mutation is written and the error message is logged.
 			err := handler(cacheCtx, proposal.Content)
+			if err == nil {
+				err = keeper.ExecuteProposalMessages(cacheCtx, proposal)
+			}
 			if err == nil {
 				proposal.Status = StatusPassed
 				tagValue = types.AttributeValueProposalPassed
@@ -100,4 +139,7 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) {
 		)
 		return false
 	})
+
+	// delete the votes of the ended proposals in bounded batches
+	keeper.PruneVotes(ctx, maxPrunedVotesPerBlock)
 }
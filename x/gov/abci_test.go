@@ -8,6 +8,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 	keep "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 )
@@ -295,6 +296,97 @@ func TestProposalPassedEndblocker(t *testing.T) {
 	require.True(t, input.bk.GetAllBalances(ctx, macc.GetAddress()).IsEqual(initialModuleAccCoins))
 }
 
+func TestProposalMsgsExecutedEndblocker(t *testing.T) {
+	input := getMockApp(t, 2, GenesisState{}, nil, ProposalHandler)
+	SortAddresses(input.addrs)
+
+	handler := NewHandler(input.keeper)
+	stakingHandler := staking.NewHandler(input.sk)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+
+	valAddr := sdk.ValAddress(input.addrs[0])
+
+	createValidators(t, stakingHandler, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+	staking.EndBlocker(ctx, input.sk)
+
+	macc := input.keeper.GetGovernanceAccount(ctx)
+	spendCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(5)))
+	require.NoError(t, input.bk.SetBalances(ctx, macc.GetAddress(), spendCoins))
+	recipientCoins := input.bk.GetAllBalances(ctx, input.addrs[1])
+
+	// the proposal spends the funds of the governance module account
+	proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10)))
+	msgs := []sdk.Msg{bank.NewMsgSend(macc.GetAddress(), input.addrs[1], spendCoins)}
+	res, err := handler(ctx, NewMsgSubmitProposalWithMsgs(keep.TestProposal, msgs, proposalCoins, input.addrs[0]))
+	require.NoError(t, err)
+	proposalID := GetProposalIDFromBytes(res.Data)
+
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[0], OptionYes)
+	require.NoError(t, err)
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(input.keeper.GetVotingParams(ctx).VotingPeriod)
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	EndBlocker(ctx, input.keeper)
+
+	proposal, ok := input.keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, StatusPassed, proposal.Status)
+	require.Equal(t, recipientCoins.Add(spendCoins...), input.bk.GetAllBalances(ctx, input.addrs[1]))
+	require.True(t, input.bk.GetAllBalances(ctx, macc.GetAddress()).IsZero())
+}
+
+func TestProposalMsgsFailedEndblocker(t *testing.T) {
+	input := getMockApp(t, 2, GenesisState{}, nil, ProposalHandler)
+	SortAddresses(input.addrs)
+
+	handler := NewHandler(input.keeper)
+	stakingHandler := staking.NewHandler(input.sk)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+
+	valAddr := sdk.ValAddress(input.addrs[0])
+
+	createValidators(t, stakingHandler, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+	staking.EndBlocker(ctx, input.sk)
+
+	macc := input.keeper.GetGovernanceAccount(ctx)
+	spendCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(5)))
+	require.NoError(t, input.bk.SetBalances(ctx, macc.GetAddress(), spendCoins))
+	recipientCoins := input.bk.GetAllBalances(ctx, input.addrs[1])
+
+	// the second message spends more than is left, so neither is applied
+	proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10)))
+	msgs := []sdk.Msg{
+		bank.NewMsgSend(macc.GetAddress(), input.addrs[1], spendCoins),
+		bank.NewMsgSend(macc.GetAddress(), input.addrs[1], spendCoins),
+	}
+	res, err := handler(ctx, NewMsgSubmitProposalWithMsgs(keep.TestProposal, msgs, proposalCoins, input.addrs[0]))
+	require.NoError(t, err)
+	proposalID := GetProposalIDFromBytes(res.Data)
+
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[0], OptionYes)
+	require.NoError(t, err)
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(input.keeper.GetVotingParams(ctx).VotingPeriod)
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	EndBlocker(ctx, input.keeper)
+
+	proposal, ok := input.keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, StatusFailed, proposal.Status)
+	require.Equal(t, recipientCoins, input.bk.GetAllBalances(ctx, input.addrs[1]))
+	require.Equal(t, spendCoins, input.bk.GetAllBalances(ctx, macc.GetAddress()))
+}
+
 func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	// hijack the router to one that will fail in a proposal's handler
 	input := getMockApp(t, 1, GenesisState{}, nil, badProposalHandler)
@@ -339,3 +431,115 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	// validate that the proposal fails/has been rejected
 	EndBlocker(ctx, input.keeper)
 }
+
+func TestExpeditedProposalPassedEndblocker(t *testing.T) {
+	input := getMockApp(t, 1, GenesisState{}, nil, ProposalHandler)
+	SortAddresses(input.addrs)
+
+	handler := NewHandler(input.keeper)
+	stakingHandler := staking.NewHandler(input.sk)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+
+	valAddr := sdk.ValAddress(input.addrs[0])
+
+	createValidators(t, stakingHandler, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+	staking.EndBlocker(ctx, input.sk)
+
+	// lower the expedited deposit below the balance of the mock accounts
+	depositParams := input.keeper.GetDepositParams(ctx)
+	depositParams.MinExpeditedDeposit = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(20)))
+	input.keeper.SetDepositParams(ctx, depositParams)
+
+	msg := NewMsgSubmitProposal(keep.TestProposal, depositParams.MinExpeditedDeposit, input.addrs[0])
+	msg.IsExpedited = true
+	res, err := handler(ctx, msg)
+	require.NoError(t, err)
+	proposalID := GetProposalIDFromBytes(res.Data)
+
+	err = input.keeper.AddVote(ctx, proposalID, input.addrs[0], OptionYes)
+	require.NoError(t, err)
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(input.keeper.GetVotingParams(ctx).ExpeditedVotingPeriod)
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	EndBlocker(ctx, input.keeper)
+
+	proposal, ok := input.keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, StatusPassed, proposal.Status)
+	require.True(t, proposal.IsExpedited)
+}
+
+func TestExpeditedProposalConvertedEndblocker(t *testing.T) {
+	input := getMockApp(t, 2, GenesisState{}, nil, ProposalHandler)
+	SortAddresses(input.addrs)
+
+	handler := NewHandler(input.keeper)
+	stakingHandler := staking.NewHandler(input.sk)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+
+	valAddrs := []sdk.ValAddress{sdk.ValAddress(input.addrs[0]), sdk.ValAddress(input.addrs[1])}
+
+	createValidators(t, stakingHandler, ctx, valAddrs, []int64{6, 4})
+	staking.EndBlocker(ctx, input.sk)
+
+	macc := input.keeper.GetGovernanceAccount(ctx)
+	initialModuleAccCoins := input.bk.GetAllBalances(ctx, macc.GetAddress())
+
+	// lower the expedited deposit below the balance of the mock accounts
+	depositParams := input.keeper.GetDepositParams(ctx)
+	depositParams.MinExpeditedDeposit = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(20)))
+	input.keeper.SetDepositParams(ctx, depositParams)
+
+	deposit := depositParams.MinExpeditedDeposit
+	msg := NewMsgSubmitProposal(keep.TestProposal, deposit, input.addrs[0])
+	msg.IsExpedited = true
+	res, err := handler(ctx, msg)
+	require.NoError(t, err)
+	proposalID := GetProposalIDFromBytes(res.Data)
+
+	// 60% of the voting power votes yes, which is below the expedited threshold
+	// but above the regular one
+	require.NoError(t, input.keeper.AddVote(ctx, proposalID, input.addrs[0], OptionYes))
+	require.NoError(t, input.keeper.AddVote(ctx, proposalID, input.addrs[1], OptionNo))
+
+	votingParams := input.keeper.GetVotingParams(ctx)
+	startTime := ctx.BlockHeader().Time
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = startTime.Add(votingParams.ExpeditedVotingPeriod)
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	EndBlocker(ctx, input.keeper)
+
+	// the proposal is converted to a regular proposal and keeps its deposits and votes
+	proposal, ok := input.keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, StatusVotingPeriod, proposal.Status)
+	require.False(t, proposal.IsExpedited)
+	require.Equal(t, startTime.Add(votingParams.VotingPeriod), proposal.VotingEndTime)
+	require.Len(t, input.keeper.GetVotes(ctx, proposalID), 2)
+	require.Equal(t, initialModuleAccCoins.Add(deposit...), input.bk.GetAllBalances(ctx, macc.GetAddress()))
+
+	activeQueue := input.keeper.ActiveProposalQueueIterator(ctx, ctx.BlockHeader().Time)
+	require.False(t, activeQueue.Valid())
+	activeQueue.Close()
+
+	newHeader.Time = startTime.Add(votingParams.VotingPeriod)
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	EndBlocker(ctx, input.keeper)
+
+	proposal, ok = input.keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, StatusPassed, proposal.Status)
+	require.Empty(t, input.keeper.GetVotes(ctx, proposalID))
+	require.True(t, input.bk.GetAllBalances(ctx, macc.GetAddress()).IsEqual(initialModuleAccCoins))
+}
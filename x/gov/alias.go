@@ -18,7 +18,9 @@ const (
 	DefaultParamspace     = types.DefaultParamspace
 	TypeMsgDeposit        = types.TypeMsgDeposit
 	TypeMsgVote           = types.TypeMsgVote
+	TypeMsgVoteWeighted   = types.TypeMsgVoteWeighted
 	TypeMsgSubmitProposal = types.TypeMsgSubmitProposal
+	TypeMsgCancelProposal = types.TypeMsgCancelProposal
 	StatusNil             = types.StatusNil
 	StatusDepositPeriod   = types.StatusDepositPeriod
 	StatusVotingPeriod    = types.StatusVotingPeriod
@@ -63,6 +65,10 @@ var (
 	ErrInvalidVote                = types.ErrInvalidVote
 	ErrInvalidGenesis             = types.ErrInvalidGenesis
 	ErrNoProposalHandlerExists    = types.ErrNoProposalHandlerExists
+	ErrInvalidProposalMsg         = types.ErrInvalidProposalMsg
+	ErrUnroutableProposalMsg      = types.ErrUnroutableProposalMsg
+	ErrInvalidSigner              = types.ErrInvalidSigner
+	ErrInvalidProposer            = types.ErrInvalidProposer
 	NewGenesisState               = types.NewGenesisState
 	DefaultGenesisState           = types.DefaultGenesisState
 	ValidateGenesis               = types.ValidateGenesis
@@ -73,23 +79,35 @@ var (
 	ActiveProposalQueueKey        = types.ActiveProposalQueueKey
 	InactiveProposalByTimeKey     = types.InactiveProposalByTimeKey
 	InactiveProposalQueueKey      = types.InactiveProposalQueueKey
+	VotePruningQueueKey           = types.VotePruningQueueKey
 	DepositsKey                   = types.DepositsKey
 	DepositKey                    = types.DepositKey
 	VotesKey                      = types.VotesKey
 	VoteKey                       = types.VoteKey
+	ValidatorTalliesKey           = types.ValidatorTalliesKey
+	ValidatorTallyKey             = types.ValidatorTallyKey
 	SplitProposalKey              = types.SplitProposalKey
 	SplitActiveProposalQueueKey   = types.SplitActiveProposalQueueKey
 	SplitInactiveProposalQueueKey = types.SplitInactiveProposalQueueKey
+	SplitVotePruningQueueKey      = types.SplitVotePruningQueueKey
 	SplitKeyDeposit               = types.SplitKeyDeposit
 	SplitKeyVote                  = types.SplitKeyVote
+	SplitKeyValidatorTally        = types.SplitKeyValidatorTally
 	NewMsgSubmitProposal          = types.NewMsgSubmitProposal
+	NewMsgSubmitProposalWithMsgs  = types.NewMsgSubmitProposalWithMsgs
 	NewMsgDeposit                 = types.NewMsgDeposit
 	NewMsgVote                    = types.NewMsgVote
+	NewMsgVoteWeighted            = types.NewMsgVoteWeighted
+	NewMsgCancelProposal          = types.NewMsgCancelProposal
 	ParamKeyTable                 = types.ParamKeyTable
 	NewDepositParams              = types.NewDepositParams
 	NewTallyParams                = types.NewTallyParams
 	NewVotingParams               = types.NewVotingParams
 	NewParams                     = types.NewParams
+	DefaultDepositParams          = types.DefaultDepositParams
+	DefaultTallyParams            = types.DefaultTallyParams
+	DefaultVotingParams           = types.DefaultVotingParams
+	DefaultParams                 = types.DefaultParams
 	NewProposal                   = types.NewProposal
 	NewRouter                     = types.NewRouter
 	ProposalStatusFromString      = types.ProposalStatusFromString
@@ -104,10 +122,16 @@ var (
 	NewQueryVoteParams            = types.NewQueryVoteParams
 	NewQueryProposalsParams       = types.NewQueryProposalsParams
 	NewValidatorGovInfo           = types.NewValidatorGovInfo
+	NewValidatorTally             = types.NewValidatorTally
 	NewTallyResult                = types.NewTallyResult
 	NewTallyResultFromMap         = types.NewTallyResultFromMap
 	EmptyTallyResult              = types.EmptyTallyResult
 	NewVote                       = types.NewVote
+	NewWeightedVote               = types.NewWeightedVote
+	NewWeightedVoteOption         = types.NewWeightedVoteOption
+	NewNonSplitVoteOption         = types.NewNonSplitVoteOption
+	WeightedVoteOptionsFromString = types.WeightedVoteOptionsFromString
+	ValidWeightedVoteOptions      = types.ValidWeightedVoteOptions
 	VoteOptionFromString          = types.VoteOptionFromString
 	ValidVoteOption               = types.ValidVoteOption
 
@@ -117,14 +141,17 @@ var (
 	ActiveProposalQueuePrefix   = types.ActiveProposalQueuePrefix
 	InactiveProposalQueuePrefix = types.InactiveProposalQueuePrefix
 	ProposalIDKey               = types.ProposalIDKey
+	VotePruningQueuePrefix      = types.VotePruningQueuePrefix
 	DepositsKeyPrefix           = types.DepositsKeyPrefix
 	VotesKeyPrefix              = types.VotesKeyPrefix
+	ValidatorTalliesKeyPrefix   = types.ValidatorTalliesKeyPrefix
 	ParamStoreKeyDepositParams  = types.ParamStoreKeyDepositParams
 	ParamStoreKeyVotingParams   = types.ParamStoreKeyVotingParams
 	ParamStoreKeyTallyParams    = types.ParamStoreKeyTallyParams
 )
 
 type (
+	Hooks                = keeper.Hooks
 	Keeper               = keeper.Keeper
 	Content              = types.Content
 	Handler              = types.Handler
@@ -134,6 +161,8 @@ type (
 	MsgSubmitProposal    = types.MsgSubmitProposal
 	MsgDeposit           = types.MsgDeposit
 	MsgVote              = types.MsgVote
+	MsgVoteWeighted      = types.MsgVoteWeighted
+	MsgCancelProposal    = types.MsgCancelProposal
 	DepositParams        = types.DepositParams
 	TallyParams          = types.TallyParams
 	VotingParams         = types.VotingParams
@@ -148,8 +177,11 @@ type (
 	QueryVoteParams      = types.QueryVoteParams
 	QueryProposalsParams = types.QueryProposalsParams
 	ValidatorGovInfo     = types.ValidatorGovInfo
+	ValidatorTally       = types.ValidatorTally
 	TallyResult          = types.TallyResult
 	Vote                 = types.Vote
 	Votes                = types.Votes
 	VoteOption           = types.VoteOption
+	WeightedVoteOption   = types.WeightedVoteOption
+	WeightedVoteOptions  = types.WeightedVoteOptions
 )
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"bufio"
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -30,6 +31,7 @@ const (
 	flagDepositor    = "depositor"
 	flagStatus       = "status"
 	FlagProposal     = "proposal"
+	FlagExpedited    = "expedited"
 )
 
 type proposal struct {
@@ -37,6 +39,8 @@ type proposal struct {
 	Description string
 	Type        string
 	Deposit     string
+	Expedited   bool
+	Messages    []json.RawMessage
 }
 
 // ProposalFlags defines the core required fields of a proposal. It is used to
@@ -71,6 +75,8 @@ func GetTxCmd(storeKey string, cdc *codec.Codec, pcmds []*cobra.Command) *cobra.
 	govTxCmd.AddCommand(flags.PostCommands(
 		GetCmdDeposit(cdc),
 		GetCmdVote(cdc),
+		GetCmdWeightedVote(cdc),
+		GetCmdCancelProposal(cdc),
 		cmdSubmitProp,
 	)...)
 
@@ -85,6 +91,8 @@ func GetCmdSubmitProposal(cdc *codec.Codec) *cobra.Command {
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Submit a proposal along with an initial deposit.
 Proposal title, description, type and deposit can be given directly or through a proposal JSON file.
+A proposal JSON file may also list messages which are executed by the governance module account if
+the proposal passes; each message must be signed by the governance module account only.
 
 Example:
 $ %s tx gov submit-proposal --proposal="path/to/proposal.json" --from mykey
@@ -101,6 +109,28 @@ Where proposal.json contains:
 Which is equivalent to:
 
 $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome proposal" --type="Text" --deposit="10test" --from mykey
+
+An expedited proposal, which requires a higher deposit and threshold but has a shorter voting period,
+is submitted with the --expedited flag or with "expedited": true in the proposal JSON file.
+
+A proposal spending from the governance module account would contain:
+
+{
+  "title": "Test Proposal",
+  "description": "My awesome proposal",
+  "type": "Text",
+  "deposit": "10test",
+  "messages": [
+    {
+      "type": "cosmos-sdk/MsgSend",
+      "value": {
+        "from_address": "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
+        "to_address": "cosmos1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq",
+        "amount": [{"denom": "test", "amount": "10"}]
+      }
+    }
+  ]
+}
 `,
 				version.ClientName, version.ClientName,
 			),
@@ -122,7 +152,17 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 
 			content := types.ContentFromProposalType(proposal.Title, proposal.Description, proposal.Type)
 
-			msg := types.NewMsgSubmitProposal(content, amount, cliCtx.GetFromAddress())
+			var messages []sdk.Msg
+			for _, bz := range proposal.Messages {
+				var m sdk.Msg
+				if err := cdc.UnmarshalJSON(bz, &m); err != nil {
+					return err
+				}
+				messages = append(messages, m)
+			}
+
+			msg := types.NewMsgSubmitProposalWithMsgs(content, messages, amount, cliCtx.GetFromAddress())
+			msg.IsExpedited = proposal.Expedited
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -136,6 +176,7 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 	cmd.Flags().String(flagProposalType, "", "proposalType of proposal, types: text/parameter_change/software_upgrade")
 	cmd.Flags().String(FlagDeposit, "", "deposit of proposal")
 	cmd.Flags().String(FlagProposal, "", "proposal file path (if this path is given, other proposal flags are ignored)")
+	cmd.Flags().Bool(FlagExpedited, false, "submit the proposal as an expedited proposal")
 
 	return cmd
 }
@@ -236,4 +277,93 @@ $ %s tx gov vote 1 yes --from mykey
 	}
 }
 
+// GetCmdWeightedVote implements creating a new weighted vote command.
+func GetCmdWeightedVote(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "weighted-vote [proposal-id] [weighted-options]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Vote for an active proposal, splitting the voting power between options",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a vote for an active proposal, splitting the voting power
+between the options yes/no/no_with_veto/abstain. The weights must sum to one.
+You can find the proposal-id by running "%s query gov proposals".
+
+
+Example:
+$ %s tx gov weighted-vote 1 yes=0.6,no=0.3,abstain=0.05,no_with_veto=0.05 --from mykey
+`,
+				version.ClientName, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			// Get voting address
+			from := cliCtx.GetFromAddress()
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			// Find out which vote options user chose
+			options, err := types.WeightedVoteOptionsFromString(govutils.NormalizeWeightedVoteOptions(args[1]))
+			if err != nil {
+				return err
+			}
+
+			// Build vote message and run basic validation
+			msg := types.NewMsgVoteWeighted(from, proposalID, options)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
 // DONTCOVER
+
+// GetCmdCancelProposal implements the command to cancel a proposal by its proposer.
+func GetCmdCancelProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel-proposal [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Cancel a proposal you submitted before it is tallied",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel a proposal in the deposit or voting period. Only the proposer
+of the proposal can cancel it. A fraction of the deposits, set by the proposal_cancel_ratio
+deposit param, is burned and the rest is refunded to the depositors.
+
+Example:
+$ %s tx gov cancel-proposal 1 --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+
+			msg := types.NewMsgCancelProposal(proposalID, cliCtx.GetFromAddress())
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
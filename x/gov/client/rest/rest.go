@@ -42,6 +42,8 @@ type PostProposalReq struct {
 	ProposalType   string         `json:"proposal_type" yaml:"proposal_type"`     // Type of proposal. Initial set {PlainTextProposal }
 	Proposer       sdk.AccAddress `json:"proposer" yaml:"proposer"`               // Address of the proposer
 	InitialDeposit sdk.Coins      `json:"initial_deposit" yaml:"initial_deposit"` // Coins to add to the proposal's deposit
+	Messages       []sdk.Msg      `json:"messages" yaml:"messages"`               // Messages executed by the governance module account if the proposal passes
+	Expedited      bool           `json:"expedited" yaml:"expedited"`             // Whether the proposal is expedited
 }
 
 // DepositReq defines the properties of a deposit request's body.
@@ -57,3 +59,16 @@ type VoteReq struct {
 	Voter   sdk.AccAddress `json:"voter" yaml:"voter"`   // address of the voter
 	Option  string         `json:"option" yaml:"option"` // option from OptionSet chosen by the voter
 }
+
+// WeightedVoteReq defines the properties of a weighted vote request's body.
+type WeightedVoteReq struct {
+	BaseReq rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Voter   sdk.AccAddress `json:"voter" yaml:"voter"`     // address of the voter
+	Options string         `json:"options" yaml:"options"` // weighted options chosen by the voter, e.g. "yes=0.6,no=0.4"
+}
+
+// CancelProposalReq defines the properties of a cancel proposal request's body.
+type CancelProposalReq struct {
+	BaseReq  rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Proposer sdk.AccAddress `json:"proposer" yaml:"proposer"` // address of the proposer
+}
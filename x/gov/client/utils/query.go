@@ -78,8 +78,9 @@ func QueryDepositsByTxQuery(cliCtx context.CLIContext, params types.QueryProposa
 // marshalled result or any error that occurred.
 func QueryVotesByTxQuery(cliCtx context.CLIContext, params types.QueryProposalVotesParams) ([]byte, error) {
 	var (
+		// votes cast through either a MsgVote or a MsgVoteWeighted emit the
+		// proposal vote event
 		events = []string{
-			fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, types.TypeMsgVote),
 			fmt.Sprintf("%s.%s='%s'", types.EventTypeProposalVote, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", params.ProposalID))),
 		}
 		votes      []types.Vote
@@ -95,14 +96,8 @@ func QueryVotesByTxQuery(cliCtx context.CLIContext, params types.QueryProposalVo
 		nextTxPage++
 		for _, info := range searchResult.Txs {
 			for _, msg := range info.Tx.GetMsgs() {
-				if msg.Type() == types.TypeMsgVote {
-					voteMsg := msg.(types.MsgVote)
-
-					votes = append(votes, types.Vote{
-						Voter:      voteMsg.Voter,
-						ProposalID: params.ProposalID,
-						Option:     voteMsg.Option,
-					})
+				if vote, ok := voteFromMsg(msg, params.ProposalID); ok {
+					votes = append(votes, vote)
 				}
 			}
 		}
@@ -125,7 +120,6 @@ func QueryVotesByTxQuery(cliCtx context.CLIContext, params types.QueryProposalVo
 // QueryVoteByTxQuery will query for a single vote via a direct txs tags query.
 func QueryVoteByTxQuery(cliCtx context.CLIContext, params types.QueryVoteParams) ([]byte, error) {
 	events := []string{
-		fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, types.TypeMsgVote),
 		fmt.Sprintf("%s.%s='%s'", types.EventTypeProposalVote, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", params.ProposalID))),
 		fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeySender, []byte(params.Voter.String())),
 	}
@@ -139,15 +133,7 @@ func QueryVoteByTxQuery(cliCtx context.CLIContext, params types.QueryVoteParams)
 	for _, info := range searchResult.Txs {
 		for _, msg := range info.Tx.GetMsgs() {
 			// there should only be a single vote under the given conditions
-			if msg.Type() == types.TypeMsgVote {
-				voteMsg := msg.(types.MsgVote)
-
-				vote := types.Vote{
-					Voter:      voteMsg.Voter,
-					ProposalID: params.ProposalID,
-					Option:     voteMsg.Option,
-				}
-
+			if vote, ok := voteFromMsg(msg, params.ProposalID); ok && vote.Voter.Equals(params.Voter) {
 				if cliCtx.Indent {
 					return cliCtx.Codec.MarshalJSONIndent(vote, "", "  ")
 				}
@@ -160,6 +146,21 @@ func QueryVoteByTxQuery(cliCtx context.CLIContext, params types.QueryVoteParams)
 	return nil, fmt.Errorf("address '%s' did not vote on proposalID %d", params.Voter, params.ProposalID)
 }
 
+// voteFromMsg builds the vote cast on a proposal by a MsgVote or a
+// MsgVoteWeighted. It returns false for any other message.
+func voteFromMsg(msg sdk.Msg, proposalID uint64) (types.Vote, bool) {
+	switch msg := msg.(type) {
+	case types.MsgVote:
+		return types.NewVote(proposalID, msg.Voter, msg.Option), msg.ProposalID == proposalID
+
+	case types.MsgVoteWeighted:
+		return types.NewWeightedVote(proposalID, msg.Voter, msg.Options), msg.ProposalID == proposalID
+
+	default:
+		return types.Vote{}, false
+	}
+}
+
 // QueryDepositByTxQuery will query for a single deposit via a direct txs tags
 // query.
 func QueryDepositByTxQuery(cliCtx context.CLIContext, params types.QueryDepositParams) ([]byte, error) {
@@ -40,6 +40,18 @@ func InitGenesis(ctx sdk.Context, bk types.BankKeeper, supplyKeeper types.Supply
 		k.SetProposal(ctx, proposal)
 	}
 
+	// the votes of the ended proposals which were not pruned yet are queued
+	// for pruning again
+	for _, vote := range data.Votes {
+		proposal, found := k.GetProposal(ctx, vote.ProposalID)
+		if !found || proposal.Status != StatusVotingPeriod {
+			k.InsertVotePruningQueue(ctx, vote.ProposalID)
+		}
+	}
+
+	// the running tallies are not exported but recomputed from the votes
+	k.RebuildValidatorTallies(ctx)
+
 	// add coins if not provided on genesis
 	if bk.GetAllBalances(ctx, moduleAcc.GetAddress()).IsZero() {
 		if err := bk.SetBalances(ctx, moduleAcc.GetAddress(), totalDeposits); err != nil {
@@ -58,15 +70,15 @@ func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 	proposals := k.GetProposals(ctx)
 
 	var proposalsDeposits Deposits
-	var proposalsVotes Votes
 	for _, proposal := range proposals {
 		deposits := k.GetDeposits(ctx, proposal.ProposalID)
 		proposalsDeposits = append(proposalsDeposits, deposits...)
-
-		votes := k.GetVotes(ctx, proposal.ProposalID)
-		proposalsVotes = append(proposalsVotes, votes...)
 	}
 
+	// the votes of the ended proposals which are not pruned yet are exported
+	// along with the votes of the proposals in voting period
+	proposalsVotes := k.GetAllVotes(ctx)
+
 	return GenesisState{
 		StartingProposalID: startingProposalID,
 		Deposits:           proposalsDeposits,
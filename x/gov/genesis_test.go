@@ -75,6 +75,49 @@ func TestImportExportQueues(t *testing.T) {
 	require.True(t, proposal2.Status == StatusRejected)
 }
 
+func TestImportExportVotePruningQueue(t *testing.T) {
+	input := getMockApp(t, 2, GenesisState{}, nil, ProposalHandler)
+	SortAddresses(input.addrs)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := input.mApp.BaseApp.NewContext(false, abci.Header{})
+
+	proposal, err := input.keeper.SubmitProposal(ctx, keep.TestProposal)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
+
+	_, err = input.keeper.AddDeposit(ctx, proposalID, input.addrs[0], input.keeper.GetDepositParams(ctx).MinDeposit)
+	require.NoError(t, err)
+	require.NoError(t, input.keeper.AddVote(ctx, proposalID, input.addrs[0], OptionYes))
+	require.NoError(t, input.keeper.AddVote(ctx, proposalID, input.addrs[1], OptionNo))
+
+	// end the proposal without pruning its votes
+	proposal, ok := input.keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	input.keeper.RemoveFromActiveProposalQueue(ctx, proposalID, proposal.VotingEndTime)
+	proposal.Status = StatusRejected
+	input.keeper.SetProposal(ctx, proposal)
+	input.keeper.DeleteVotes(ctx, proposalID)
+
+	// the votes left to prune are exported and queued again on import
+	genState := ExportGenesis(ctx, input.keeper)
+	require.Len(t, genState.Votes, 2)
+
+	genAccs := input.mApp.AccountKeeper.GetAllAccounts(ctx)
+	input2 := getMockApp(t, 2, genState, genAccs, ProposalHandler)
+
+	header = abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input2.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx2 := input2.mApp.BaseApp.NewContext(false, abci.Header{})
+	require.Len(t, input2.keeper.GetVotes(ctx2, proposalID), 2)
+
+	EndBlocker(ctx2, input2.keeper)
+	require.Empty(t, input2.keeper.GetVotes(ctx2, proposalID))
+}
+
 func TestEqualProposals(t *testing.T) {
 	// Generate mock app and keepers
 	input := getMockApp(t, 2, GenesisState{}, nil, ProposalHandler)
@@ -118,7 +118,7 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 
 	// Check if deposit has provided sufficient total funds to transition the proposal into the voting period
 	activatedVotingPeriod := false
-	if proposal.Status == types.StatusDepositPeriod && proposal.TotalDeposit.IsAllGTE(keeper.GetDepositParams(ctx).MinDeposit) {
+	if proposal.Status == types.StatusDepositPeriod && proposal.TotalDeposit.IsAllGTE(keeper.GetDepositParams(ctx).GetMinDeposit(proposal.IsExpedited)) {
 		keeper.activateVotingPeriod(ctx, proposal)
 		activatedVotingPeriod = true
 	}
@@ -143,6 +143,38 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 	return activatedVotingPeriod, nil
 }
 
+// CancelDeposits burns the given fraction of each deposit on a specific
+// proposal, refunds the rest to the depositors and deletes the deposits
+func (keeper Keeper) CancelDeposits(ctx sdk.Context, proposalID uint64, burnRatio sdk.Dec) {
+	store := ctx.KVStore(keeper.storeKey)
+	burnAmount := sdk.NewCoins()
+
+	keeper.IterateDeposits(ctx, proposalID, func(deposit types.Deposit) bool {
+		burn := sdk.NewCoins()
+		for _, coin := range deposit.Amount {
+			burn = burn.Add(sdk.NewCoin(coin.Denom, coin.Amount.ToDec().Mul(burnRatio).TruncateInt()))
+		}
+
+		refund := deposit.Amount.Sub(burn)
+		if !refund.IsZero() {
+			err := keeper.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, deposit.Depositor, refund)
+			if err != nil {
+				panic(err)
+			}
+		}
+
+		burnAmount = burnAmount.Add(burn...)
+		store.Delete(types.DepositKey(proposalID, deposit.Depositor))
+		return false
+	})
+
+	if !burnAmount.IsZero() {
+		if err := keeper.supplyKeeper.BurnCoins(ctx, types.ModuleName, burnAmount); err != nil {
+			panic(err)
+		}
+	}
+}
+
 // RefundDeposits refunds and deletes all the deposits on a specific proposal
 func (keeper Keeper) RefundDeposits(ctx sdk.Context, proposalID uint64) {
 	store := ctx.KVStore(keeper.storeKey)
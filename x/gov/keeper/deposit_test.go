@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func TestDeposits(t *testing.T) {
@@ -96,3 +97,30 @@ func TestDeposits(t *testing.T) {
 	require.Equal(t, addr0Initial, bk.GetAllBalances(ctx, TestAddrs[0]))
 	require.Equal(t, addr1Initial, bk.GetAllBalances(ctx, TestAddrs[1]))
 }
+
+func TestExpeditedProposalDeposits(t *testing.T) {
+	ctx, _, _, keeper, _, _ := createTestInput(t, false, 100)
+
+	proposal, err := keeper.SubmitProposalWithMsgs(ctx, TestProposal, nil, nil, true)
+	require.NoError(t, err)
+	require.True(t, proposal.IsExpedited)
+	proposalID := proposal.ProposalID
+
+	depositParams := keeper.GetDepositParams(ctx)
+
+	// the regular minimum deposit does not activate an expedited proposal
+	votingStarted, err := keeper.AddDeposit(ctx, proposalID, TestAddrs[0], depositParams.MinDeposit)
+	require.NoError(t, err)
+	require.False(t, votingStarted)
+
+	remaining := depositParams.MinExpeditedDeposit.Sub(depositParams.MinDeposit)
+	votingStarted, err = keeper.AddDeposit(ctx, proposalID, TestAddrs[1], remaining)
+	require.NoError(t, err)
+	require.True(t, votingStarted)
+
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, types.StatusVotingPeriod, proposal.Status)
+	expeditedVotingPeriod := keeper.GetVotingParams(ctx).ExpeditedVotingPeriod
+	require.Equal(t, proposal.VotingStartTime.Add(expeditedVotingPeriod), proposal.VotingEndTime)
+}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// Hooks wrapper struct for gov keeper
+type Hooks struct {
+	k Keeper
+}
+
+var _ types.StakingHooks = Hooks{}
+
+// Hooks returns the wrapper struct keeping the running tallies of the proposals
+// in voting period up to date with the delegations of their voters
+func (keeper Keeper) Hooks() Hooks {
+	return Hooks{keeper}
+}
+
+// BeforeDelegationSharesModified removes the shares of the delegation from the
+// running tallies of the proposals the delegator voted on
+func (h Hooks) BeforeDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	delegation := h.k.sk.Delegation(ctx, delAddr, valAddr)
+	if delegation == nil {
+		return
+	}
+
+	h.k.iterateActiveVotes(ctx, delAddr, func(vote types.Vote) bool {
+		h.k.removeDelegationFromTally(ctx, vote, delegation)
+		return false
+	})
+}
+
+// AfterDelegationModified adds the new shares of the delegation to the running
+// tallies of the proposals the delegator voted on
+func (h Hooks) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	delegation := h.k.sk.Delegation(ctx, delAddr, valAddr)
+	if delegation == nil {
+		return
+	}
+
+	h.k.iterateActiveVotes(ctx, delAddr, func(vote types.Vote) bool {
+		h.k.addDelegationToTally(ctx, vote, delegation)
+		return false
+	})
+}
+
+// nolint - unused hooks
+func (h Hooks) AfterValidatorCreated(_ sdk.Context, _ sdk.ValAddress)                           {}
+func (h Hooks) BeforeValidatorModified(_ sdk.Context, _ sdk.ValAddress)                         {}
+func (h Hooks) AfterValidatorRemoved(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress)        {}
+func (h Hooks) AfterValidatorBonded(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress)         {}
+func (h Hooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) {}
+func (h Hooks) BeforeDelegationCreated(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress)       {}
+func (h Hooks) BeforeDelegationRemoved(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress)       {}
+func (h Hooks) BeforeValidatorSlashed(_ sdk.Context, _ sdk.ValAddress, _ sdk.Dec)               {}
+func (h Hooks) AfterConsensusPubKeyRotated(_ sdk.Context, _, _ crypto.PubKey, _ sdk.ValAddress) {}
+func (h Hooks) AfterValidatorRetired(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress)        {}
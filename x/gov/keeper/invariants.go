@@ -7,17 +7,24 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/exported"
 )
 
 // RegisterInvariants registers all governance invariants
 func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper, bk types.BankKeeper) {
 	ir.RegisterRoute(types.ModuleName, "module-account", ModuleAccountInvariant(keeper, bk))
+	ir.RegisterRoute(types.ModuleName, "validator-tallies", ValidatorTalliesInvariant(keeper))
 }
 
 // AllInvariants runs all invariants of the governance module
 func AllInvariants(keeper Keeper, bk types.BankKeeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
-		return ModuleAccountInvariant(keeper, bk)(ctx)
+		res, stop := ModuleAccountInvariant(keeper, bk)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return ValidatorTalliesInvariant(keeper)(ctx)
 	}
 }
 
@@ -41,3 +48,48 @@ func ModuleAccountInvariant(keeper Keeper, bk types.BankKeeper) sdk.Invariant {
 				balances, expectedDeposits)), broken
 	}
 }
+
+// ValidatorTalliesInvariant checks that the running tallies of the proposals in
+// voting period match the delegations of their voters
+func ValidatorTalliesInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var broken bool
+
+		keeper.iterateActiveProposalIDs(ctx, func(proposalID uint64) bool {
+			expected := make(map[string]types.ValidatorTally)
+			keeper.IterateVotes(ctx, proposalID, func(vote types.Vote) bool {
+				keeper.sk.IterateDelegations(ctx, vote.Voter, func(_ int64, delegation exported.DelegationI) bool {
+					valAddrStr := delegation.GetValidatorAddr().String()
+					valTally, ok := expected[valAddrStr]
+					if !ok {
+						valTally = types.NewValidatorTally()
+					}
+
+					expected[valAddrStr] = valTally.AddVote(delegation.GetShares(), vote.GetOptions())
+					return false
+				})
+				return false
+			})
+
+			count := 0
+			keeper.IterateValidatorTallies(ctx, proposalID, func(valAddr sdk.ValAddress, valTally types.ValidatorTally) bool {
+				count++
+				if expTally, ok := expected[valAddr.String()]; !ok || !expTally.Equal(valTally) {
+					broken = true
+					msg += fmt.Sprintf("\tproposal %d validator %s running tally:\n%s\n", proposalID, valAddr, valTally)
+				}
+				return false
+			})
+
+			if count != len(expected) {
+				broken = true
+				msg += fmt.Sprintf("\tproposal %d has %d running tallies, expected %d\n", proposalID, count, len(expected))
+			}
+
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "validator tallies", msg), broken
+	}
+}
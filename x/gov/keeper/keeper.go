@@ -31,6 +31,9 @@ type Keeper struct {
 
 	// Proposal router
 	router types.Router
+
+	// Message router used to execute the messages of passed proposals
+	msgRouter sdk.Router
 }
 
 // NewKeeper returns a governance keeper. It handles:
@@ -39,10 +42,13 @@ type Keeper struct {
 // - users voting on proposals, with weight proportional to stake in the system
 // - and tallying the result of the vote.
 //
+// The message router is used to execute the messages carried by passed
+// proposals on behalf of the governance module account.
+//
 // CONTRACT: the parameter Subspace must have the param key table already initialized
 func NewKeeper(
 	cdc *codec.Codec, key sdk.StoreKey, paramSpace types.ParamSubspace,
-	supplyKeeper types.SupplyKeeper, sk types.StakingKeeper, rtr types.Router,
+	supplyKeeper types.SupplyKeeper, sk types.StakingKeeper, rtr types.Router, msgRouter sdk.Router,
 ) Keeper {
 
 	// ensure governance module account is set
@@ -62,6 +68,7 @@ func NewKeeper(
 		sk:           sk,
 		cdc:          cdc,
 		router:       rtr,
+		msgRouter:    msgRouter,
 	}
 }
 
@@ -108,6 +115,13 @@ func (keeper Keeper) RemoveFromInactiveProposalQueue(ctx sdk.Context, proposalID
 	store.Delete(types.InactiveProposalQueueKey(proposalID, endTime))
 }
 
+// InsertVotePruningQueue inserts a ProposalID into the vote pruning queue
+func (keeper Keeper) InsertVotePruningQueue(ctx sdk.Context, proposalID uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := types.GetProposalIDBytes(proposalID)
+	store.Set(types.VotePruningQueueKey(proposalID), bz)
+}
+
 // Iterators
 
 // IterateActiveProposalsQueue iterates over the proposals in the active proposal queue
@@ -129,6 +143,21 @@ func (keeper Keeper) IterateActiveProposalsQueue(ctx sdk.Context, endTime time.T
 	}
 }
 
+// iterateActiveProposalIDs iterates over the IDs of all the proposals in the
+// active proposal queue without loading the proposals
+func (keeper Keeper) iterateActiveProposalIDs(ctx sdk.Context, cb func(proposalID uint64) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ActiveProposalQueuePrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		proposalID, _ := types.SplitActiveProposalQueueKey(iterator.Key())
+		if cb(proposalID) {
+			break
+		}
+	}
+}
+
 // IterateInactiveProposalsQueue iterates over the proposals in the inactive proposal queue
 // and performs a callback function
 func (keeper Keeper) IterateInactiveProposalsQueue(ctx sdk.Context, endTime time.Time, cb func(proposal types.Proposal) (stop bool)) {
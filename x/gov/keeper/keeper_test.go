@@ -2,9 +2,11 @@ package keeper
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -49,3 +51,30 @@ func TestProposalQueues(t *testing.T) {
 	require.Equal(t, proposalID, proposal.ProposalID)
 	activeIterator.Close()
 }
+
+func TestMigrateParams(t *testing.T) {
+	ctx, _, _, keeper, _, _ := createTestInput(t, false, 100) // nolint: dogsled
+
+	// store the params of a chain upgrading from a version without expedited or cancelled proposals
+	depositParams := keeper.GetDepositParams(ctx)
+	depositParams.MinExpeditedDeposit = nil
+	depositParams.ProposalCancelRatio = sdk.Dec{}
+	keeper.SetDepositParams(ctx, depositParams)
+	keeper.SetVotingParams(ctx, types.VotingParams{VotingPeriod: types.DefaultPeriod})
+	tallyParams := keeper.GetTallyParams(ctx)
+	tallyParams.ExpeditedThreshold = sdk.Dec{}
+	keeper.SetTallyParams(ctx, tallyParams)
+	require.True(t, keeper.GetTallyParams(ctx).GetThreshold(true).IsNil())
+
+	defaults := types.DefaultParams()
+	keeper.MigrateParams(ctx, defaults)
+	require.Equal(t, defaults.DepositParams, keeper.GetDepositParams(ctx))
+	require.Equal(t, defaults.VotingParams, keeper.GetVotingParams(ctx))
+	require.Equal(t, defaults.TallyParams.ExpeditedThreshold, keeper.GetTallyParams(ctx).ExpeditedThreshold)
+
+	// params already set are kept
+	votingParams := types.NewVotingParams(types.DefaultPeriod, time.Hour)
+	keeper.SetVotingParams(ctx, votingParams)
+	keeper.MigrateParams(ctx, defaults)
+	require.Equal(t, votingParams, keeper.GetVotingParams(ctx))
+}
@@ -40,3 +40,29 @@ func (keeper Keeper) SetVotingParams(ctx sdk.Context, votingParams types.VotingP
 func (keeper Keeper) SetTallyParams(ctx sdk.Context, tallyParams types.TallyParams) {
 	keeper.paramSpace.Set(ctx, types.ParamStoreKeyTallyParams, &tallyParams)
 }
+
+// MigrateParams sets the expedited proposal and proposal cancel ratio params
+// left unset by chains upgrading from a version without them to the given
+// defaults. It is meant to be called from the upgrade handler introducing them.
+func (keeper Keeper) MigrateParams(ctx sdk.Context, defaults types.Params) {
+	depositParams := keeper.GetDepositParams(ctx)
+	if depositParams.MinExpeditedDeposit.Empty() {
+		depositParams.MinExpeditedDeposit = defaults.DepositParams.MinExpeditedDeposit
+	}
+	if depositParams.ProposalCancelRatio.IsNil() {
+		depositParams.ProposalCancelRatio = defaults.DepositParams.ProposalCancelRatio
+	}
+	keeper.SetDepositParams(ctx, depositParams)
+
+	votingParams := keeper.GetVotingParams(ctx)
+	if votingParams.ExpeditedVotingPeriod == 0 {
+		votingParams.ExpeditedVotingPeriod = defaults.VotingParams.ExpeditedVotingPeriod
+	}
+	keeper.SetVotingParams(ctx, votingParams)
+
+	tallyParams := keeper.GetTallyParams(ctx)
+	if tallyParams.ExpeditedThreshold.IsNil() {
+		tallyParams.ExpeditedThreshold = defaults.TallyParams.ExpeditedThreshold
+	}
+	keeper.SetTallyParams(ctx, tallyParams)
+}
@@ -11,6 +11,20 @@ import (
 
 // SubmitProposal create new proposal given a content
 func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (types.Proposal, error) {
+	return keeper.SubmitProposalWithMsgs(ctx, content, nil, nil, false)
+}
+
+// SubmitProposalWithMsgs create new proposal given a content and the
+// messages to execute on behalf of the governance module account if it passes.
+// Expedited proposals use the expedited minimum deposit, voting period and
+// threshold. The proposer is recorded so that it may cancel the proposal.
+func (keeper Keeper) SubmitProposalWithMsgs(
+	ctx sdk.Context, content types.Content, msgs []sdk.Msg, proposer sdk.AccAddress, expedited bool,
+) (types.Proposal, error) {
+	if err := keeper.validateProposalMessages(ctx, msgs); err != nil {
+		return types.Proposal{}, err
+	}
+
 	if !keeper.router.HasRoute(content.ProposalRoute()) {
 		return types.Proposal{}, sdkerrors.Wrap(types.ErrNoProposalHandlerExists, content.ProposalRoute())
 	}
@@ -33,6 +47,9 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (typ
 	depositPeriod := keeper.GetDepositParams(ctx).MaxDepositPeriod
 
 	proposal := types.NewProposal(content, proposalID, submitTime, submitTime.Add(depositPeriod))
+	proposal.Messages = msgs
+	proposal.IsExpedited = expedited
+	proposal.Proposer = proposer
 
 	keeper.SetProposal(ctx, proposal)
 	keeper.InsertInactiveProposalQueue(ctx, proposalID, proposal.DepositEndTime)
@@ -48,6 +65,79 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (typ
 	return proposal, nil
 }
 
+// validateProposalMessages ensures every proposal message can be routed and is
+// signed by the governance module account only
+func (keeper Keeper) validateProposalMessages(ctx sdk.Context, msgs []sdk.Msg) error {
+	govAddr := keeper.supplyKeeper.GetModuleAddress(types.ModuleName)
+
+	for i, msg := range msgs {
+		if keeper.msgRouter.Route(ctx, msg.Route()) == nil {
+			return sdkerrors.Wrapf(types.ErrUnroutableProposalMsg, "message %d: %s", i, msg.Route())
+		}
+
+		signers := msg.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(govAddr) {
+			return sdkerrors.Wrapf(types.ErrInvalidSigner, "message %d: %v", i, signers)
+		}
+	}
+
+	return nil
+}
+
+// ExecuteProposalMessages executes the messages of a passed proposal in order
+// on behalf of the governance module account. Execution stops at the first
+// failing message; the caller is expected to discard any state changes in that
+// case. Events emitted by the message handlers are added to the context.
+func (keeper Keeper) ExecuteProposalMessages(ctx sdk.Context, proposal types.Proposal) error {
+	for i, msg := range proposal.Messages {
+		handler := keeper.msgRouter.Route(ctx, msg.Route())
+		if handler == nil {
+			return sdkerrors.Wrapf(types.ErrUnroutableProposalMsg, "message %d: %s", i, msg.Route())
+		}
+
+		res, err := handler(ctx, msg)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "message %d", i)
+		}
+
+		ctx.EventManager().EmitEvents(res.Events)
+	}
+
+	return nil
+}
+
+// CancelProposal cancels a proposal in the deposit or voting period on behalf
+// of its proposer. The ProposalCancelRatio fraction of the deposits is burned
+// and the rest refunded, after which the proposal, its votes and its queue
+// entries are deleted.
+func (keeper Keeper) CancelProposal(ctx sdk.Context, proposalID uint64, proposer sdk.AccAddress) error {
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
+	}
+
+	if (proposal.Status != types.StatusDepositPeriod) && (proposal.Status != types.StatusVotingPeriod) {
+		return sdkerrors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
+	}
+
+	if proposal.Proposer.Empty() || !proposal.Proposer.Equals(proposer) {
+		return sdkerrors.Wrapf(types.ErrInvalidProposer, "%s", proposer)
+	}
+
+	keeper.CancelDeposits(ctx, proposalID, keeper.GetDepositParams(ctx).ProposalCancelRatio)
+	keeper.DeleteVotes(ctx, proposalID)
+	keeper.DeleteProposal(ctx, proposalID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelProposal,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+		),
+	)
+
+	return nil
+}
+
 // GetProposal get proposal from store by ProposalID
 func (keeper Keeper) GetProposal(ctx sdk.Context, proposalID uint64) (proposal types.Proposal, ok bool) {
 	store := ctx.KVStore(keeper.storeKey)
@@ -169,7 +259,7 @@ func (keeper Keeper) SetProposalID(ctx sdk.Context, proposalID uint64) {
 
 func (keeper Keeper) activateVotingPeriod(ctx sdk.Context, proposal types.Proposal) {
 	proposal.VotingStartTime = ctx.BlockHeader().Time
-	votingPeriod := keeper.GetVotingParams(ctx).VotingPeriod
+	votingPeriod := keeper.GetVotingParams(ctx).GetVotingPeriod(proposal.IsExpedited)
 	proposal.VotingEndTime = proposal.VotingStartTime.Add(votingPeriod)
 	proposal.Status = types.StatusVotingPeriod
 	keeper.SetProposal(ctx, proposal)
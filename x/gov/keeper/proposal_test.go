@@ -10,7 +10,9 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
 )
 
 func TestGetSetProposal(t *testing.T) {
@@ -123,6 +125,130 @@ func TestSubmitProposal(t *testing.T) {
 	}
 }
 
+func TestSubmitProposalWithMsgs(t *testing.T) {
+	ctx, _, _, keeper, _, _ := createTestInput(t, false, 100) // nolint: dogsled
+
+	govAddr := keeper.GetGovernanceAccount(ctx).GetAddress()
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+
+	testCases := []struct {
+		msgs        []sdk.Msg
+		expectedErr error
+	}{
+		{[]sdk.Msg{bank.NewMsgSend(govAddr, TestAddrs[0], coins)}, nil},
+		{[]sdk.Msg{bank.NewMsgSend(TestAddrs[0], TestAddrs[1], coins)}, types.ErrInvalidSigner},
+		{
+			[]sdk.Msg{bank.NewMsgMultiSend(
+				[]bank.Input{bank.NewInput(govAddr, coins), bank.NewInput(TestAddrs[0], coins)},
+				[]bank.Output{bank.NewOutput(TestAddrs[1], coins.Add(coins...))},
+			)},
+			types.ErrInvalidSigner,
+		},
+		{[]sdk.Msg{staking.NewMsgUndelegate(govAddr, sdk.ValAddress(TestAddrs[0]), coins[0])}, types.ErrUnroutableProposalMsg},
+	}
+
+	for i, tc := range testCases {
+		proposal, err := keeper.SubmitProposalWithMsgs(ctx, TestProposal, tc.msgs, nil, false)
+		if tc.expectedErr != nil {
+			require.True(t, errors.Is(err, tc.expectedErr), "tc #%d; got: %v, expected: %v", i, err, tc.expectedErr)
+			continue
+		}
+
+		require.NoError(t, err, "tc #%d", i)
+		gotProposal, ok := keeper.GetProposal(ctx, proposal.ProposalID)
+		require.True(t, ok)
+		require.Equal(t, tc.msgs, gotProposal.Messages)
+	}
+}
+
+func TestCancelProposal(t *testing.T) {
+	ctx, _, bk, keeper, _, _ := createTestInput(t, false, 100) // nolint: dogsled
+
+	govAddr := keeper.GetGovernanceAccount(ctx).GetAddress()
+	fourStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(4)))
+	sixStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(6)))
+
+	// proposals submitted without a proposer cannot be cancelled
+	proposal, err := keeper.SubmitProposal(ctx, TestProposal)
+	require.NoError(t, err)
+	err = keeper.CancelProposal(ctx, proposal.ProposalID, TestAddrs[0])
+	require.True(t, errors.Is(err, types.ErrInvalidProposer))
+
+	proposal, err = keeper.SubmitProposalWithMsgs(ctx, TestProposal, nil, TestAddrs[0], false)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
+
+	addr0Initial := bk.GetAllBalances(ctx, TestAddrs[0])
+	addr1Initial := bk.GetAllBalances(ctx, TestAddrs[1])
+	_, err = keeper.AddDeposit(ctx, proposalID, TestAddrs[0], fourStake)
+	require.NoError(t, err)
+	_, err = keeper.AddDeposit(ctx, proposalID, TestAddrs[1], sixStake)
+	require.NoError(t, err)
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, types.StatusVotingPeriod, proposal.Status)
+	require.NoError(t, keeper.AddVote(ctx, proposalID, TestAddrs[1], types.OptionYes))
+
+	// only the proposer can cancel
+	err = keeper.CancelProposal(ctx, proposalID, TestAddrs[1])
+	require.True(t, errors.Is(err, types.ErrInvalidProposer))
+
+	err = keeper.CancelProposal(ctx, 100, TestAddrs[0])
+	require.True(t, errors.Is(err, types.ErrUnknownProposal))
+
+	moduleBalance := bk.GetAllBalances(ctx, govAddr)
+	require.NoError(t, keeper.CancelProposal(ctx, proposalID, TestAddrs[0]))
+
+	_, ok = keeper.GetProposal(ctx, proposalID)
+	require.False(t, ok)
+	require.Empty(t, keeper.GetDeposits(ctx, proposalID))
+
+	// the votes are deleted once pruned
+	require.Len(t, keeper.GetVotes(ctx, proposalID), 1)
+	require.Equal(t, 1, keeper.PruneVotes(ctx, 10))
+	require.Empty(t, keeper.GetVotes(ctx, proposalID))
+
+	activeIterator := keeper.ActiveProposalQueueIterator(ctx, proposal.VotingEndTime)
+	require.False(t, activeIterator.Valid())
+	activeIterator.Close()
+
+	// half of each deposit is burned, the other half refunded
+	ratio := keeper.GetDepositParams(ctx).ProposalCancelRatio
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), ratio)
+	twoStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(2)))
+	threeStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(3)))
+	require.Equal(t, addr0Initial.Sub(twoStake), bk.GetAllBalances(ctx, TestAddrs[0]))
+	require.Equal(t, addr1Initial.Sub(threeStake), bk.GetAllBalances(ctx, TestAddrs[1]))
+	require.Equal(t, moduleBalance.Sub(fourStake).Sub(sixStake), bk.GetAllBalances(ctx, govAddr))
+
+	// a proposal that is no longer active cannot be cancelled
+	proposal, err = keeper.SubmitProposalWithMsgs(ctx, TestProposal, nil, TestAddrs[0], false)
+	require.NoError(t, err)
+	proposal.Status = types.StatusPassed
+	keeper.SetProposal(ctx, proposal)
+	err = keeper.CancelProposal(ctx, proposal.ProposalID, TestAddrs[0])
+	require.True(t, errors.Is(err, types.ErrInactiveProposal))
+}
+
+func TestExecuteProposalMessages(t *testing.T) {
+	ctx, _, bankKeeper, keeper, _, _ := createTestInput(t, false, 100) // nolint: dogsled
+
+	govAddr := keeper.GetGovernanceAccount(ctx).GetAddress()
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	require.NoError(t, bankKeeper.SetBalances(ctx, govAddr, coins))
+	balance := bankKeeper.GetAllBalances(ctx, TestAddrs[0])
+
+	proposal, err := keeper.SubmitProposalWithMsgs(ctx, TestProposal, []sdk.Msg{bank.NewMsgSend(govAddr, TestAddrs[0], coins)}, nil, false)
+	require.NoError(t, err)
+
+	require.NoError(t, keeper.ExecuteProposalMessages(ctx, proposal))
+	require.Equal(t, balance.Add(coins...), bankKeeper.GetAllBalances(ctx, TestAddrs[0]))
+	require.True(t, bankKeeper.GetAllBalances(ctx, govAddr).IsZero())
+
+	// the governance module account no longer has the funds to send
+	require.Error(t, keeper.ExecuteProposalMessages(ctx, proposal))
+}
+
 func TestGetProposalsFiltered(t *testing.T) {
 	proposalID := uint64(1)
 	ctx, _, _, keeper, _, _ := createTestInput(t, false, 100) // nolint: dogsled
@@ -153,7 +153,7 @@ func TestQueries(t *testing.T) {
 	depositParams, _, _ := getQueriedParams(t, ctx, keeper.cdc, querier)
 
 	// TestAddrs[0] proposes (and deposits) proposals #1 and #2
-	proposal1, err := keeper.SubmitProposal(ctx, tp)
+	proposal1, err := keeper.SubmitProposalWithMsgs(ctx, tp, nil, TestAddrs[0], false)
 	require.NoError(t, err)
 	deposit1 := types.NewDeposit(proposal1.ProposalID, TestAddrs[0], oneCoins)
 	_, err = keeper.AddDeposit(ctx, deposit1.ProposalID, deposit1.Depositor, deposit1.Amount)
@@ -161,7 +161,7 @@ func TestQueries(t *testing.T) {
 
 	proposal1.TotalDeposit = proposal1.TotalDeposit.Add(deposit1.Amount...)
 
-	proposal2, err := keeper.SubmitProposal(ctx, tp)
+	proposal2, err := keeper.SubmitProposalWithMsgs(ctx, tp, nil, TestAddrs[0], false)
 	require.NoError(t, err)
 	deposit2 := types.NewDeposit(proposal2.ProposalID, TestAddrs[0], consCoins)
 	_, err = keeper.AddDeposit(ctx, deposit2.ProposalID, deposit2.Depositor, deposit2.Amount)
@@ -170,7 +170,7 @@ func TestQueries(t *testing.T) {
 	proposal2.TotalDeposit = proposal2.TotalDeposit.Add(deposit2.Amount...)
 
 	// TestAddrs[1] proposes (and deposits) on proposal #3
-	proposal3, err := keeper.SubmitProposal(ctx, tp)
+	proposal3, err := keeper.SubmitProposalWithMsgs(ctx, tp, nil, TestAddrs[1], false)
 	require.NoError(t, err)
 	deposit3 := types.NewDeposit(proposal3.ProposalID, TestAddrs[1], oneCoins)
 	_, err = keeper.AddDeposit(ctx, deposit3.ProposalID, deposit3.Depositor, deposit3.Amount)
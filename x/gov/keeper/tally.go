@@ -6,10 +6,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/staking/exported"
 )
 
-// TODO: Break into several smaller functions for clarity
-
-// Tally iterates over the votes and updates the tally of a proposal based on the voting power of the
-// voters
+// Tally computes the result of a proposal from the running tallies of the
+// bonded validators, which hold the delegator shares of the voters, and from
+// the votes of the validators themselves. Its cost grows with the number of
+// validators, independently of the number of votes and delegations.
 func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes bool, burnDeposits bool, tallyResults types.TallyResult) {
 	results := make(map[types.VoteOption]sdk.Dec)
 	results[types.OptionYes] = sdk.ZeroDec()
@@ -17,67 +17,44 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 	results[types.OptionNo] = sdk.ZeroDec()
 	results[types.OptionNoWithVeto] = sdk.ZeroDec()
 
+	voteOptions := []types.VoteOption{types.OptionYes, types.OptionAbstain, types.OptionNo, types.OptionNoWithVeto}
 	totalVotingPower := sdk.ZeroDec()
-	currValidators := make(map[string]types.ValidatorGovInfo)
 
-	// fetch all the bonded validators, insert them into currValidators
 	keeper.sk.IterateBondedValidatorsByPower(ctx, func(index int64, validator exported.ValidatorI) (stop bool) {
-		currValidators[validator.GetOperator().String()] = types.NewValidatorGovInfo(
-			validator.GetOperator(),
-			validator.GetBondedTokens(),
-			validator.GetDelegatorShares(),
-			sdk.ZeroDec(),
-			types.OptionEmpty,
-		)
-
-		return false
-	})
-
-	keeper.IterateVotes(ctx, proposal.ProposalID, func(vote types.Vote) bool {
-		// if validator, just record it in the map
-		valAddrStr := sdk.ValAddress(vote.Voter).String()
-		if val, ok := currValidators[valAddrStr]; ok {
-			val.Vote = vote.Option
-			currValidators[valAddrStr] = val
-		}
-
-		// iterate over all delegations from voter, deduct from any delegated-to validators
-		keeper.sk.IterateDelegations(ctx, vote.Voter, func(index int64, delegation exported.DelegationI) (stop bool) {
-			valAddrStr := delegation.GetValidatorAddr().String()
-
-			if val, ok := currValidators[valAddrStr]; ok {
-				// There is no need to handle the special case that validator address equal to voter address.
-				// Because voter's voting power will tally again even if there will deduct voter's voting power from validator.
-				val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
-				currValidators[valAddrStr] = val
-
-				delegatorShare := delegation.GetShares().Quo(val.DelegatorShares)
-				votingPower := delegatorShare.MulInt(val.BondedTokens)
-
-				results[vote.Option] = results[vote.Option].Add(votingPower)
-				totalVotingPower = totalVotingPower.Add(votingPower)
+		valAddr := validator.GetOperator()
+		bondedTokens := validator.GetBondedTokens()
+		delegatorShares := validator.GetDelegatorShares()
+		deductions := sdk.ZeroDec()
+
+		// the shares delegated by voters count towards the options of their votes
+		if valTally, found := keeper.GetValidatorTally(ctx, proposal.ProposalID, valAddr); found {
+			deductions = valTally.VoterShares
+
+			for _, option := range voteOptions {
+				subPower := valTally.OptionShares(option).Quo(delegatorShares).MulInt(bondedTokens)
+				results[option] = results[option].Add(subPower)
 			}
+			totalVotingPower = totalVotingPower.Add(valTally.VoterShares.Quo(delegatorShares).MulInt(bondedTokens))
+		}
 
+		// the validator votes with the shares of the delegators who did not vote themselves
+		vote, found := keeper.GetVote(ctx, proposal.ProposalID, sdk.AccAddress(valAddr))
+		if !found {
 			return false
-		})
-
-		keeper.deleteVote(ctx, vote.ProposalID, vote.Voter)
-		return false
-	})
-
-	// iterate over the validators again to tally their voting power
-	for _, val := range currValidators {
-		if val.Vote == types.OptionEmpty {
-			continue
 		}
 
-		sharesAfterDeductions := val.DelegatorShares.Sub(val.DelegatorDeductions)
-		fractionAfterDeductions := sharesAfterDeductions.Quo(val.DelegatorShares)
-		votingPower := fractionAfterDeductions.MulInt(val.BondedTokens)
+		sharesAfterDeductions := delegatorShares.Sub(deductions)
+		fractionAfterDeductions := sharesAfterDeductions.Quo(delegatorShares)
+		votingPower := fractionAfterDeductions.MulInt(bondedTokens)
 
-		results[val.Vote] = results[val.Vote].Add(votingPower)
+		for _, option := range vote.GetOptions() {
+			subPower := votingPower.Mul(option.Weight)
+			results[option.Option] = results[option.Option].Add(subPower)
+		}
 		totalVotingPower = totalVotingPower.Add(votingPower)
-	}
+
+		return false
+	})
 
 	tallyParams := keeper.GetTallyParams(ctx)
 	tallyResults = types.NewTallyResultFromMap(results)
@@ -104,11 +81,138 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 		return false, true, tallyResults
 	}
 
-	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
-	if results[types.OptionYes].Quo(totalVotingPower.Sub(results[types.OptionAbstain])).GT(tallyParams.Threshold) {
+	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes. Expedited
+	// proposals require the higher expedited threshold.
+	threshold := tallyParams.GetThreshold(proposal.IsExpedited)
+	if results[types.OptionYes].Quo(totalVotingPower.Sub(results[types.OptionAbstain])).GT(threshold) {
 		return true, false, tallyResults
 	}
 
 	// If more than 1/2 of non-abstaining voters vote No, proposal fails
 	return false, false, tallyResults
 }
+
+// GetValidatorTally returns the running tally of the shares of a validator held
+// by the voters of a proposal
+func (keeper Keeper) GetValidatorTally(ctx sdk.Context, proposalID uint64, valAddr sdk.ValAddress) (valTally types.ValidatorTally, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.ValidatorTallyKey(proposalID, valAddr))
+	if bz == nil {
+		return valTally, false
+	}
+
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &valTally)
+	return valTally, true
+}
+
+// SetValidatorTally sets the running tally of a validator on a proposal. The
+// tally is deleted once it no longer counts any voter shares.
+func (keeper Keeper) SetValidatorTally(ctx sdk.Context, proposalID uint64, valAddr sdk.ValAddress, valTally types.ValidatorTally) {
+	store := ctx.KVStore(keeper.storeKey)
+	if valTally.IsZero() {
+		store.Delete(types.ValidatorTallyKey(proposalID, valAddr))
+		return
+	}
+
+	bz := keeper.cdc.MustMarshalBinaryLengthPrefixed(valTally)
+	store.Set(types.ValidatorTallyKey(proposalID, valAddr), bz)
+}
+
+// IterateValidatorTallies iterates over the running tallies of the validators
+// on a proposal and performs a callback function
+func (keeper Keeper) IterateValidatorTallies(
+	ctx sdk.Context, proposalID uint64, cb func(valAddr sdk.ValAddress, valTally types.ValidatorTally) (stop bool),
+) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorTalliesKey(proposalID))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		_, valAddr := types.SplitKeyValidatorTally(iterator.Key())
+
+		var valTally types.ValidatorTally
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &valTally)
+
+		if cb(valAddr, valTally) {
+			break
+		}
+	}
+}
+
+// DeleteValidatorTallies deletes all the running tallies of a proposal
+func (keeper Keeper) DeleteValidatorTallies(ctx sdk.Context, proposalID uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	keeper.IterateValidatorTallies(ctx, proposalID, func(valAddr sdk.ValAddress, _ types.ValidatorTally) bool {
+		store.Delete(types.ValidatorTallyKey(proposalID, valAddr))
+		return false
+	})
+}
+
+// RebuildValidatorTallies recomputes the running tallies of the proposals in
+// voting period from their votes and the delegations of the voters. It must
+// be called when the tallies are not kept up to date by the staking hooks, e.g.
+// when upgrading a chain which has proposals in voting period.
+func (keeper Keeper) RebuildValidatorTallies(ctx sdk.Context) {
+	keeper.iterateActiveProposalIDs(ctx, func(proposalID uint64) bool {
+		keeper.DeleteValidatorTallies(ctx, proposalID)
+		keeper.IterateVotes(ctx, proposalID, func(vote types.Vote) bool {
+			keeper.addVoteToTallies(ctx, vote)
+			return false
+		})
+		return false
+	})
+}
+
+// addVoteToTallies adds the shares of all the delegations of a voter to the
+// running tallies of the validators they are delegated to
+func (keeper Keeper) addVoteToTallies(ctx sdk.Context, vote types.Vote) {
+	keeper.sk.IterateDelegations(ctx, vote.Voter, func(_ int64, delegation exported.DelegationI) bool {
+		keeper.addDelegationToTally(ctx, vote, delegation)
+		return false
+	})
+}
+
+// removeVoteFromTallies removes the shares of all the delegations of a voter
+// from the running tallies of the validators they are delegated to
+func (keeper Keeper) removeVoteFromTallies(ctx sdk.Context, vote types.Vote) {
+	keeper.sk.IterateDelegations(ctx, vote.Voter, func(_ int64, delegation exported.DelegationI) bool {
+		keeper.removeDelegationFromTally(ctx, vote, delegation)
+		return false
+	})
+}
+
+func (keeper Keeper) addDelegationToTally(ctx sdk.Context, vote types.Vote, delegation exported.DelegationI) {
+	valAddr := delegation.GetValidatorAddr()
+	valTally, found := keeper.GetValidatorTally(ctx, vote.ProposalID, valAddr)
+	if !found {
+		valTally = types.NewValidatorTally()
+	}
+
+	valTally = valTally.AddVote(delegation.GetShares(), vote.GetOptions())
+	keeper.SetValidatorTally(ctx, vote.ProposalID, valAddr, valTally)
+}
+
+func (keeper Keeper) removeDelegationFromTally(ctx sdk.Context, vote types.Vote, delegation exported.DelegationI) {
+	valAddr := delegation.GetValidatorAddr()
+	valTally, found := keeper.GetValidatorTally(ctx, vote.ProposalID, valAddr)
+	if !found {
+		valTally = types.NewValidatorTally()
+	}
+
+	valTally = valTally.SubVote(delegation.GetShares(), vote.GetOptions())
+	keeper.SetValidatorTally(ctx, vote.ProposalID, valAddr, valTally)
+}
+
+// iterateActiveVotes iterates over the votes of a voter on the proposals in
+// voting period and performs a callback function
+func (keeper Keeper) iterateActiveVotes(ctx sdk.Context, voterAddr sdk.AccAddress, cb func(vote types.Vote) (stop bool)) {
+	keeper.iterateActiveProposalIDs(ctx, func(proposalID uint64) bool {
+		vote, found := keeper.GetVote(ctx, proposalID, voterAddr)
+		if !found {
+			return false
+		}
+
+		return cb(vote)
+	})
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"math/rand"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -8,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/exported"
 )
 
 func TestTallyNoOneVotes(t *testing.T) {
@@ -434,3 +436,384 @@ func TestTallyValidatorMultipleDelegations(t *testing.T) {
 
 	require.True(t, tallyResults.Equals(expectedTallyResult))
 }
+
+func TestTallyDelgatorInheritWeighted(t *testing.T) {
+	ctx, _, _, keeper, sk, _ := createTestInput(t, false, 100)
+	createValidators(ctx, sk, []int64{5, 6, 7})
+
+	delTokens := sdk.TokensFromConsensusPower(30)
+	val3, found := sk.GetValidator(ctx, valOpAddr3)
+	require.True(t, found)
+
+	_, err := sk.Delegate(ctx, TestAddrs[0], delTokens, sdk.Unbonded, val3, true)
+	require.NoError(t, err)
+
+	_ = staking.EndBlocker(ctx, sk)
+
+	tp := TestProposal
+	proposal, err := keeper.SubmitProposal(ctx, tp)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
+	proposal.Status = types.StatusVotingPeriod
+	keeper.SetProposal(ctx, proposal)
+
+	// the delegator inherits the split vote of the validator
+	options := types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(5, 1)),
+		types.NewWeightedVoteOption(types.OptionNo, sdk.NewDecWithPrec(5, 1)),
+	}
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr1, types.OptionNo))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr2, types.OptionNo))
+	require.NoError(t, keeper.AddWeightedVote(ctx, proposalID, valAccAddr3, options))
+
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	passes, burnDeposits, tallyResults := keeper.Tally(ctx, proposal)
+
+	require.False(t, passes)
+	require.False(t, burnDeposits)
+
+	expectedYes := sdk.TokensFromConsensusPower(37).QuoRaw(2)
+	expectedAbstain := sdk.TokensFromConsensusPower(0)
+	expectedNo := sdk.TokensFromConsensusPower(11).Add(sdk.TokensFromConsensusPower(37).QuoRaw(2))
+	expectedNoWithVeto := sdk.TokensFromConsensusPower(0)
+	expectedTallyResult := types.NewTallyResult(expectedYes, expectedAbstain, expectedNo, expectedNoWithVeto)
+
+	require.True(t, tallyResults.Equals(expectedTallyResult))
+}
+
+func TestTallyDelgatorOverrideWeighted(t *testing.T) {
+	ctx, _, _, keeper, sk, _ := createTestInput(t, false, 100)
+	createValidators(ctx, sk, []int64{5, 6, 10})
+
+	delTokens := sdk.TokensFromConsensusPower(30)
+	val3, found := sk.GetValidator(ctx, valOpAddr3)
+	require.True(t, found)
+
+	_, err := sk.Delegate(ctx, TestAddrs[0], delTokens, sdk.Unbonded, val3, true)
+	require.NoError(t, err)
+
+	_ = staking.EndBlocker(ctx, sk)
+
+	tp := TestProposal
+	proposal, err := keeper.SubmitProposal(ctx, tp)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
+	proposal.Status = types.StatusVotingPeriod
+	keeper.SetProposal(ctx, proposal)
+
+	valOptions := types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(5, 1)),
+		types.NewWeightedVoteOption(types.OptionNo, sdk.NewDecWithPrec(5, 1)),
+	}
+	delOptions := types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(8, 1)),
+		types.NewWeightedVoteOption(types.OptionAbstain, sdk.NewDecWithPrec(2, 1)),
+	}
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr1, types.OptionNo))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr2, types.OptionNo))
+	require.NoError(t, keeper.AddWeightedVote(ctx, proposalID, valAccAddr3, valOptions))
+	require.NoError(t, keeper.AddWeightedVote(ctx, proposalID, TestAddrs[0], delOptions))
+
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	passes, burnDeposits, tallyResults := keeper.Tally(ctx, proposal)
+
+	require.True(t, passes)
+	require.False(t, burnDeposits)
+
+	expectedYes := sdk.TokensFromConsensusPower(29)
+	expectedAbstain := sdk.TokensFromConsensusPower(6)
+	expectedNo := sdk.TokensFromConsensusPower(16)
+	expectedNoWithVeto := sdk.TokensFromConsensusPower(0)
+	expectedTallyResult := types.NewTallyResult(expectedYes, expectedAbstain, expectedNo, expectedNoWithVeto)
+
+	require.True(t, tallyResults.Equals(expectedTallyResult))
+}
+
+// iterationTally computes the tally of a proposal by iterating over its votes
+// and the delegations of each voter. It is the algorithm used before the
+// running tallies were introduced and serves as a reference for Tally.
+func iterationTally(ctx sdk.Context, keeper Keeper, proposal types.Proposal) (passes bool, burnDeposits bool, tallyResults types.TallyResult) {
+	results := make(map[types.VoteOption]sdk.Dec)
+	results[types.OptionYes] = sdk.ZeroDec()
+	results[types.OptionAbstain] = sdk.ZeroDec()
+	results[types.OptionNo] = sdk.ZeroDec()
+	results[types.OptionNoWithVeto] = sdk.ZeroDec()
+
+	totalVotingPower := sdk.ZeroDec()
+	currValidators := make(map[string]types.ValidatorGovInfo)
+
+	keeper.sk.IterateBondedValidatorsByPower(ctx, func(index int64, validator exported.ValidatorI) (stop bool) {
+		currValidators[validator.GetOperator().String()] = types.NewValidatorGovInfo(
+			validator.GetOperator(),
+			validator.GetBondedTokens(),
+			validator.GetDelegatorShares(),
+			sdk.ZeroDec(),
+			nil,
+		)
+
+		return false
+	})
+
+	keeper.IterateVotes(ctx, proposal.ProposalID, func(vote types.Vote) bool {
+		options := vote.GetOptions()
+
+		valAddrStr := sdk.ValAddress(vote.Voter).String()
+		if val, ok := currValidators[valAddrStr]; ok {
+			val.Vote = options
+			currValidators[valAddrStr] = val
+		}
+
+		keeper.sk.IterateDelegations(ctx, vote.Voter, func(index int64, delegation exported.DelegationI) (stop bool) {
+			valAddrStr := delegation.GetValidatorAddr().String()
+
+			if val, ok := currValidators[valAddrStr]; ok {
+				val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
+				currValidators[valAddrStr] = val
+
+				delegatorShare := delegation.GetShares().Quo(val.DelegatorShares)
+				votingPower := delegatorShare.MulInt(val.BondedTokens)
+
+				for _, option := range options {
+					subPower := votingPower.Mul(option.Weight)
+					results[option.Option] = results[option.Option].Add(subPower)
+				}
+				totalVotingPower = totalVotingPower.Add(votingPower)
+			}
+
+			return false
+		})
+
+		return false
+	})
+
+	for _, val := range currValidators {
+		if len(val.Vote) == 0 {
+			continue
+		}
+
+		sharesAfterDeductions := val.DelegatorShares.Sub(val.DelegatorDeductions)
+		fractionAfterDeductions := sharesAfterDeductions.Quo(val.DelegatorShares)
+		votingPower := fractionAfterDeductions.MulInt(val.BondedTokens)
+
+		for _, option := range val.Vote {
+			subPower := votingPower.Mul(option.Weight)
+			results[option.Option] = results[option.Option].Add(subPower)
+		}
+		totalVotingPower = totalVotingPower.Add(votingPower)
+	}
+
+	tallyParams := keeper.GetTallyParams(ctx)
+	tallyResults = types.NewTallyResultFromMap(results)
+
+	if keeper.sk.TotalBondedTokens(ctx).IsZero() {
+		return false, false, tallyResults
+	}
+
+	percentVoting := totalVotingPower.Quo(keeper.sk.TotalBondedTokens(ctx).ToDec())
+	if percentVoting.LT(tallyParams.Quorum) {
+		return false, true, tallyResults
+	}
+
+	if totalVotingPower.Sub(results[types.OptionAbstain]).Equal(sdk.ZeroDec()) {
+		return false, false, tallyResults
+	}
+
+	if results[types.OptionNoWithVeto].Quo(totalVotingPower).GT(tallyParams.Veto) {
+		return false, true, tallyResults
+	}
+
+	threshold := tallyParams.GetThreshold(proposal.IsExpedited)
+	if results[types.OptionYes].Quo(totalVotingPower.Sub(results[types.OptionAbstain])).GT(threshold) {
+		return true, false, tallyResults
+	}
+
+	return false, false, tallyResults
+}
+
+// requireTallyEquivalent checks that Tally matches the reference iteration
+// tally. The results may differ by one token per option because the running
+// tallies convert the summed shares of the voters to tokens at once instead of
+// the shares of each delegation.
+func requireTallyEquivalent(t *testing.T, ctx sdk.Context, keeper Keeper, proposalID uint64) {
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+
+	passes, burnDeposits, tallyResults := keeper.Tally(ctx, proposal)
+	expPasses, expBurnDeposits, expTallyResults := iterationTally(ctx, keeper, proposal)
+	require.Equal(t, expPasses, passes)
+	require.Equal(t, expBurnDeposits, burnDeposits)
+
+	withinOne := func(a, b sdk.Int) bool {
+		diff := a.Sub(b)
+		return diff.GTE(sdk.NewInt(-1)) && diff.LTE(sdk.OneInt())
+	}
+	require.True(t, withinOne(expTallyResults.Yes, tallyResults.Yes), "%s\n%s", expTallyResults, tallyResults)
+	require.True(t, withinOne(expTallyResults.Abstain, tallyResults.Abstain), "%s\n%s", expTallyResults, tallyResults)
+	require.True(t, withinOne(expTallyResults.No, tallyResults.No), "%s\n%s", expTallyResults, tallyResults)
+	require.True(t, withinOne(expTallyResults.NoWithVeto, tallyResults.NoWithVeto), "%s\n%s", expTallyResults, tallyResults)
+
+	msg, broken := ValidatorTalliesInvariant(keeper)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestTallyDelegationsModifiedAfterVote(t *testing.T) {
+	ctx, _, _, keeper, sk, _ := createTestInput(t, false, 100)
+	createValidators(ctx, sk, []int64{5, 6, 7})
+
+	tp := TestProposal
+	proposal, err := keeper.SubmitProposal(ctx, tp)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
+	proposal.Status = types.StatusVotingPeriod
+	keeper.SetProposal(ctx, proposal)
+	keeper.InsertActiveProposalQueue(ctx, proposalID, proposal.VotingEndTime)
+
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr1, types.OptionNo))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr2, types.OptionNo))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, valAccAddr3, types.OptionNo))
+	require.NoError(t, keeper.AddVote(ctx, proposalID, TestAddrs[0], types.OptionYes))
+
+	// the delegator votes before delegating
+	delTokens := sdk.TokensFromConsensusPower(30)
+	val3, found := sk.GetValidator(ctx, valOpAddr3)
+	require.True(t, found)
+	_, err = sk.Delegate(ctx, TestAddrs[0], delTokens, sdk.Unbonded, val3, true)
+	require.NoError(t, err)
+	_ = staking.EndBlocker(ctx, sk)
+
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	passes, _, tallyResults := keeper.Tally(ctx, proposal)
+	require.True(t, passes)
+	require.Equal(t, sdk.TokensFromConsensusPower(30), tallyResults.Yes)
+	requireTallyEquivalent(t, ctx, keeper, proposalID)
+
+	// a partial unbonding is removed from the vote of the delegator
+	_, err = sk.Undelegate(ctx, TestAddrs[0], valOpAddr3, sdk.TokensFromConsensusPower(20).ToDec())
+	require.NoError(t, err)
+	_ = staking.EndBlocker(ctx, sk)
+
+	passes, _, tallyResults = keeper.Tally(ctx, proposal)
+	require.False(t, passes)
+	require.Equal(t, sdk.TokensFromConsensusPower(10), tallyResults.Yes)
+	requireTallyEquivalent(t, ctx, keeper, proposalID)
+
+	// a redelegation moves the shares of the delegator to the tally of the destination
+	_, err = sk.BeginRedelegation(ctx, TestAddrs[0], valOpAddr3, valOpAddr1, sdk.TokensFromConsensusPower(5).ToDec())
+	require.NoError(t, err)
+	_ = staking.EndBlocker(ctx, sk)
+	requireTallyEquivalent(t, ctx, keeper, proposalID)
+
+	valTally, found := keeper.GetValidatorTally(ctx, proposalID, valOpAddr1)
+	require.True(t, found)
+	require.Equal(t, sdk.TokensFromConsensusPower(5).ToDec(), valTally.Yes)
+
+	// a new vote replaces the shares counted under the previous one
+	require.NoError(t, keeper.AddVote(ctx, proposalID, TestAddrs[0], types.OptionNoWithVeto))
+	requireTallyEquivalent(t, ctx, keeper, proposalID)
+
+	valTally, found = keeper.GetValidatorTally(ctx, proposalID, valOpAddr3)
+	require.True(t, found)
+	require.True(t, valTally.Yes.IsZero())
+	require.Equal(t, sdk.TokensFromConsensusPower(5).ToDec(), valTally.NoWithVeto)
+
+	// removing the whole delegation leaves the self-delegation of the validator
+	_, err = sk.Undelegate(ctx, TestAddrs[0], valOpAddr3, sdk.TokensFromConsensusPower(5).ToDec())
+	require.NoError(t, err)
+	valTally, found = keeper.GetValidatorTally(ctx, proposalID, valOpAddr3)
+	require.True(t, found)
+	require.True(t, valTally.NoWithVeto.IsZero())
+	require.Equal(t, sdk.TokensFromConsensusPower(7).ToDec(), valTally.VoterShares)
+	requireTallyEquivalent(t, ctx, keeper, proposalID)
+
+	// the running tallies are rebuilt from the votes and deleted with them
+	keeper.DeleteValidatorTallies(ctx, proposalID)
+	keeper.RebuildValidatorTallies(ctx)
+	requireTallyEquivalent(t, ctx, keeper, proposalID)
+
+	keeper.DeleteVotes(ctx, proposalID)
+	keeper.IterateValidatorTallies(ctx, proposalID, func(_ sdk.ValAddress, _ types.ValidatorTally) bool {
+		t.Fatal("running tallies were not deleted with the votes")
+		return true
+	})
+}
+
+func TestTallyEquivalence(t *testing.T) {
+	ctx, _, _, keeper, sk, _ := createTestInput(t, false, 100)
+	createValidators(ctx, sk, []int64{5, 6, 7})
+
+	r := rand.New(rand.NewSource(42))
+	valAddrs := []sdk.ValAddress{valOpAddr1, valOpAddr2, valOpAddr3}
+	options := []types.VoteOption{types.OptionYes, types.OptionAbstain, types.OptionNo, types.OptionNoWithVeto}
+
+	proposal, err := keeper.SubmitProposal(ctx, TestProposal)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
+	proposal.Status = types.StatusVotingPeriod
+	keeper.SetProposal(ctx, proposal)
+	keeper.InsertActiveProposalQueue(ctx, proposalID, proposal.VotingEndTime)
+
+	for i := 0; i < 300; i++ {
+		addr := TestAddrs[r.Intn(len(TestAddrs))]
+		valAddr := valAddrs[r.Intn(len(valAddrs))]
+
+		// operations which fail, e.g. because the delegation does not exist,
+		// are discarded
+		cacheCtx, write := ctx.CacheContext()
+		var err error
+
+		switch r.Intn(6) {
+		case 0:
+			validator, found := sk.GetValidator(cacheCtx, valAddr)
+			require.True(t, found)
+			_, err = sk.Delegate(cacheCtx, addr, sdk.NewInt(r.Int63n(5000000)+1), sdk.Unbonded, validator, true)
+
+		case 1:
+			delegation, found := sk.GetDelegation(cacheCtx, addr, valAddr)
+			if !found {
+				continue
+			}
+			shares := delegation.Shares.MulTruncate(sdk.NewDecWithPrec(r.Int63n(100)+1, 2))
+			_, err = sk.Undelegate(cacheCtx, addr, valAddr, shares)
+
+		case 2:
+			delegation, found := sk.GetDelegation(cacheCtx, addr, valAddr)
+			if !found {
+				continue
+			}
+			shares := delegation.Shares.MulTruncate(sdk.NewDecWithPrec(r.Int63n(100)+1, 2))
+			_, err = sk.BeginRedelegation(cacheCtx, addr, valAddr, valAddrs[r.Intn(len(valAddrs))], shares)
+
+		case 3:
+			err = keeper.AddVote(cacheCtx, proposalID, addr, options[r.Intn(len(options))])
+
+		case 4:
+			weight := sdk.NewDecWithPrec(r.Int63n(99)+1, 2)
+			first := r.Intn(len(options))
+			err = keeper.AddWeightedVote(cacheCtx, proposalID, addr, types.WeightedVoteOptions{
+				types.NewWeightedVoteOption(options[first], weight),
+				types.NewWeightedVoteOption(options[(first+1)%len(options)], sdk.OneDec().Sub(weight)),
+			})
+
+		case 5:
+			validator, found := sk.GetValidator(cacheCtx, valAddr)
+			require.True(t, found)
+			if !validator.IsBonded() {
+				continue
+			}
+			consAddr := sdk.ConsAddress(validator.GetConsPubKey().Address())
+			power := validator.GetConsensusPower()
+			sk.Slash(cacheCtx, consAddr, cacheCtx.BlockHeight(), power, sdk.NewDecWithPrec(r.Int63n(10)+1, 2))
+		}
+
+		if err != nil {
+			continue
+		}
+
+		write()
+		_ = staking.EndBlocker(ctx, sk)
+		requireTallyEquivalent(t, ctx, keeper, proposalID)
+	}
+}
@@ -18,6 +18,7 @@ import (
 	tmtypes "github.com/tendermint/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -82,6 +83,7 @@ func newPubKey(pk string) (res crypto.PubKey) {
 func makeTestCodec() *codec.Codec {
 	var cdc = codec.New()
 	auth.RegisterCodec(cdc)
+	bank.RegisterCodec(cdc)
 	types.RegisterCodec(cdc)
 	supply.RegisterCodec(cdc)
 	staking.RegisterCodec(cdc)
@@ -151,15 +153,19 @@ func createTestInput(
 	bankKeeper := bank.NewBaseKeeper(cdc, keyBank, accountKeeper, pk.Subspace(bank.DefaultParamspace), blacklistedAddrs)
 	supplyKeeper := supply.NewKeeper(cdc, keySupply, accountKeeper, bankKeeper, maccPerms)
 
-	sk := staking.NewKeeper(staking.ModuleCdc, keyStaking, bankKeeper, supplyKeeper, pk.Subspace(staking.DefaultParamspace))
+	sk := staking.NewKeeper(staking.ModuleCdc, keyStaking, accountKeeper, bankKeeper, supplyKeeper, pk.Subspace(staking.DefaultParamspace))
 	sk.SetParams(ctx, staking.DefaultParams())
+	bankKeeper.SetSendEnabled(ctx, true)
 
 	rtr := types.NewRouter().
 		AddRoute(types.RouterKey, types.ProposalHandler)
+	msgRtr := baseapp.NewRouter().
+		AddRoute(bank.RouterKey, bank.NewHandler(bankKeeper, supplyKeeper))
 
 	keeper := NewKeeper(
-		cdc, keyGov, pk.Subspace(types.DefaultParamspace).WithKeyTable(types.ParamKeyTable()), supplyKeeper, sk, rtr,
+		cdc, keyGov, pk.Subspace(types.DefaultParamspace).WithKeyTable(types.ParamKeyTable()), supplyKeeper, sk, rtr, msgRtr,
 	)
+	sk.SetHooks(keeper.Hooks())
 
 	keeper.SetProposalID(ctx, types.DefaultStartingProposalID)
 	keeper.SetDepositParams(ctx, types.DefaultDepositParams())
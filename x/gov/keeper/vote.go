@@ -10,6 +10,16 @@ import (
 
 // AddVote adds a vote on a specific proposal
 func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, option types.VoteOption) error {
+	if !types.ValidVoteOption(option) {
+		return sdkerrors.Wrap(types.ErrInvalidVote, option.String())
+	}
+
+	return keeper.AddWeightedVote(ctx, proposalID, voterAddr, types.NewNonSplitVoteOption(option))
+}
+
+// AddWeightedVote adds a vote on a specific proposal splitting the voting power
+// of the voter between the given options
+func (keeper Keeper) AddWeightedVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, options types.WeightedVoteOptions) error {
 	proposal, ok := keeper.GetProposal(ctx, proposalID)
 	if !ok {
 		return sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
@@ -18,17 +28,29 @@ func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.A
 		return sdkerrors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
 	}
 
-	if !types.ValidVoteOption(option) {
-		return sdkerrors.Wrap(types.ErrInvalidVote, option.String())
+	if !types.ValidWeightedVoteOptions(options) {
+		return sdkerrors.Wrap(types.ErrInvalidVote, options.String())
+	}
+
+	// replace the delegations of the voter counted under a previous vote
+	if oldVote, found := keeper.GetVote(ctx, proposalID, voterAddr); found {
+		keeper.removeVoteFromTallies(ctx, oldVote)
 	}
 
-	vote := types.NewVote(proposalID, voterAddr, option)
+	vote := types.NewWeightedVote(proposalID, voterAddr, options)
 	keeper.SetVote(ctx, vote)
+	keeper.addVoteToTallies(ctx, vote)
+
+	// votes which are not split keep emitting the name of their option
+	optionValue := options.String()
+	if vote.Option != types.OptionEmpty {
+		optionValue = vote.Option.String()
+	}
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeProposalVote,
-			sdk.NewAttribute(types.AttributeKeyOption, option.String()),
+			sdk.NewAttribute(types.AttributeKeyOption, optionValue),
 			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
 		),
 	)
@@ -105,8 +127,66 @@ func (keeper Keeper) IterateVotes(ctx sdk.Context, proposalID uint64, cb func(vo
 	}
 }
 
-// deleteVote deletes a vote from a given proposalID and voter from the store
-func (keeper Keeper) deleteVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) {
+// DeleteVotes deletes the running tallies of a specific proposal and queues
+// its votes to be deleted by PruneVotes, so that the cost of ending a proposal
+// does not grow with its number of votes
+func (keeper Keeper) DeleteVotes(ctx sdk.Context, proposalID uint64) {
+	keeper.DeleteValidatorTallies(ctx, proposalID)
+
+	// only the proposals with votes left to delete are queued
+	if keeper.hasVotes(ctx, proposalID) {
+		keeper.InsertVotePruningQueue(ctx, proposalID)
+	}
+}
+
+// PruneVotes deletes at most limit votes of the proposals in the vote pruning
+// queue, by ascending proposal ID, and removes the proposals whose votes are
+// all deleted from the queue. It returns the number of deleted votes.
+func (keeper Keeper) PruneVotes(ctx sdk.Context, limit int) int {
+	store := ctx.KVStore(keeper.storeKey)
+
+	// the keys are collected first as the store must not be written to while
+	// iterating over it
+	voteKeys, queueKeys := keeper.votesToPrune(ctx, limit)
+	for _, key := range voteKeys {
+		store.Delete(key)
+	}
+	for _, key := range queueKeys {
+		store.Delete(key)
+	}
+
+	return len(voteKeys)
+}
+
+// votesToPrune returns the keys of at most limit votes of the queued proposals
+// and the queue keys of the proposals left without votes once they are deleted
+func (keeper Keeper) votesToPrune(ctx sdk.Context, limit int) (voteKeys, queueKeys [][]byte) {
+	store := ctx.KVStore(keeper.storeKey)
+	queueIterator := sdk.KVStorePrefixIterator(store, types.VotePruningQueuePrefix)
+
+	defer queueIterator.Close()
+	for ; queueIterator.Valid() && len(voteKeys) < limit; queueIterator.Next() {
+		proposalID := types.SplitVotePruningQueueKey(queueIterator.Key())
+
+		votesIterator := sdk.KVStorePrefixIterator(store, types.VotesKey(proposalID))
+		for ; votesIterator.Valid() && len(voteKeys) < limit; votesIterator.Next() {
+			voteKeys = append(voteKeys, votesIterator.Key())
+		}
+
+		if !votesIterator.Valid() {
+			queueKeys = append(queueKeys, queueIterator.Key())
+		}
+		votesIterator.Close()
+	}
+
+	return voteKeys, queueKeys
+}
+
+// hasVotes returns true if any vote is stored for the given proposal
+func (keeper Keeper) hasVotes(ctx sdk.Context, proposalID uint64) bool {
 	store := ctx.KVStore(keeper.storeKey)
-	store.Delete(types.VoteKey(proposalID, voterAddr))
+	iterator := sdk.KVStorePrefixIterator(store, types.VotesKey(proposalID))
+
+	defer iterator.Close()
+	return iterator.Valid()
 }
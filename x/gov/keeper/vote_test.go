@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -62,3 +63,89 @@ func TestVotes(t *testing.T) {
 	require.Equal(t, proposalID, votes[1].ProposalID)
 	require.Equal(t, types.OptionNoWithVeto, votes[1].Option)
 }
+
+func TestWeightedVotes(t *testing.T) {
+	ctx, _, _, keeper, _, _ := createTestInput(t, false, 100) // nolint: dogsled
+
+	tp := TestProposal
+	proposal, err := keeper.SubmitProposal(ctx, tp)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
+	proposal.Status = types.StatusVotingPeriod
+	keeper.SetProposal(ctx, proposal)
+
+	options := types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(7, 1)),
+		types.NewWeightedVoteOption(types.OptionNo, sdk.NewDecWithPrec(3, 1)),
+	}
+	invalidOptions := types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(7, 1)),
+		types.NewWeightedVoteOption(types.OptionNo, sdk.NewDecWithPrec(2, 1)),
+	}
+
+	require.Error(t, keeper.AddWeightedVote(ctx, proposalID, TestAddrs[0], invalidOptions), "weights do not sum to one")
+
+	require.NoError(t, keeper.AddWeightedVote(ctx, proposalID, TestAddrs[0], options))
+	vote, found := keeper.GetVote(ctx, proposalID, TestAddrs[0])
+	require.True(t, found)
+	require.Equal(t, types.OptionEmpty, vote.Option)
+	require.True(t, options.Equal(vote.Options))
+
+	// a vote which is not split keeps its option
+	require.NoError(t, keeper.AddVote(ctx, proposalID, TestAddrs[1], types.OptionNo))
+	vote, found = keeper.GetVote(ctx, proposalID, TestAddrs[1])
+	require.True(t, found)
+	require.Equal(t, types.OptionNo, vote.Option)
+	require.True(t, types.NewNonSplitVoteOption(types.OptionNo).Equal(vote.Options))
+
+	// votes stored without weighted options carry all the voting power
+	keeper.SetVote(ctx, types.Vote{ProposalID: proposalID, Voter: TestAddrs[2], Option: types.OptionYes})
+	vote, found = keeper.GetVote(ctx, proposalID, TestAddrs[2])
+	require.True(t, found)
+	require.True(t, types.NewNonSplitVoteOption(types.OptionYes).Equal(vote.GetOptions()))
+}
+
+func TestPruneVotes(t *testing.T) {
+	ctx, _, _, keeper, _, _ := createTestInput(t, false, 100) // nolint: dogsled
+
+	var proposalIDs []uint64
+	for i := 0; i < 3; i++ {
+		proposal, err := keeper.SubmitProposal(ctx, TestProposal)
+		require.NoError(t, err)
+		proposal.Status = types.StatusVotingPeriod
+		keeper.SetProposal(ctx, proposal)
+		proposalIDs = append(proposalIDs, proposal.ProposalID)
+	}
+
+	for _, addr := range TestAddrs[:3] {
+		require.NoError(t, keeper.AddVote(ctx, proposalIDs[0], addr, types.OptionYes))
+	}
+	for _, addr := range TestAddrs[:2] {
+		require.NoError(t, keeper.AddVote(ctx, proposalIDs[1], addr, types.OptionNo))
+	}
+
+	// proposals without votes are not queued
+	store := ctx.KVStore(keeper.storeKey)
+	keeper.DeleteVotes(ctx, proposalIDs[2])
+	require.False(t, store.Has(types.VotePruningQueueKey(proposalIDs[2])))
+
+	keeper.DeleteVotes(ctx, proposalIDs[0])
+	keeper.DeleteVotes(ctx, proposalIDs[1])
+	require.Len(t, keeper.GetAllVotes(ctx), 5)
+
+	// the votes are deleted in batches, by ascending proposal ID
+	require.Equal(t, 2, keeper.PruneVotes(ctx, 2))
+	require.Len(t, keeper.GetVotes(ctx, proposalIDs[0]), 1)
+	require.True(t, store.Has(types.VotePruningQueueKey(proposalIDs[0])))
+
+	require.Equal(t, 2, keeper.PruneVotes(ctx, 2))
+	require.Empty(t, keeper.GetVotes(ctx, proposalIDs[0]))
+	require.Len(t, keeper.GetVotes(ctx, proposalIDs[1]), 1)
+	require.False(t, store.Has(types.VotePruningQueueKey(proposalIDs[0])))
+
+	require.Equal(t, 1, keeper.PruneVotes(ctx, 2))
+	require.Empty(t, keeper.GetAllVotes(ctx))
+	require.False(t, store.Has(types.VotePruningQueueKey(proposalIDs[1])))
+
+	require.Equal(t, 0, keeper.PruneVotes(ctx, 2))
+}
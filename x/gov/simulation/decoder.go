@@ -22,7 +22,8 @@ func DecodeStore(cdc *codec.Codec, kvA, kvB tmkv.Pair) string {
 
 	case bytes.Equal(kvA.Key[:1], types.ActiveProposalQueuePrefix),
 		bytes.Equal(kvA.Key[:1], types.InactiveProposalQueuePrefix),
-		bytes.Equal(kvA.Key[:1], types.ProposalIDKey):
+		bytes.Equal(kvA.Key[:1], types.ProposalIDKey),
+		bytes.Equal(kvA.Key[:1], types.VotePruningQueuePrefix):
 		proposalIDA := binary.LittleEndian.Uint64(kvA.Value)
 		proposalIDB := binary.LittleEndian.Uint64(kvB.Value)
 		return fmt.Sprintf("proposalIDA: %d\nProposalIDB: %d", proposalIDA, proposalIDB)
@@ -39,6 +40,12 @@ func DecodeStore(cdc *codec.Codec, kvA, kvB tmkv.Pair) string {
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &voteB)
 		return fmt.Sprintf("%v\n%v", voteA, voteB)
 
+	case bytes.Equal(kvA.Key[:1], types.ValidatorTalliesKeyPrefix):
+		var valTallyA, valTallyB types.ValidatorTally
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &valTallyA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &valTallyB)
+		return fmt.Sprintf("%v\n%v", valTallyA, valTallyB)
+
 	default:
 		panic(fmt.Sprintf("invalid governance key prefix %X", kvA.Key[:1]))
 	}
@@ -40,12 +40,14 @@ func TestDecodeStore(t *testing.T) {
 	binary.LittleEndian.PutUint64(proposalIDBz, 1)
 	deposit := types.NewDeposit(1, delAddr1, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())))
 	vote := types.NewVote(1, delAddr1, types.OptionYes)
+	valTally := types.NewValidatorTally().AddVote(sdk.OneDec(), types.NewNonSplitVoteOption(types.OptionYes))
 
 	kvPairs := tmkv.Pairs{
 		tmkv.Pair{Key: types.ProposalKey(1), Value: cdc.MustMarshalBinaryLengthPrefixed(proposal)},
 		tmkv.Pair{Key: types.InactiveProposalQueueKey(1, endTime), Value: proposalIDBz},
 		tmkv.Pair{Key: types.DepositKey(1, delAddr1), Value: cdc.MustMarshalBinaryLengthPrefixed(deposit)},
 		tmkv.Pair{Key: types.VoteKey(1, delAddr1), Value: cdc.MustMarshalBinaryLengthPrefixed(vote)},
+		tmkv.Pair{Key: types.ValidatorTallyKey(1, sdk.ValAddress(delAddr1)), Value: cdc.MustMarshalBinaryLengthPrefixed(valTally)},
 		tmkv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
 	}
 
@@ -57,6 +59,7 @@ func TestDecodeStore(t *testing.T) {
 		{"proposal IDs", "proposalIDA: 1\nProposalIDB: 1"},
 		{"deposits", fmt.Sprintf("%v\n%v", deposit, deposit)},
 		{"votes", fmt.Sprintf("%v\n%v", vote, vote)},
+		{"validator tallies", fmt.Sprintf("%v\n%v", valTally, valTally)},
 		{"other", ""},
 	}
 
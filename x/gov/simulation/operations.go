@@ -19,8 +19,11 @@ var initialProposalID = uint64(100000000000000)
 
 // Simulation operation weights constants
 const (
-	OpWeightMsgDeposit = "op_weight_msg_deposit"
-	OpWeightMsgVote    = "op_weight_msg_vote"
+	OpWeightMsgDeposit      = "op_weight_msg_deposit"
+	OpWeightMsgVote         = "op_weight_msg_vote"
+	OpWeightMsgVoteWeighted = "op_weight_msg_weighted_vote"
+
+	OpWeightMsgCancelProposal = "op_weight_msg_cancel_proposal"
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights
@@ -30,8 +33,11 @@ func WeightedOperations(
 ) simulation.WeightedOperations {
 
 	var (
-		weightMsgDeposit int
-		weightMsgVote    int
+		weightMsgDeposit      int
+		weightMsgVote         int
+		weightMsgVoteWeighted int
+
+		weightMsgCancelProposal int
 	)
 
 	appParams.GetOrGenerate(cdc, OpWeightMsgDeposit, &weightMsgDeposit, nil,
@@ -46,6 +52,18 @@ func WeightedOperations(
 		},
 	)
 
+	appParams.GetOrGenerate(cdc, OpWeightMsgVoteWeighted, &weightMsgVoteWeighted, nil,
+		func(_ *rand.Rand) {
+			weightMsgVoteWeighted = simappparams.DefaultWeightMsgVoteWeighted
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgCancelProposal, &weightMsgCancelProposal, nil,
+		func(_ *rand.Rand) {
+			weightMsgCancelProposal = simappparams.DefaultWeightMsgCancelProposal
+		},
+	)
+
 	// generate the weighted operations for the proposal contents
 	var wProposalOps simulation.WeightedOperations
 
@@ -73,6 +91,14 @@ func WeightedOperations(
 			weightMsgVote,
 			SimulateMsgVote(ak, bk, k),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgVoteWeighted,
+			SimulateMsgVoteWeighted(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgCancelProposal,
+			SimulateMsgCancelProposal(ak, bk, k),
+		),
 	}
 
 	return append(wProposalOps, wGovOps...)
@@ -125,6 +151,7 @@ func SimulateSubmitProposal(
 		}
 
 		msg := types.NewMsgSubmitProposal(content, deposit, simAccount.Address)
+		msg.IsExpedited = r.Intn(2) == 0
 
 		account := ak.GetAccount(ctx, simAccount.Address)
 		spendable := bk.SpendableCoins(ctx, account.GetAddress())
@@ -297,6 +324,96 @@ func operationSimulateMsgVote(ak types.AccountKeeper, bk types.BankKeeper, k kee
 	}
 }
 
+// SimulateMsgVoteWeighted generates a MsgVoteWeighted with random values.
+func SimulateMsgVoteWeighted(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		simAccount, _ := simulation.RandomAcc(r, accs)
+
+		proposalID, ok := randomProposalID(r, k, ctx, types.StatusVotingPeriod)
+		if !ok {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		options := randomWeightedVotingOptions(r)
+		msg := types.NewMsgVoteWeighted(simAccount.Address, proposalID, options)
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		fees, err := simulation.RandomFees(r, ctx, spendable)
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgCancelProposal generates a MsgCancelProposal canceling a random
+// proposal in the deposit period on behalf of its proposer.
+func SimulateMsgCancelProposal(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		proposalID, ok := randomProposalID(r, k, ctx, types.StatusDepositPeriod)
+		if !ok {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		proposal, _ := k.GetProposal(ctx, proposalID)
+		simAccount, found := simulation.FindAccount(accs, proposal.Proposer)
+		if !found {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		msg := types.NewMsgCancelProposal(proposalID, simAccount.Address)
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		fees, err := simulation.RandomFees(r, ctx, spendable)
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
 // Pick a random deposit with a random denomination with a
 // deposit amount between (0, min(balance, minDepositAmount))
 // This is to simulate multiple users depositing to get the
@@ -375,3 +492,27 @@ func randomVotingOption(r *rand.Rand) types.VoteOption {
 		panic("invalid vote option")
 	}
 }
+
+// Pick random weighted voting options, splitting the voting power between a
+// random subset of the options
+func randomWeightedVotingOptions(r *rand.Rand) types.WeightedVoteOptions {
+	options := []types.VoteOption{types.OptionYes, types.OptionAbstain, types.OptionNo, types.OptionNoWithVeto}
+	r.Shuffle(len(options), func(i, j int) { options[i], options[j] = options[j], options[i] })
+	options = options[:1+r.Intn(len(options))]
+
+	weightedOptions := make(types.WeightedVoteOptions, len(options))
+	remaining := sdk.OneDec()
+
+	for i, option := range options {
+		weight := remaining
+		if i < len(options)-1 {
+			// leave part of the remaining weight to the next options
+			weight = remaining.Mul(sdk.NewDecWithPrec(int64(1+r.Intn(99)), 2))
+		}
+
+		weightedOptions[i] = types.NewWeightedVoteOption(option, weight)
+		remaining = remaining.Sub(weight)
+	}
+
+	return weightedOptions
+}
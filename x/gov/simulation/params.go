@@ -19,6 +19,8 @@ const (
 	subkeyQuorum     = "quorum"
 	subkeyThreshold  = "threshold"
 	subkeyVeto       = "veto"
+
+	subkeyExpeditedThreshold = "expedited_threshold"
 )
 
 // ParamChanges defines the parameters that can be modified by param change proposals
@@ -27,12 +29,20 @@ func ParamChanges(r *rand.Rand) []simulation.ParamChange {
 	return []simulation.ParamChange{
 		simulation.NewSimParamChange(types.ModuleName, keyVotingParams,
 			func(r *rand.Rand) string {
-				return fmt.Sprintf(`{"voting_period": "%d"}`, GenVotingParamsVotingPeriod(r))
+				// the expedited voting period must remain shorter than the voting period
+				votingPeriod := GenVotingParamsVotingPeriod(r)
+				return fmt.Sprintf(
+					`{"voting_period": "%d", "expedited_voting_period": "%d"}`,
+					votingPeriod, GenVotingParamsExpeditedVotingPeriod(r, votingPeriod),
+				)
 			},
 		),
 		simulation.NewSimParamChange(types.ModuleName, keyDepositParams,
 			func(r *rand.Rand) string {
-				return fmt.Sprintf(`{"max_deposit_period": "%d"}`, GenDepositParamsDepositPeriod(r))
+				return fmt.Sprintf(
+					`{"max_deposit_period": "%d", "proposal_cancel_ratio": "%s"}`,
+					GenDepositParamsDepositPeriod(r), GenDepositParamsProposalCancelRatio(r),
+				)
 			},
 		),
 		simulation.NewSimParamChange(types.ModuleName, keyTallyParams,
@@ -44,6 +54,7 @@ func ParamChanges(r *rand.Rand) []simulation.ParamChange {
 					{subkeyQuorum, GenTallyParamsQuorum(r)},
 					{subkeyThreshold, GenTallyParamsThreshold(r)},
 					{subkeyVeto, GenTallyParamsVeto(r)},
+					{subkeyExpeditedThreshold, GenTallyParamsExpeditedThreshold(r)},
 				}
 
 				pc := make(map[string]string)
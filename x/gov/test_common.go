@@ -53,6 +53,7 @@ func getMockApp(
 
 	mApp := mock.NewApp()
 
+	bank.RegisterCodec(mApp.Cdc)
 	staking.RegisterCodec(mApp.Cdc)
 	types.RegisterCodec(mApp.Cdc)
 	supply.RegisterCodec(mApp.Cdc)
@@ -81,14 +82,16 @@ func getMockApp(
 	bk := mApp.BankKeeper
 	supplyKeeper := supply.NewKeeper(mApp.Cdc, keySupply, mApp.AccountKeeper, bk, maccPerms)
 	sk := staking.NewKeeper(
-		staking.ModuleCdc, keyStaking, bk, supplyKeeper, pk.Subspace(staking.DefaultParamspace),
+		staking.ModuleCdc, keyStaking, mApp.AccountKeeper, bk, supplyKeeper, pk.Subspace(staking.DefaultParamspace),
 	)
 
 	keeper := keep.NewKeeper(
-		mApp.Cdc, keyGov, pk.Subspace(DefaultParamspace).WithKeyTable(ParamKeyTable()), supplyKeeper, sk, rtr,
+		mApp.Cdc, keyGov, pk.Subspace(DefaultParamspace).WithKeyTable(ParamKeyTable()), supplyKeeper, sk, rtr, mApp.Router(),
 	)
+	sk.SetHooks(keeper.Hooks())
 
 	mApp.Router().AddRoute(types.RouterKey, NewHandler(keeper))
+	mApp.Router().AddRoute(bank.RouterKey, bank.NewHandler(bk, supplyKeeper))
 	mApp.QueryRouter().AddRoute(types.QuerierRoute, keep.NewQuerier(keeper))
 
 	mApp.SetEndBlocker(getEndBlocker(keeper))
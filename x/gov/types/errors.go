@@ -14,4 +14,8 @@ var (
 	ErrInvalidVote             = sdkerrors.Register(ModuleName, 6, "invalid vote option")
 	ErrInvalidGenesis          = sdkerrors.Register(ModuleName, 7, "invalid genesis state")
 	ErrNoProposalHandlerExists = sdkerrors.Register(ModuleName, 8, "no handler exists for proposal type")
+	ErrInvalidProposalMsg      = sdkerrors.Register(ModuleName, 9, "invalid proposal message")
+	ErrUnroutableProposalMsg   = sdkerrors.Register(ModuleName, 10, "proposal message not recognized by router")
+	ErrInvalidSigner           = sdkerrors.Register(ModuleName, 11, "expected gov account as only signer for proposal message")
+	ErrInvalidProposer         = sdkerrors.Register(ModuleName, 12, "only the proposer can cancel a proposal")
 )
@@ -38,6 +38,14 @@ type StakingKeeper interface {
 		ctx sdk.Context, delegator sdk.AccAddress,
 		fn func(index int64, delegation stakingexported.DelegationI) (stop bool),
 	)
+
+	Delegation(sdk.Context, sdk.AccAddress, sdk.ValAddress) stakingexported.DelegationI // get a particular delegation
+}
+
+// StakingHooks event hooks for staking delegation objects (noalias)
+type StakingHooks interface {
+	BeforeDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) // Must be called when a delegation's shares are modified
+	AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress)        // Must be called when a delegation is created or its shares are modified
 }
 
 // AccountKeeper defines the expected account keeper (noalias)
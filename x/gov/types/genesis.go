@@ -58,6 +58,12 @@ func ValidateGenesis(data GenesisState) error {
 			threshold.String())
 	}
 
+	expeditedThreshold := data.TallyParams.ExpeditedThreshold
+	if expeditedThreshold.IsNil() || !expeditedThreshold.GT(threshold) || expeditedThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("governance expedited vote threshold should be greater than the vote threshold and less or equal to one, is %s",
+			expeditedThreshold.String())
+	}
+
 	veto := data.TallyParams.Veto
 	if veto.IsNegative() || veto.GT(sdk.OneDec()) {
 		return fmt.Errorf("governance vote veto threshold should be positive and less or equal to one, is %s",
@@ -69,5 +75,24 @@ func ValidateGenesis(data GenesisState) error {
 			data.DepositParams.MinDeposit.String())
 	}
 
+	if !data.DepositParams.MinExpeditedDeposit.IsValid() ||
+		!data.DepositParams.MinExpeditedDeposit.IsAllGT(data.DepositParams.MinDeposit) {
+		return fmt.Errorf("governance expedited deposit amount must be a valid sdk.Coins amount greater than the minimum deposit, is %s",
+			data.DepositParams.MinExpeditedDeposit.String())
+	}
+
+	cancelRatio := data.DepositParams.ProposalCancelRatio
+	if cancelRatio.IsNil() || cancelRatio.IsNegative() || cancelRatio.GT(sdk.OneDec()) {
+		return fmt.Errorf("governance proposal cancel ratio should be positive and less or equal to one, is %s",
+			cancelRatio.String())
+	}
+
+	votingPeriod := data.VotingParams.VotingPeriod
+	expeditedVotingPeriod := data.VotingParams.ExpeditedVotingPeriod
+	if expeditedVotingPeriod <= 0 || expeditedVotingPeriod >= votingPeriod {
+		return fmt.Errorf("governance expedited voting period should be positive and shorter than the voting period (%s), is %s",
+			votingPeriod, expeditedVotingPeriod)
+	}
+
 	return nil
 }
@@ -36,18 +36,25 @@ const (
 //
 // - 0x03: nextProposalID
 //
+// - 0x04<proposalID_Bytes>: votePruningProposalID
+//
 // - 0x10<proposalID_Bytes><depositorAddr_Bytes>: Deposit
 //
 // - 0x20<proposalID_Bytes><voterAddr_Bytes>: Voter
+//
+// - 0x30<proposalID_Bytes><validatorAddr_Bytes>: ValidatorTally
 var (
 	ProposalsKeyPrefix          = []byte{0x00}
 	ActiveProposalQueuePrefix   = []byte{0x01}
 	InactiveProposalQueuePrefix = []byte{0x02}
 	ProposalIDKey               = []byte{0x03}
+	VotePruningQueuePrefix      = []byte{0x04}
 
 	DepositsKeyPrefix = []byte{0x10}
 
 	VotesKeyPrefix = []byte{0x20}
+
+	ValidatorTalliesKeyPrefix = []byte{0x30}
 )
 
 var lenTime = len(sdk.FormatTimeBytes(time.Now()))
@@ -89,6 +96,11 @@ func InactiveProposalQueueKey(proposalID uint64, endTime time.Time) []byte {
 	return append(InactiveProposalByTimeKey(endTime), GetProposalIDBytes(proposalID)...)
 }
 
+// VotePruningQueueKey returns the key for a proposalID in the vote pruning queue
+func VotePruningQueueKey(proposalID uint64) []byte {
+	return append(VotePruningQueuePrefix, GetProposalIDBytes(proposalID)...)
+}
+
 // DepositsKey gets the first part of the deposits key based on the proposalID
 func DepositsKey(proposalID uint64) []byte {
 	return append(DepositsKeyPrefix, GetProposalIDBytes(proposalID)...)
@@ -109,6 +121,16 @@ func VoteKey(proposalID uint64, voterAddr sdk.AccAddress) []byte {
 	return append(VotesKey(proposalID), voterAddr.Bytes()...)
 }
 
+// ValidatorTalliesKey gets the first part of the validator tallies key based on the proposalID
+func ValidatorTalliesKey(proposalID uint64) []byte {
+	return append(ValidatorTalliesKeyPrefix, GetProposalIDBytes(proposalID)...)
+}
+
+// ValidatorTallyKey key of the running tally of a validator on a proposal
+func ValidatorTallyKey(proposalID uint64, valAddr sdk.ValAddress) []byte {
+	return append(ValidatorTalliesKey(proposalID), valAddr.Bytes()...)
+}
+
 // Split keys function; used for iterators
 
 // SplitProposalKey split the proposal key and returns the proposal id
@@ -130,6 +152,11 @@ func SplitInactiveProposalQueueKey(key []byte) (proposalID uint64, endTime time.
 	return splitKeyWithTime(key)
 }
 
+// SplitVotePruningQueueKey split the vote pruning queue key and returns the proposal id
+func SplitVotePruningQueueKey(key []byte) (proposalID uint64) {
+	return SplitProposalKey(key)
+}
+
 // SplitKeyDeposit split the deposits key and returns the proposal id and depositor address
 func SplitKeyDeposit(key []byte) (proposalID uint64, depositorAddr sdk.AccAddress) {
 	return splitKeyWithAddress(key)
@@ -140,6 +167,12 @@ func SplitKeyVote(key []byte) (proposalID uint64, voterAddr sdk.AccAddress) {
 	return splitKeyWithAddress(key)
 }
 
+// SplitKeyValidatorTally split the validator tallies key and returns the proposal id and validator address
+func SplitKeyValidatorTally(key []byte) (proposalID uint64, valAddr sdk.ValAddress) {
+	proposalID, addr := splitKeyWithAddress(key)
+	return proposalID, sdk.ValAddress(addr)
+}
+
 // private functions
 
 func splitKeyWithTime(key []byte) (proposalID uint64, endTime time.Time) {
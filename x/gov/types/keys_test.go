@@ -69,3 +69,21 @@ func TestVoteKeys(t *testing.T) {
 	key = VoteKey(5, addr2)
 	require.Panics(t, func() { SplitKeyVote(key) })
 }
+
+func TestValidatorTallyKeys(t *testing.T) {
+
+	key := ValidatorTalliesKey(2)
+	proposalID := SplitProposalKey(key)
+	require.Equal(t, int(proposalID), 2)
+
+	valAddr := sdk.ValAddress(addr)
+	key = ValidatorTallyKey(2, valAddr)
+	proposalID, gotValAddr := SplitKeyValidatorTally(key)
+	require.Equal(t, int(proposalID), 2)
+	require.Equal(t, valAddr, gotValAddr)
+
+	// invalid key
+	addr2 := sdk.ValAddress("test1")
+	key = ValidatorTallyKey(5, addr2)
+	require.Panics(t, func() { SplitKeyValidatorTally(key) })
+}
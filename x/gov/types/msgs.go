@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/json"
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -11,22 +12,36 @@ import (
 const (
 	TypeMsgDeposit        = "deposit"
 	TypeMsgVote           = "vote"
+	TypeMsgVoteWeighted   = "weighted_vote"
 	TypeMsgSubmitProposal = "submit_proposal"
+	TypeMsgCancelProposal = "cancel_proposal"
 )
 
-var _, _, _ sdk.Msg = MsgSubmitProposal{}, MsgDeposit{}, MsgVote{}
+var _, _, _, _, _ sdk.Msg = MsgSubmitProposal{}, MsgDeposit{}, MsgVote{}, MsgVoteWeighted{}, MsgCancelProposal{}
 
 // MsgSubmitProposal defines a message to create a governance proposal with a
-// given content and initial deposit
+// given content and initial deposit. The proposal may also carry messages
+// which are executed by the governance module account if it passes.
 type MsgSubmitProposal struct {
 	Content        Content        `json:"content" yaml:"content"`
-	InitialDeposit sdk.Coins      `json:"initial_deposit" yaml:"initial_deposit"` //  Initial deposit paid by sender. Must be strictly positive
-	Proposer       sdk.AccAddress `json:"proposer" yaml:"proposer"`               //  Address of the proposer
+	InitialDeposit sdk.Coins      `json:"initial_deposit" yaml:"initial_deposit"`               //  Initial deposit paid by sender. Must be strictly positive
+	Proposer       sdk.AccAddress `json:"proposer" yaml:"proposer"`                             //  Address of the proposer
+	Messages       []sdk.Msg      `json:"messages,omitempty" yaml:"messages,omitempty"`         //  Messages to execute if the proposal passes
+	IsExpedited    bool           `json:"is_expedited,omitempty" yaml:"is_expedited,omitempty"` //  Whether the proposal is expedited
 }
 
 // NewMsgSubmitProposal creates a new MsgSubmitProposal instance
 func NewMsgSubmitProposal(content Content, initialDeposit sdk.Coins, proposer sdk.AccAddress) MsgSubmitProposal {
-	return MsgSubmitProposal{content, initialDeposit, proposer}
+	return MsgSubmitProposal{Content: content, InitialDeposit: initialDeposit, Proposer: proposer}
+}
+
+// NewMsgSubmitProposalWithMsgs creates a new MsgSubmitProposal instance
+// whose messages are executed by the governance module account if the
+// proposal passes
+func NewMsgSubmitProposalWithMsgs(
+	content Content, messages []sdk.Msg, initialDeposit sdk.Coins, proposer sdk.AccAddress,
+) MsgSubmitProposal {
+	return MsgSubmitProposal{Content: content, InitialDeposit: initialDeposit, Proposer: proposer, Messages: messages}
 }
 
 // Route implements Msg
@@ -52,6 +67,14 @@ func (msg MsgSubmitProposal) ValidateBasic() error {
 	if !IsValidProposalType(msg.Content.ProposalType()) {
 		return sdkerrors.Wrap(ErrInvalidProposalType, msg.Content.ProposalType())
 	}
+	for i, m := range msg.Messages {
+		if m == nil {
+			return sdkerrors.Wrapf(ErrInvalidProposalMsg, "message %d is empty", i)
+		}
+		if err := m.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidProposalMsg, "message %d: %s", i, err)
+		}
+	}
 
 	return msg.Content.ValidateBasic()
 }
@@ -61,12 +84,48 @@ func (msg MsgSubmitProposal) String() string {
 	return fmt.Sprintf(`Submit Proposal Message:
   Content:         %s
   Initial Deposit: %s
-`, msg.Content.String(), msg.InitialDeposit)
+  Messages:        %d
+  Expedited:       %t
+`, msg.Content.String(), msg.InitialDeposit, len(msg.Messages), msg.IsExpedited)
+}
+
+// submitProposalSignDoc defines the sign bytes of a MsgSubmitProposal which
+// carries messages. As in the auth StdSignDoc, the messages are included
+// through their own sign bytes so the module codec does not need to know every
+// message type.
+type submitProposalSignDoc struct {
+	Content        Content           `json:"content"`
+	InitialDeposit sdk.Coins         `json:"initial_deposit"`
+	Messages       []json.RawMessage `json:"messages"`
+	Proposer       sdk.AccAddress    `json:"proposer"`
+	IsExpedited    bool              `json:"is_expedited,omitempty"`
 }
 
 // GetSignBytes implements Msg
 func (msg MsgSubmitProposal) GetSignBytes() []byte {
-	bz := ModuleCdc.MustMarshalJSON(msg)
+	if len(msg.Messages) == 0 {
+		bz := ModuleCdc.MustMarshalJSON(msg)
+		return sdk.MustSortJSON(bz)
+	}
+
+	messages := make([]json.RawMessage, 0, len(msg.Messages))
+	for _, m := range msg.Messages {
+		messages = append(messages, json.RawMessage(m.GetSignBytes()))
+	}
+
+	bz := ModuleCdc.MustMarshalJSON(struct {
+		Type  string                `json:"type"`
+		Value submitProposalSignDoc `json:"value"`
+	}{
+		Type: "cosmos-sdk/MsgSubmitProposal",
+		Value: submitProposalSignDoc{
+			Content:        msg.Content,
+			InitialDeposit: msg.InitialDeposit,
+			Messages:       messages,
+			Proposer:       msg.Proposer,
+			IsExpedited:    msg.IsExpedited,
+		},
+	})
 	return sdk.MustSortJSON(bz)
 }
 
@@ -176,3 +235,100 @@ func (msg MsgVote) GetSignBytes() []byte {
 func (msg MsgVote) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Voter}
 }
+
+// MsgVoteWeighted defines a message to cast a vote splitting the voting power
+// of the voter between several options
+type MsgVoteWeighted struct {
+	ProposalID uint64              `json:"proposal_id" yaml:"proposal_id"` // ID of the proposal
+	Voter      sdk.AccAddress      `json:"voter" yaml:"voter"`             //  address of the voter
+	Options    WeightedVoteOptions `json:"options" yaml:"options"`         //  options chosen by the voter with weights summing to one
+}
+
+// NewMsgVoteWeighted creates a message to cast a weighted vote on an active
+// proposal
+func NewMsgVoteWeighted(voter sdk.AccAddress, proposalID uint64, options WeightedVoteOptions) MsgVoteWeighted {
+	return MsgVoteWeighted{proposalID, voter, options}
+}
+
+// Route implements Msg
+func (msg MsgVoteWeighted) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgVoteWeighted) Type() string { return TypeMsgVoteWeighted }
+
+// ValidateBasic implements Msg
+func (msg MsgVoteWeighted) ValidateBasic() error {
+	if msg.Voter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Voter.String())
+	}
+	if !ValidWeightedVoteOptions(msg.Options) {
+		return sdkerrors.Wrap(ErrInvalidVote, msg.Options.String())
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface
+func (msg MsgVoteWeighted) String() string {
+	return fmt.Sprintf(`Weighted Vote Message:
+  Proposal ID: %d
+  Options:     %s
+`, msg.ProposalID, msg.Options)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgVoteWeighted) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgVoteWeighted) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Voter}
+}
+
+// MsgCancelProposal defines a message to cancel a proposal by its proposer
+// while it is in the deposit or voting period
+type MsgCancelProposal struct {
+	ProposalID uint64         `json:"proposal_id" yaml:"proposal_id"` // ID of the proposal
+	Proposer   sdk.AccAddress `json:"proposer" yaml:"proposer"`       //  address of the proposer
+}
+
+// NewMsgCancelProposal creates a message to cancel a proposal
+func NewMsgCancelProposal(proposalID uint64, proposer sdk.AccAddress) MsgCancelProposal {
+	return MsgCancelProposal{proposalID, proposer}
+}
+
+// Route implements Msg
+func (msg MsgCancelProposal) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgCancelProposal) Type() string { return TypeMsgCancelProposal }
+
+// ValidateBasic implements Msg
+func (msg MsgCancelProposal) ValidateBasic() error {
+	if msg.Proposer.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Proposer.String())
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface
+func (msg MsgCancelProposal) String() string {
+	return fmt.Sprintf(`Cancel Proposal Message:
+  Proposal ID: %d
+  Proposer:    %s
+`, msg.ProposalID, msg.Proposer)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgCancelProposal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgCancelProposal) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Proposer}
+}
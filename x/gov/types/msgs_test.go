@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var (
@@ -57,6 +58,54 @@ func TestMsgSubmitProposal(t *testing.T) {
 	}
 }
 
+type testMsg struct {
+	Signer sdk.AccAddress `json:"signer"`
+}
+
+func (testMsg) Route() string { return "test" }
+func (testMsg) Type() string  { return "test" }
+func (msg testMsg) ValidateBasic() error {
+	if msg.Signer.Empty() {
+		return sdkerrors.ErrInvalidAddress
+	}
+	return nil
+}
+func (msg testMsg) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+func (msg testMsg) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.Signer} }
+
+func TestMsgSubmitProposalWithMsgs(t *testing.T) {
+	content := NewTextProposal("Test Proposal", "the purpose of this proposal is to test")
+
+	msg := NewMsgSubmitProposalWithMsgs(content, []sdk.Msg{testMsg{addrs[1]}}, coinsPos, addrs[0])
+	require.NoError(t, msg.ValidateBasic())
+
+	msg = NewMsgSubmitProposalWithMsgs(content, []sdk.Msg{testMsg{addrs[1]}, testMsg{}}, coinsPos, addrs[0])
+	require.True(t, ErrInvalidProposalMsg.Is(msg.ValidateBasic()))
+
+	msg = NewMsgSubmitProposalWithMsgs(content, []sdk.Msg{nil}, coinsPos, addrs[0])
+	require.True(t, ErrInvalidProposalMsg.Is(msg.ValidateBasic()))
+}
+
+func TestMsgSubmitProposalGetSignBytes(t *testing.T) {
+	content := NewTextProposal("Test", "description")
+
+	// proposals without messages keep their sign bytes
+	msg := NewMsgSubmitProposal(content, coinsPos, addrs[0])
+	expected := `{"type":"cosmos-sdk/MsgSubmitProposal","value":{"content":{"type":"cosmos-sdk/TextProposal","value":{"description":"description","title":"Test"}},"initial_deposit":[{"amount":"1000","denom":"stake"}],"proposer":"cosmos1w3jhxap3gempvr"}}`
+	require.Equal(t, expected, string(msg.GetSignBytes()))
+
+	msg = NewMsgSubmitProposalWithMsgs(content, []sdk.Msg{testMsg{addrs[1]}}, coinsPos, addrs[0])
+	expected = `{"type":"cosmos-sdk/MsgSubmitProposal","value":{"content":{"type":"cosmos-sdk/TextProposal","value":{"description":"description","title":"Test"}},"initial_deposit":[{"amount":"1000","denom":"stake"}],"messages":[{"signer":"cosmos1w3jhxapjx2whzu"}],"proposer":"cosmos1w3jhxap3gempvr"}}`
+	require.Equal(t, expected, string(msg.GetSignBytes()))
+
+	msg = NewMsgSubmitProposal(content, coinsPos, addrs[0])
+	msg.IsExpedited = true
+	expected = `{"type":"cosmos-sdk/MsgSubmitProposal","value":{"content":{"type":"cosmos-sdk/TextProposal","value":{"description":"description","title":"Test"}},"initial_deposit":[{"amount":"1000","denom":"stake"}],"is_expedited":true,"proposer":"cosmos1w3jhxap3gempvr"}}`
+	require.Equal(t, expected, string(msg.GetSignBytes()))
+}
+
 func TestMsgDepositGetSignBytes(t *testing.T) {
 	addr := sdk.AccAddress("addr1")
 	msg := NewMsgDeposit(addr, 0, coinsPos)
@@ -115,3 +164,67 @@ func TestMsgVote(t *testing.T) {
 		}
 	}
 }
+
+// test ValidateBasic for MsgVoteWeighted
+func TestMsgVoteWeighted(t *testing.T) {
+	tests := []struct {
+		proposalID uint64
+		voterAddr  sdk.AccAddress
+		options    string
+		expectPass bool
+	}{
+		{0, addrs[0], "Yes=1", true},
+		{0, addrs[0], "Yes=0.6,No=0.3,Abstain=0.05,NoWithVeto=0.05", true},
+		{0, sdk.AccAddress{}, "Yes=1", false},
+		{0, addrs[0], "Yes=0.6,No=0.3", false},
+		{0, addrs[0], "Yes=0.6,No=0.6", false},
+		{0, addrs[0], "Yes=0.5,Yes=0.5", false},
+		{0, addrs[0], "Yes=1.2,No=-0.2", false},
+		{0, addrs[0], "Yes=1,No=0", false},
+	}
+
+	for i, tc := range tests {
+		options, err := WeightedVoteOptionsFromString(tc.options)
+		require.NoError(t, err, "test: %v", i)
+
+		msg := NewMsgVoteWeighted(tc.voterAddr, tc.proposalID, options)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+
+	require.Error(t, NewMsgVoteWeighted(addrs[0], 0, nil).ValidateBasic())
+
+	_, err := WeightedVoteOptionsFromString("Yes")
+	require.Error(t, err)
+	_, err = WeightedVoteOptionsFromString("Maybe=1")
+	require.Error(t, err)
+}
+
+func TestMsgCancelProposal(t *testing.T) {
+	tests := []struct {
+		proposalID   uint64
+		proposerAddr sdk.AccAddress
+		expectPass   bool
+	}{
+		{0, addrs[0], true},
+		{1, addrs[1], true},
+		{1, sdk.AccAddress{}, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgCancelProposal(tc.proposalID, tc.proposerAddr)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+			require.Equal(t, []sdk.AccAddress{tc.proposerAddr}, msg.GetSigners())
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+
+	msg := NewMsgCancelProposal(0, sdk.AccAddress("addr1"))
+	expected := `{"type":"cosmos-sdk/MsgCancelProposal","value":{"proposal_id":"0","proposer":"cosmos1v9jxgu33kfsgr5"}}`
+	require.Equal(t, expected, string(msg.GetSignBytes()))
+}
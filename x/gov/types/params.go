@@ -10,15 +10,19 @@ import (
 
 // Default period for deposits & voting
 const (
-	DefaultPeriod time.Duration = time.Hour * 24 * 2 // 2 days
+	DefaultPeriod          time.Duration = time.Hour * 24 * 2 // 2 days
+	DefaultExpeditedPeriod time.Duration = time.Hour * 24     // 1 day
 )
 
 // Default governance params
 var (
-	DefaultMinDepositTokens = sdk.TokensFromConsensusPower(10)
-	DefaultQuorum           = sdk.NewDecWithPrec(334, 3)
-	DefaultThreshold        = sdk.NewDecWithPrec(5, 1)
-	DefaultVeto             = sdk.NewDecWithPrec(334, 3)
+	DefaultMinDepositTokens          = sdk.TokensFromConsensusPower(10)
+	DefaultMinExpeditedDepositTokens = sdk.TokensFromConsensusPower(50)
+	DefaultQuorum                    = sdk.NewDecWithPrec(334, 3)
+	DefaultThreshold                 = sdk.NewDecWithPrec(5, 1)
+	DefaultExpeditedThreshold        = sdk.NewDecWithPrec(667, 3)
+	DefaultVeto                      = sdk.NewDecWithPrec(334, 3)
+	DefaultProposalCancelRatio       = sdk.NewDecWithPrec(5, 1)
 )
 
 // Parameter store key
@@ -39,15 +43,21 @@ func ParamKeyTable() params.KeyTable {
 
 // DepositParams defines the params around deposits for governance
 type DepositParams struct {
-	MinDeposit       sdk.Coins     `json:"min_deposit,omitempty" yaml:"min_deposit,omitempty"`               //  Minimum deposit for a proposal to enter voting period.
-	MaxDepositPeriod time.Duration `json:"max_deposit_period,omitempty" yaml:"max_deposit_period,omitempty"` //  Maximum period for Atom holders to deposit on a proposal. Initial value: 2 months
+	MinDeposit          sdk.Coins     `json:"min_deposit,omitempty" yaml:"min_deposit,omitempty"`                     //  Minimum deposit for a proposal to enter voting period.
+	MaxDepositPeriod    time.Duration `json:"max_deposit_period,omitempty" yaml:"max_deposit_period,omitempty"`       //  Maximum period for Atom holders to deposit on a proposal. Initial value: 2 months
+	MinExpeditedDeposit sdk.Coins     `json:"min_expedited_deposit,omitempty" yaml:"min_expedited_deposit,omitempty"` //  Minimum deposit for an expedited proposal to enter voting period. Must be greater than MinDeposit
+	ProposalCancelRatio sdk.Dec       `json:"proposal_cancel_ratio,omitempty" yaml:"proposal_cancel_ratio,omitempty"` //  Fraction of the deposits burned when a proposal is canceled by its proposer. Initial value: 0.5
 }
 
 // NewDepositParams creates a new DepositParams object
-func NewDepositParams(minDeposit sdk.Coins, maxDepositPeriod time.Duration) DepositParams {
+func NewDepositParams(
+	minDeposit, minExpeditedDeposit sdk.Coins, maxDepositPeriod time.Duration, proposalCancelRatio sdk.Dec,
+) DepositParams {
 	return DepositParams{
-		MinDeposit:       minDeposit,
-		MaxDepositPeriod: maxDepositPeriod,
+		MinDeposit:          minDeposit,
+		MaxDepositPeriod:    maxDepositPeriod,
+		MinExpeditedDeposit: minExpeditedDeposit,
+		ProposalCancelRatio: proposalCancelRatio,
 	}
 }
 
@@ -55,20 +65,36 @@ func NewDepositParams(minDeposit sdk.Coins, maxDepositPeriod time.Duration) Depo
 func DefaultDepositParams() DepositParams {
 	return NewDepositParams(
 		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinDepositTokens)),
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinExpeditedDepositTokens)),
 		DefaultPeriod,
+		DefaultProposalCancelRatio,
 	)
 }
 
 // String implements stringer insterface
 func (dp DepositParams) String() string {
 	return fmt.Sprintf(`Deposit Params:
-  Min Deposit:        %s
-  Max Deposit Period: %s`, dp.MinDeposit, dp.MaxDepositPeriod)
+  Min Deposit:           %s
+  Min Expedited Deposit: %s
+  Max Deposit Period:    %s
+  Proposal Cancel Ratio: %s`, dp.MinDeposit, dp.MinExpeditedDeposit, dp.MaxDepositPeriod, dp.ProposalCancelRatio)
 }
 
 // Equal checks equality of DepositParams
 func (dp DepositParams) Equal(dp2 DepositParams) bool {
-	return dp.MinDeposit.IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod
+	return dp.MinDeposit.IsEqual(dp2.MinDeposit) &&
+		dp.MinExpeditedDeposit.IsEqual(dp2.MinExpeditedDeposit) &&
+		dp.MaxDepositPeriod == dp2.MaxDepositPeriod &&
+		dp.ProposalCancelRatio.Equal(dp2.ProposalCancelRatio)
+}
+
+// GetMinDeposit returns the minimum deposit required for a proposal to enter
+// the voting period, depending on whether it is expedited
+func (dp DepositParams) GetMinDeposit(expedited bool) sdk.Coins {
+	if expedited {
+		return dp.MinExpeditedDeposit
+	}
+	return dp.MinDeposit
 }
 
 func validateDepositParams(i interface{}) error {
@@ -83,38 +109,59 @@ func validateDepositParams(i interface{}) error {
 	if v.MaxDepositPeriod <= 0 {
 		return fmt.Errorf("maximum deposit period must be positive: %d", v.MaxDepositPeriod)
 	}
+	if !v.MinExpeditedDeposit.IsValid() {
+		return fmt.Errorf("invalid minimum expedited deposit: %s", v.MinExpeditedDeposit)
+	}
+	if !v.MinExpeditedDeposit.IsAllGT(v.MinDeposit) {
+		return fmt.Errorf("minimum expedited deposit %s must be greater than minimum deposit %s", v.MinExpeditedDeposit, v.MinDeposit)
+	}
+	if v.ProposalCancelRatio.IsNil() || v.ProposalCancelRatio.IsNegative() || v.ProposalCancelRatio.GT(sdk.OneDec()) {
+		return fmt.Errorf("proposal cancel ratio must be between 0 and 1: %s", v.ProposalCancelRatio)
+	}
 
 	return nil
 }
 
 // TallyParams defines the params around Tallying votes in governance
 type TallyParams struct {
-	Quorum    sdk.Dec `json:"quorum,omitempty" yaml:"quorum,omitempty"`       //  Minimum percentage of total stake needed to vote for a result to be considered valid
-	Threshold sdk.Dec `json:"threshold,omitempty" yaml:"threshold,omitempty"` //  Minimum proportion of Yes votes for proposal to pass. Initial value: 0.5
-	Veto      sdk.Dec `json:"veto,omitempty" yaml:"veto,omitempty"`           //  Minimum value of Veto votes to Total votes ratio for proposal to be vetoed. Initial value: 1/3
+	Quorum             sdk.Dec `json:"quorum,omitempty" yaml:"quorum,omitempty"`                           //  Minimum percentage of total stake needed to vote for a result to be considered valid
+	Threshold          sdk.Dec `json:"threshold,omitempty" yaml:"threshold,omitempty"`                     //  Minimum proportion of Yes votes for proposal to pass. Initial value: 0.5
+	Veto               sdk.Dec `json:"veto,omitempty" yaml:"veto,omitempty"`                               //  Minimum value of Veto votes to Total votes ratio for proposal to be vetoed. Initial value: 1/3
+	ExpeditedThreshold sdk.Dec `json:"expedited_threshold,omitempty" yaml:"expedited_threshold,omitempty"` //  Minimum proportion of Yes votes for an expedited proposal to pass. Initial value: 0.667
 }
 
 // NewTallyParams creates a new TallyParams object
-func NewTallyParams(quorum, threshold, veto sdk.Dec) TallyParams {
+func NewTallyParams(quorum, threshold, expeditedThreshold, veto sdk.Dec) TallyParams {
 	return TallyParams{
-		Quorum:    quorum,
-		Threshold: threshold,
-		Veto:      veto,
+		Quorum:             quorum,
+		Threshold:          threshold,
+		Veto:               veto,
+		ExpeditedThreshold: expeditedThreshold,
 	}
 }
 
 // DefaultTallyParams default parameters for tallying
 func DefaultTallyParams() TallyParams {
-	return NewTallyParams(DefaultQuorum, DefaultThreshold, DefaultVeto)
+	return NewTallyParams(DefaultQuorum, DefaultThreshold, DefaultExpeditedThreshold, DefaultVeto)
 }
 
 // String implements stringer insterface
 func (tp TallyParams) String() string {
 	return fmt.Sprintf(`Tally Params:
-  Quorum:             %s
-  Threshold:          %s
-  Veto:               %s`,
-		tp.Quorum, tp.Threshold, tp.Veto)
+  Quorum:              %s
+  Threshold:           %s
+  Expedited Threshold: %s
+  Veto:                %s`,
+		tp.Quorum, tp.Threshold, tp.ExpeditedThreshold, tp.Veto)
+}
+
+// GetThreshold returns the proportion of Yes votes required for a proposal
+// to pass, depending on whether it is expedited
+func (tp TallyParams) GetThreshold(expedited bool) sdk.Dec {
+	if expedited {
+		return tp.ExpeditedThreshold
+	}
+	return tp.Threshold
 }
 
 func validateTallyParams(i interface{}) error {
@@ -141,31 +188,49 @@ func validateTallyParams(i interface{}) error {
 	if v.Veto.GT(sdk.OneDec()) {
 		return fmt.Errorf("veto threshold too large: %s", v)
 	}
+	if v.ExpeditedThreshold.IsNil() || !v.ExpeditedThreshold.GT(v.Threshold) {
+		return fmt.Errorf("expedited vote threshold must be greater than the vote threshold: %s", v.ExpeditedThreshold)
+	}
+	if v.ExpeditedThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("expedited vote threshold too large: %s", v)
+	}
 
 	return nil
 }
 
 // VotingParams defines the params around Voting in governance
 type VotingParams struct {
-	VotingPeriod time.Duration `json:"voting_period,omitempty" yaml:"voting_period,omitempty"` //  Length of the voting period.
+	VotingPeriod          time.Duration `json:"voting_period,omitempty" yaml:"voting_period,omitempty"`                     //  Length of the voting period.
+	ExpeditedVotingPeriod time.Duration `json:"expedited_voting_period,omitempty" yaml:"expedited_voting_period,omitempty"` //  Length of the voting period of expedited proposals. Must be shorter than VotingPeriod
 }
 
 // NewVotingParams creates a new VotingParams object
-func NewVotingParams(votingPeriod time.Duration) VotingParams {
+func NewVotingParams(votingPeriod, expeditedVotingPeriod time.Duration) VotingParams {
 	return VotingParams{
-		VotingPeriod: votingPeriod,
+		VotingPeriod:          votingPeriod,
+		ExpeditedVotingPeriod: expeditedVotingPeriod,
 	}
 }
 
 // DefaultVotingParams default parameters for voting
 func DefaultVotingParams() VotingParams {
-	return NewVotingParams(DefaultPeriod)
+	return NewVotingParams(DefaultPeriod, DefaultExpeditedPeriod)
 }
 
 // String implements stringer interface
 func (vp VotingParams) String() string {
 	return fmt.Sprintf(`Voting Params:
-  Voting Period:      %s`, vp.VotingPeriod)
+  Voting Period:           %s
+  Expedited Voting Period: %s`, vp.VotingPeriod, vp.ExpeditedVotingPeriod)
+}
+
+// GetVotingPeriod returns the length of the voting period of a proposal,
+// depending on whether it is expedited
+func (vp VotingParams) GetVotingPeriod(expedited bool) time.Duration {
+	if expedited {
+		return vp.ExpeditedVotingPeriod
+	}
+	return vp.VotingPeriod
 }
 
 func validateVotingParams(i interface{}) error {
@@ -177,6 +242,12 @@ func validateVotingParams(i interface{}) error {
 	if v.VotingPeriod <= 0 {
 		return fmt.Errorf("voting period must be positive: %s", v.VotingPeriod)
 	}
+	if v.ExpeditedVotingPeriod <= 0 {
+		return fmt.Errorf("expedited voting period must be positive: %s", v.ExpeditedVotingPeriod)
+	}
+	if v.ExpeditedVotingPeriod >= v.VotingPeriod {
+		return fmt.Errorf("expedited voting period %s must be shorter than the voting period %s", v.ExpeditedVotingPeriod, v.VotingPeriod)
+	}
 
 	return nil
 }
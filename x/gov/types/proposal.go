@@ -28,6 +28,12 @@ type Proposal struct {
 
 	VotingStartTime time.Time `json:"voting_start_time" yaml:"voting_start_time"` // Time of the block where MinDeposit was reached. -1 if MinDeposit is not reached
 	VotingEndTime   time.Time `json:"voting_end_time" yaml:"voting_end_time"`     // Time that the VotingPeriod for this proposal will end and votes will be tallied
+
+	Messages []sdk.Msg `json:"messages,omitempty" yaml:"messages,omitempty"` // Messages executed by the governance module account if the proposal passes
+
+	IsExpedited bool `json:"is_expedited" yaml:"is_expedited"` // Whether the proposal uses the expedited deposit, voting period and threshold
+
+	Proposer sdk.AccAddress `json:"proposer" yaml:"proposer"` // Address of the proposer, who may cancel the proposal. Empty for proposals submitted before it was recorded
 }
 
 // NewProposal creates a new Proposal instance
@@ -49,6 +55,8 @@ func (p Proposal) String() string {
   Title:              %s
   Type:               %s
   Status:             %s
+  Expedited:          %t
+  Proposer:           %s
   Submit Time:        %s
   Deposit End Time:   %s
   Total Deposit:      %s
@@ -56,7 +64,7 @@ func (p Proposal) String() string {
   Voting End Time:    %s
   Description:        %s`,
 		p.ProposalID, p.GetTitle(), p.ProposalType(),
-		p.Status, p.SubmitTime, p.DepositEndTime,
+		p.Status, p.IsExpedited, p.Proposer, p.SubmitTime, p.DepositEndTime,
 		p.TotalDeposit, p.VotingStartTime, p.VotingEndTime, p.GetDescription(),
 	)
 }
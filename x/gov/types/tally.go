@@ -8,16 +8,16 @@ import (
 
 // ValidatorGovInfo used for tallying
 type ValidatorGovInfo struct {
-	Address             sdk.ValAddress // address of the validator operator
-	BondedTokens        sdk.Int        // Power of a Validator
-	DelegatorShares     sdk.Dec        // Total outstanding delegator shares
-	DelegatorDeductions sdk.Dec        // Delegator deductions from validator's delegators voting independently
-	Vote                VoteOption     // Vote of the validator
+	Address             sdk.ValAddress      // address of the validator operator
+	BondedTokens        sdk.Int             // Power of a Validator
+	DelegatorShares     sdk.Dec             // Total outstanding delegator shares
+	DelegatorDeductions sdk.Dec             // Delegator deductions from validator's delegators voting independently
+	Vote                WeightedVoteOptions // Vote of the validator
 }
 
 // NewValidatorGovInfo creates a ValidatorGovInfo instance
 func NewValidatorGovInfo(address sdk.ValAddress, bondedTokens sdk.Int, delegatorShares,
-	delegatorDeductions sdk.Dec, vote VoteOption) ValidatorGovInfo {
+	delegatorDeductions sdk.Dec, vote WeightedVoteOptions) ValidatorGovInfo {
 
 	return ValidatorGovInfo{
 		Address:             address,
@@ -28,6 +28,101 @@ func NewValidatorGovInfo(address sdk.ValAddress, bondedTokens sdk.Int, delegator
 	}
 }
 
+// ValidatorTally is the running tally of the delegator shares of a validator
+// held by the voters of a proposal. These shares are deducted from the vote of
+// the validator and split between the options chosen by the voters.
+type ValidatorTally struct {
+	VoterShares sdk.Dec `json:"voter_shares" yaml:"voter_shares"` // shares of the validator delegated by voters
+	Yes         sdk.Dec `json:"yes" yaml:"yes"`
+	Abstain     sdk.Dec `json:"abstain" yaml:"abstain"`
+	No          sdk.Dec `json:"no" yaml:"no"`
+	NoWithVeto  sdk.Dec `json:"no_with_veto" yaml:"no_with_veto"`
+}
+
+// NewValidatorTally creates an empty ValidatorTally instance
+func NewValidatorTally() ValidatorTally {
+	return ValidatorTally{
+		VoterShares: sdk.ZeroDec(),
+		Yes:         sdk.ZeroDec(),
+		Abstain:     sdk.ZeroDec(),
+		No:          sdk.ZeroDec(),
+		NoWithVeto:  sdk.ZeroDec(),
+	}
+}
+
+// AddVote adds the shares of a voter split between the options of its vote
+func (vt ValidatorTally) AddVote(shares sdk.Dec, options WeightedVoteOptions) ValidatorTally {
+	vt.VoterShares = vt.VoterShares.Add(shares)
+	for _, option := range options {
+		vt = vt.addOptionShares(option.Option, shares.Mul(option.Weight))
+	}
+	return vt
+}
+
+// SubVote removes the shares of a voter previously added with the same vote options
+func (vt ValidatorTally) SubVote(shares sdk.Dec, options WeightedVoteOptions) ValidatorTally {
+	vt.VoterShares = vt.VoterShares.Sub(shares)
+	for _, option := range options {
+		vt = vt.addOptionShares(option.Option, shares.Mul(option.Weight).Neg())
+	}
+	return vt
+}
+
+// OptionShares returns the voter shares counted towards the given option
+func (vt ValidatorTally) OptionShares(option VoteOption) sdk.Dec {
+	switch option {
+	case OptionYes:
+		return vt.Yes
+	case OptionAbstain:
+		return vt.Abstain
+	case OptionNo:
+		return vt.No
+	case OptionNoWithVeto:
+		return vt.NoWithVeto
+	default:
+		return sdk.ZeroDec()
+	}
+}
+
+// IsZero returns true if no voter shares are counted
+func (vt ValidatorTally) IsZero() bool {
+	return vt.VoterShares.IsZero() && vt.Yes.IsZero() && vt.Abstain.IsZero() &&
+		vt.No.IsZero() && vt.NoWithVeto.IsZero()
+}
+
+// Equal returns if two validator tallies are equal
+func (vt ValidatorTally) Equal(comp ValidatorTally) bool {
+	return vt.VoterShares.Equal(comp.VoterShares) &&
+		vt.Yes.Equal(comp.Yes) &&
+		vt.Abstain.Equal(comp.Abstain) &&
+		vt.No.Equal(comp.No) &&
+		vt.NoWithVeto.Equal(comp.NoWithVeto)
+}
+
+// String implements stringer interface
+func (vt ValidatorTally) String() string {
+	return fmt.Sprintf(`Validator Tally:
+  VoterShares: %s
+  Yes:         %s
+  Abstain:     %s
+  No:          %s
+  NoWithVeto:  %s`, vt.VoterShares, vt.Yes, vt.Abstain, vt.No, vt.NoWithVeto)
+}
+
+func (vt ValidatorTally) addOptionShares(option VoteOption, shares sdk.Dec) ValidatorTally {
+	switch option {
+	case OptionYes:
+		vt.Yes = vt.Yes.Add(shares)
+	case OptionAbstain:
+		vt.Abstain = vt.Abstain.Add(shares)
+	case OptionNo:
+		vt.No = vt.No.Add(shares)
+	case OptionNoWithVeto:
+		vt.NoWithVeto = vt.NoWithVeto.Add(shares)
+	}
+	return vt
+}
+
 // TallyResult defines a standard tally for a proposal
 type TallyResult struct {
 	Yes        sdk.Int `json:"yes" yaml:"yes"`
@@ -3,24 +3,47 @@ package types
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Vote
 type Vote struct {
-	ProposalID uint64         `json:"proposal_id" yaml:"proposal_id"` //  proposalID of the proposal
-	Voter      sdk.AccAddress `json:"voter" yaml:"voter"`             //  address of the voter
-	Option     VoteOption     `json:"option" yaml:"option"`           //  option from OptionSet chosen by the voter
+	ProposalID uint64              `json:"proposal_id" yaml:"proposal_id"` //  proposalID of the proposal
+	Voter      sdk.AccAddress      `json:"voter" yaml:"voter"`             //  address of the voter
+	Option     VoteOption          `json:"option" yaml:"option"`           //  option from OptionSet chosen by the voter, empty for split votes
+	Options    WeightedVoteOptions `json:"options" yaml:"options"`         //  weighted options chosen by the voter
 }
 
 // NewVote creates a new Vote instance
 func NewVote(proposalID uint64, voter sdk.AccAddress, option VoteOption) Vote {
-	return Vote{proposalID, voter, option}
+	return Vote{proposalID, voter, option, NewNonSplitVoteOption(option)}
+}
+
+// NewWeightedVote creates a new Vote instance splitting the voting power of the
+// voter between the given options
+func NewWeightedVote(proposalID uint64, voter sdk.AccAddress, options WeightedVoteOptions) Vote {
+	option := OptionEmpty
+	if len(options) == 1 {
+		option = options[0].Option
+	}
+
+	return Vote{proposalID, voter, option, options}
+}
+
+// GetOptions returns the weighted options of the vote. Votes cast before
+// weighted votes were introduced only have an option, which carries all the
+// voting power.
+func (v Vote) GetOptions() WeightedVoteOptions {
+	if len(v.Options) == 0 && v.Option != OptionEmpty {
+		return NewNonSplitVoteOption(v.Option)
+	}
+	return v.Options
 }
 
 func (v Vote) String() string {
-	return fmt.Sprintf("voter %s voted with option %s on proposal %d", v.Voter, v.Option, v.ProposalID)
+	return fmt.Sprintf("voter %s voted with options %s on proposal %d", v.Voter, v.GetOptions(), v.ProposalID)
 }
 
 // Votes is a collection of Vote objects
@@ -32,7 +55,7 @@ func (v Votes) String() string {
 	}
 	out := fmt.Sprintf("Votes for Proposal %d:", v[0].ProposalID)
 	for _, vot := range v {
-		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.Option)
+		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.GetOptions())
 	}
 	return out
 }
@@ -41,7 +64,8 @@ func (v Votes) String() string {
 func (v Vote) Equals(comp Vote) bool {
 	return v.Voter.Equals(comp.Voter) &&
 		v.ProposalID == comp.ProposalID &&
-		v.Option == comp.Option
+		v.Option == comp.Option &&
+		v.GetOptions().Equal(comp.GetOptions())
 }
 
 // Empty returns whether a vote is empty.
@@ -61,6 +85,102 @@ const (
 	OptionNoWithVeto VoteOption = 0x04
 )
 
+// WeightedVoteOption defines a vote option with the fraction of the voting
+// power of the voter given to it
+type WeightedVoteOption struct {
+	Option VoteOption `json:"option" yaml:"option"`
+	Weight sdk.Dec    `json:"weight" yaml:"weight"`
+}
+
+// NewWeightedVoteOption creates a new WeightedVoteOption instance
+func NewWeightedVoteOption(option VoteOption, weight sdk.Dec) WeightedVoteOption {
+	return WeightedVoteOption{option, weight}
+}
+
+// String implements the Stringer interface.
+func (o WeightedVoteOption) String() string {
+	return fmt.Sprintf("%s=%s", o.Option, o.Weight)
+}
+
+// WeightedVoteOptions is a collection of WeightedVoteOption objects
+type WeightedVoteOptions []WeightedVoteOption
+
+// NewNonSplitVoteOption creates the weighted options of a vote giving all the
+// voting power of the voter to a single option
+func NewNonSplitVoteOption(option VoteOption) WeightedVoteOptions {
+	return WeightedVoteOptions{NewWeightedVoteOption(option, sdk.OneDec())}
+}
+
+// Equal returns whether two sets of weighted options are equal.
+func (options WeightedVoteOptions) Equal(comp WeightedVoteOptions) bool {
+	if len(options) != len(comp) {
+		return false
+	}
+	for i, o := range options {
+		if o.Option != comp[i].Option || !o.Weight.Equal(comp[i].Weight) {
+			return false
+		}
+	}
+	return true
+}
+
+// String implements the Stringer interface.
+func (options WeightedVoteOptions) String() string {
+	out := make([]string, len(options))
+	for i, o := range options {
+		out[i] = o.String()
+	}
+	return strings.Join(out, ",")
+}
+
+// WeightedVoteOptionsFromString returns weighted vote options from a comma
+// separated list of option=weight pairs, e.g. "Yes=0.6,No=0.4". It returns an
+// error if the string is invalid.
+func WeightedVoteOptionsFromString(str string) (WeightedVoteOptions, error) {
+	var options WeightedVoteOptions
+	for _, pair := range strings.Split(str, ",") {
+		fields := strings.Split(pair, "=")
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("'%s' is not a valid weighted vote option", pair)
+		}
+
+		option, err := VoteOptionFromString(fields[0])
+		if err != nil {
+			return nil, err
+		}
+		weight, err := sdk.NewDecFromStr(fields[1])
+		if err != nil {
+			return nil, err
+		}
+
+		options = append(options, NewWeightedVoteOption(option, weight))
+	}
+
+	return options, nil
+}
+
+// ValidWeightedVoteOptions returns true if the weighted vote options are valid
+// and false otherwise. Options must be valid and distinct, each with a
+// positive weight, and the weights must sum to one.
+func ValidWeightedVoteOptions(options WeightedVoteOptions) bool {
+	seen := make(map[VoteOption]bool)
+	totalWeight := sdk.ZeroDec()
+
+	for _, o := range options {
+		if !ValidVoteOption(o.Option) || seen[o.Option] {
+			return false
+		}
+		if o.Weight.IsNil() || !o.Weight.IsPositive() {
+			return false
+		}
+
+		seen[o.Option] = true
+		totalWeight = totalWeight.Add(o.Weight)
+	}
+
+	return totalWeight.Equal(sdk.OneDec())
+}
+
 // VoteOptionFromString returns a VoteOption from a string. It returns an error
 // if the string is invalid.
 func VoteOptionFromString(str string) (VoteOption, error) {
@@ -117,6 +237,12 @@ func (vo *VoteOption) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
+	// the option of a split vote is empty
+	if s == "" {
+		*vo = OptionEmpty
+		return nil
+	}
+
 	bz2, err := VoteOptionFromString(s)
 	if err != nil {
 		return err
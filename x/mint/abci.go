@@ -5,18 +5,20 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/mint/internal/types"
 )
 
-// BeginBlocker mints new tokens for the previous block.
-func BeginBlocker(ctx sdk.Context, k Keeper) {
+// BeginBlocker mints new tokens for the previous block. The annual inflation
+// rate is computed by the provided InflationCalculationFn, every block unless
+// the EpochIdentifier param is set, in which case it is only recalculated by
+// the EpochHooks at the end of every epoch.
+func BeginBlocker(ctx sdk.Context, k Keeper, ic types.InflationCalculationFn) {
 	// fetch stored minter & params
 	minter := k.GetMinter(ctx)
 	params := k.GetParams(ctx)
 
 	// recalculate inflation rate
-	totalStakingSupply := k.StakingTokenSupply(ctx)
+	if params.EpochIdentifier == "" {
+		minter = k.UpdateInflation(ctx, ic, 1)
+	}
 	bondedRatio := k.BondedRatio(ctx)
-	minter.Inflation = minter.NextInflationRate(params, bondedRatio)
-	minter.AnnualProvisions = minter.NextAnnualProvisions(params, totalStakingSupply)
-	k.SetMinter(ctx, minter)
 
 	// mint coins, update supply
 	mintedCoin := minter.BlockProvision(params)
@@ -27,8 +29,9 @@ func BeginBlocker(ctx sdk.Context, k Keeper) {
 		panic(err)
 	}
 
-	// send the minted coins to the fee collector account
-	err = k.AddCollectedFees(ctx, mintedCoins)
+	// distribute the minted coins between the fee collector, the community
+	// pool and the developer vesting module account
+	err = k.DistributeMintedCoin(ctx, mintedCoin)
 	if err != nil {
 		panic(err)
 	}
@@ -0,0 +1,65 @@
+package mint_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/x/epochs"
+	"github.com/cosmos/cosmos-sdk/x/mint"
+)
+
+func TestBeginBlockerEpochInflation(t *testing.T) {
+	genesisTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 1, Time: genesisTime})
+
+	for _, e := range app.EpochsKeeper.GetAllEpochInfos(ctx) {
+		app.EpochsKeeper.DeleteEpochInfo(ctx, e.Identifier)
+	}
+	require.NoError(t, app.EpochsKeeper.AddEpochInfo(ctx, epochs.NewEpochInfo("day", genesisTime, 24*time.Hour)))
+
+	params := mint.DefaultParams()
+	params.EpochIdentifier = "day"
+	app.MintKeeper.SetParams(ctx, params)
+	initialMinter := mint.DefaultInitialMinter()
+	app.MintKeeper.SetMinter(ctx, initialMinter)
+
+	beginBlock := func(blockTime time.Time) {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(blockTime)
+		epochs.BeginBlocker(ctx, app.EpochsKeeper)
+		mint.BeginBlocker(ctx, app.MintKeeper, mint.DefaultInflationCalculationFn)
+	}
+
+	// the inflation is not recalculated within the epoch
+	beginBlock(genesisTime)
+	beginBlock(genesisTime.Add(time.Hour))
+	require.Equal(t, initialMinter, app.MintKeeper.GetMinter(ctx))
+
+	// the inflation is recalculated at the end of the epoch, applying the rate
+	// change of every block of the epoch
+	beginBlock(genesisTime.Add(24 * time.Hour))
+	minter := app.MintKeeper.GetMinter(ctx)
+	require.True(t, minter.Inflation.GT(initialMinter.Inflation))
+	bondedRatio := app.MintKeeper.BondedRatio(ctx)
+	require.Equal(t, initialMinter.NextInflationRateOverBlocks(params, bondedRatio, 2), minter.Inflation)
+
+	beginBlock(genesisTime.Add(25 * time.Hour))
+	require.Equal(t, minter, app.MintKeeper.GetMinter(ctx))
+
+	// other epochs are ignored
+	require.NoError(t, app.EpochsKeeper.AddEpochInfo(ctx, epochs.NewEpochInfo("hour", genesisTime, time.Hour)))
+	beginBlock(genesisTime.Add(26 * time.Hour))
+	beginBlock(genesisTime.Add(27 * time.Hour))
+	require.Equal(t, minter, app.MintKeeper.GetMinter(ctx))
+
+	// without an epoch identifier the inflation is recalculated every block
+	params.EpochIdentifier = ""
+	app.MintKeeper.SetParams(ctx, params)
+	beginBlock(genesisTime.Add(28 * time.Hour))
+	require.True(t, app.MintKeeper.GetMinter(ctx).Inflation.GT(minter.Inflation))
+}
@@ -15,6 +15,9 @@ const (
 	QueryParameters       = types.QueryParameters
 	QueryInflation        = types.QueryInflation
 	QueryAnnualProvisions = types.QueryAnnualProvisions
+
+	QueryDistributionProportions   = types.QueryDistributionProportions
+	DeveloperVestingModuleAcctName = types.DeveloperVestingModuleAcctName
 )
 
 var (
@@ -32,6 +35,15 @@ var (
 	NewParams            = types.NewParams
 	DefaultParams        = types.DefaultParams
 
+	NewDistributionProportions     = types.NewDistributionProportions
+	DefaultDistributionProportions = types.DefaultDistributionProportions
+
+	NewEpochHooks = keeper.NewEpochHooks
+
+	DefaultInflationCalculationFn      = types.DefaultInflationCalculationFn
+	NewHalvingInflationCalculationFn   = types.NewHalvingInflationCalculationFn
+	NewMaxSupplyInflationCalculationFn = types.NewMaxSupplyInflationCalculationFn
+
 	// variable aliases
 	ModuleCdc              = types.ModuleCdc
 	MinterKey              = types.MinterKey
@@ -41,6 +53,9 @@ var (
 	KeyInflationMin        = types.KeyInflationMin
 	KeyGoalBonded          = types.KeyGoalBonded
 	KeyBlocksPerYear       = types.KeyBlocksPerYear
+
+	KeyDistributionProportions = types.KeyDistributionProportions
+	KeyEpochIdentifier         = types.KeyEpochIdentifier
 )
 
 type (
@@ -48,4 +63,8 @@ type (
 	GenesisState = types.GenesisState
 	Minter       = types.Minter
 	Params       = types.Params
+
+	EpochHooks              = keeper.EpochHooks
+	InflationCalculationFn  = types.InflationCalculationFn
+	DistributionProportions = types.DistributionProportions
 )
@@ -28,6 +28,7 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 			GetCmdQueryParams(cdc),
 			GetCmdQueryInflation(cdc),
 			GetCmdQueryAnnualProvisions(cdc),
+			GetCmdQueryDistributionProportions(cdc),
 		)...,
 	)
 
@@ -111,3 +112,29 @@ func GetCmdQueryAnnualProvisions(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+// GetCmdQueryDistributionProportions implements a command to return the
+// proportions in which newly minted coins are distributed.
+func GetCmdQueryDistributionProportions(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "distribution-proportions",
+		Short: "Query the proportions in which minted coins are distributed",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDistributionProportions)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var proportions types.DistributionProportions
+			if err := cdc.UnmarshalJSON(res, &proportions); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(proportions)
+		},
+	}
+}
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/internal/types"
+)
+
+// EpochHooks recalculates the annual inflation rate at the end of every epoch
+// identified by the EpochIdentifier param. It implements the epochs module's
+// EpochHooks interface.
+type EpochHooks struct {
+	k  Keeper
+	ek types.EpochsKeeper
+	ic types.InflationCalculationFn
+}
+
+// NewEpochHooks creates new mint epoch hooks. If a nil InflationCalculationFn
+// is provided, DefaultInflationCalculationFn is used.
+func NewEpochHooks(k Keeper, ek types.EpochsKeeper, ic types.InflationCalculationFn) EpochHooks {
+	if ic == nil {
+		ic = types.DefaultInflationCalculationFn
+	}
+
+	return EpochHooks{k: k, ek: ek, ic: ic}
+}
+
+// AfterEpochEnd recalculates the inflation rate if the epoch is the one set by
+// the EpochIdentifier param, over the blocks of the epoch which ended. The
+// epochs module calls it before storing the next epoch, so the stored epoch
+// still holds the start height of the ending one.
+func (h EpochHooks) AfterEpochEnd(ctx sdk.Context, identifier string, _ int64) {
+	if identifier != h.k.GetParams(ctx).EpochIdentifier {
+		return
+	}
+
+	epoch, found := h.ek.GetEpochInfo(ctx, identifier)
+	if !found {
+		panic(fmt.Sprintf("epoch %s not found", identifier))
+	}
+
+	// an epoch started before a restart from an exported genesis may have a
+	// start height above the current one, only the blocks since the restart
+	// are counted then
+	blocks := ctx.BlockHeight() - epoch.CurrentEpochStartHeight
+	if blocks < 0 {
+		blocks = ctx.BlockHeight()
+	}
+
+	h.k.UpdateInflation(ctx, h.ic, blocks)
+}
+
+// BeforeEpochStart is a noop
+func (h EpochHooks) BeforeEpochStart(_ sdk.Context, _ string, _ int64) {}
@@ -18,13 +18,15 @@ type Keeper struct {
 	paramSpace       params.Subspace
 	sk               types.StakingKeeper
 	supplyKeeper     types.SupplyKeeper
+	distrKeeper      types.DistributionKeeper
 	feeCollectorName string
 }
 
 // NewKeeper creates a new mint Keeper instance
 func NewKeeper(
 	cdc *codec.Codec, key sdk.StoreKey, paramSpace params.Subspace,
-	sk types.StakingKeeper, supplyKeeper types.SupplyKeeper, feeCollectorName string,
+	sk types.StakingKeeper, supplyKeeper types.SupplyKeeper, distrKeeper types.DistributionKeeper,
+	feeCollectorName string,
 ) Keeper {
 
 	// ensure mint module account is set
@@ -32,12 +34,18 @@ func NewKeeper(
 		panic("the mint module account has not been set")
 	}
 
+	// ensure developer vesting module account is set
+	if addr := supplyKeeper.GetModuleAddress(types.DeveloperVestingModuleAcctName); addr == nil {
+		panic("the developer vesting module account has not been set")
+	}
+
 	return Keeper{
 		cdc:              cdc,
 		storeKey:         key,
 		paramSpace:       paramSpace.WithKeyTable(types.ParamKeyTable()),
 		sk:               sk,
 		supplyKeeper:     supplyKeeper,
+		distrKeeper:      distrKeeper,
 		feeCollectorName: feeCollectorName,
 	}
 }
@@ -81,6 +89,23 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramSpace.SetParamSet(ctx, &params)
 }
 
+// UpdateInflation recalculates the annual inflation rate with the provided
+// InflationCalculationFn, over the given number of blocks since the last
+// recalculation, along with the annual provisions, and stores the updated
+// minter.
+func (k Keeper) UpdateInflation(ctx sdk.Context, ic types.InflationCalculationFn, blocks int64) types.Minter {
+	minter := k.GetMinter(ctx)
+	params := k.GetParams(ctx)
+
+	totalStakingSupply := k.StakingTokenSupply(ctx)
+	bondedRatio := k.BondedRatio(ctx)
+	minter.Inflation = ic(ctx, minter, params, bondedRatio, totalStakingSupply, blocks)
+	minter.AnnualProvisions = minter.NextAnnualProvisions(params, totalStakingSupply)
+	k.SetMinter(ctx, minter)
+
+	return minter
+}
+
 //______________________________________________________________________
 
 // StakingTokenSupply implements an alias call to the underlying staking keeper's
@@ -111,3 +136,54 @@ func (k Keeper) MintCoins(ctx sdk.Context, newCoins sdk.Coins) error {
 func (k Keeper) AddCollectedFees(ctx sdk.Context, fees sdk.Coins) error {
 	return k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.feeCollectorName, fees)
 }
+
+// DistributeMintedCoin splits the minted coin between the fee collector, the
+// community pool and the developer vesting module account according to the
+// DistributionProportions param. Truncation dust is sent to the fee collector.
+func (k Keeper) DistributeMintedCoin(ctx sdk.Context, mintedCoin sdk.Coin) error {
+	proportions := k.GetParams(ctx).DistributionProportions
+
+	communityPoolAmt := proportions.CommunityPool.MulInt(mintedCoin.Amount).TruncateInt()
+	developerRewardsAmt := proportions.DeveloperRewards.MulInt(mintedCoin.Amount).TruncateInt()
+	stakingAmt := mintedCoin.Amount.Sub(communityPoolAmt).Sub(developerRewardsAmt)
+
+	// send the staking rewards to the fee collector account
+	stakingCoins := sdk.NewCoins(sdk.NewCoin(mintedCoin.Denom, stakingAmt))
+	if err := k.AddCollectedFees(ctx, stakingCoins); err != nil {
+		return err
+	}
+	k.emitDistributionEvent(ctx, types.AttributeValueStaking, k.supplyKeeper.GetModuleAddress(k.feeCollectorName), stakingCoins)
+
+	// fund the community pool from the mint module account
+	communityPoolCoins := sdk.NewCoins(sdk.NewCoin(mintedCoin.Denom, communityPoolAmt))
+	if !communityPoolCoins.Empty() {
+		err := k.distrKeeper.FundCommunityPool(ctx, communityPoolCoins, k.supplyKeeper.GetModuleAddress(types.ModuleName))
+		if err != nil {
+			return err
+		}
+	}
+	k.emitDistributionEvent(ctx, types.AttributeValueCommunityPool, nil, communityPoolCoins)
+
+	// send the developer rewards to the developer vesting module account
+	developerRewardsCoins := sdk.NewCoins(sdk.NewCoin(mintedCoin.Denom, developerRewardsAmt))
+	err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.DeveloperVestingModuleAcctName, developerRewardsCoins)
+	if err != nil {
+		return err
+	}
+	k.emitDistributionEvent(
+		ctx, types.AttributeValueDeveloperRewards,
+		k.supplyKeeper.GetModuleAddress(types.DeveloperVestingModuleAcctName), developerRewardsCoins,
+	)
+
+	return nil
+}
+
+func (k Keeper) emitDistributionEvent(ctx sdk.Context, destination string, recipient sdk.AccAddress, amt sdk.Coins) {
+	attributes := []sdk.Attribute{sdk.NewAttribute(types.AttributeKeyDestination, destination)}
+	if recipient != nil {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()))
+	}
+	attributes = append(attributes, sdk.NewAttribute(sdk.AttributeKeyAmount, amt.String()))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeMintDistribution, attributes...))
+}
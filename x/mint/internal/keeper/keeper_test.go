@@ -0,0 +1,50 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/mint/internal/types"
+)
+
+func TestDistributeMintedCoin(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	params := types.DefaultParams()
+	params.DistributionProportions = types.NewDistributionProportions(
+		sdk.NewDecWithPrec(8, 1), sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(1, 1),
+	)
+	app.MintKeeper.SetParams(ctx, params)
+
+	feeCollector := app.SupplyKeeper.GetModuleAccount(ctx, auth.FeeCollectorName)
+	developerVesting := app.SupplyKeeper.GetModuleAccount(ctx, types.DeveloperVestingModuleAcctName)
+	feesBefore := app.BankKeeper.GetAllBalances(ctx, feeCollector.GetAddress())
+	communityPoolBefore := app.DistrKeeper.GetFeePoolCommunityCoins(ctx)
+
+	mintedCoin := sdk.NewInt64Coin(params.MintDenom, 1005)
+	require.NoError(t, app.MintKeeper.MintCoins(ctx, sdk.NewCoins(mintedCoin)))
+	require.NoError(t, app.MintKeeper.DistributeMintedCoin(ctx, mintedCoin))
+
+	// truncation dust goes to the fee collector
+	feesAfter := app.BankKeeper.GetAllBalances(ctx, feeCollector.GetAddress())
+	require.Equal(t, sdk.NewInt(805), feesAfter.AmountOf(params.MintDenom).Sub(feesBefore.AmountOf(params.MintDenom)))
+
+	communityPoolAfter := app.DistrKeeper.GetFeePoolCommunityCoins(ctx)
+	require.Equal(t, sdk.NewDec(100), communityPoolAfter.AmountOf(params.MintDenom).Sub(communityPoolBefore.AmountOf(params.MintDenom)))
+
+	require.Equal(t, sdk.NewInt(100), app.BankKeeper.GetAllBalances(ctx, developerVesting.GetAddress()).AmountOf(params.MintDenom))
+
+	mintModule := app.SupplyKeeper.GetModuleAccount(ctx, types.ModuleName)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, mintModule.GetAddress()).IsZero())
+
+	var distributionEvents int
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeMintDistribution {
+			distributionEvents++
+		}
+	}
+	require.Equal(t, 3, distributionEvents)
+}
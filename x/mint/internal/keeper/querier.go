@@ -22,6 +22,9 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case types.QueryAnnualProvisions:
 			return queryAnnualProvisions(ctx, k)
 
+		case types.QueryDistributionProportions:
+			return queryDistributionProportions(ctx, k)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query path: %s", path[0])
 		}
@@ -60,3 +63,14 @@ func queryAnnualProvisions(ctx sdk.Context, k Keeper) ([]byte, error) {
 
 	return res, nil
 }
+
+func queryDistributionProportions(ctx sdk.Context, k Keeper) ([]byte, error) {
+	params := k.GetParams(ctx)
+
+	res, err := codec.MarshalJSONIndent(k.cdc, params.DistributionProportions)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
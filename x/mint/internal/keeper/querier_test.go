@@ -30,6 +30,9 @@ func TestNewQuerier(t *testing.T) {
 	_, err = querier(ctx, []string{types.QueryAnnualProvisions}, query)
 	require.NoError(t, err)
 
+	_, err = querier(ctx, []string{types.QueryDistributionProportions}, query)
+	require.NoError(t, err)
+
 	_, err = querier(ctx, []string{"foo"}, query)
 	require.Error(t, err)
 }
@@ -78,3 +81,18 @@ func TestQueryAnnualProvisions(t *testing.T) {
 
 	require.Equal(t, app.MintKeeper.GetMinter(ctx).AnnualProvisions, annualProvisions)
 }
+
+func TestQueryDistributionProportions(t *testing.T) {
+	app, ctx := createTestApp(true)
+	querier := keep.NewQuerier(app.MintKeeper)
+
+	var proportions types.DistributionProportions
+
+	res, sdkErr := querier(ctx, []string{types.QueryDistributionProportions}, abci.RequestQuery{})
+	require.NoError(t, sdkErr)
+
+	err := app.Codec().UnmarshalJSON(res, &proportions)
+	require.NoError(t, err)
+
+	require.Equal(t, app.MintKeeper.GetParams(ctx).DistributionProportions, proportions)
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DistributionProportions defines the proportions of the minted coins that are
+// distributed to each destination. The proportions must sum to one.
+type DistributionProportions struct {
+	Staking          sdk.Dec `json:"staking" yaml:"staking"`                     // proportion sent to the fee collector as staking rewards
+	CommunityPool    sdk.Dec `json:"community_pool" yaml:"community_pool"`       // proportion sent to the community pool
+	DeveloperRewards sdk.Dec `json:"developer_rewards" yaml:"developer_rewards"` // proportion sent to the developer vesting module account
+}
+
+// NewDistributionProportions creates a new DistributionProportions instance.
+func NewDistributionProportions(staking, communityPool, developerRewards sdk.Dec) DistributionProportions {
+	return DistributionProportions{
+		Staking:          staking,
+		CommunityPool:    communityPool,
+		DeveloperRewards: developerRewards,
+	}
+}
+
+// DefaultDistributionProportions sends every minted coin to the fee collector
+// as staking rewards.
+func DefaultDistributionProportions() DistributionProportions {
+	return NewDistributionProportions(sdk.OneDec(), sdk.ZeroDec(), sdk.ZeroDec())
+}
+
+// Validate checks that every proportion is set and non-negative and that the
+// proportions sum to one.
+func (dp DistributionProportions) Validate() error {
+	if dp.Staking.IsNil() || dp.CommunityPool.IsNil() || dp.DeveloperRewards.IsNil() {
+		return fmt.Errorf("distribution proportions must be set: %+v", dp)
+	}
+	if dp.Staking.IsNegative() {
+		return fmt.Errorf("staking distribution proportion cannot be negative: %s", dp.Staking)
+	}
+	if dp.CommunityPool.IsNegative() {
+		return fmt.Errorf("community pool distribution proportion cannot be negative: %s", dp.CommunityPool)
+	}
+	if dp.DeveloperRewards.IsNegative() {
+		return fmt.Errorf("developer rewards distribution proportion cannot be negative: %s", dp.DeveloperRewards)
+	}
+
+	total := dp.Staking.Add(dp.CommunityPool).Add(dp.DeveloperRewards)
+	if !total.Equal(sdk.OneDec()) {
+		return fmt.Errorf("distribution proportions must sum to one, got %s", total)
+	}
+
+	return nil
+}
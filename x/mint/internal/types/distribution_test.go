@@ -0,0 +1,33 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestDistributionProportionsValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		proportions DistributionProportions
+		expectPass  bool
+	}{
+		{"default", DefaultDistributionProportions(), true},
+		{"split", NewDistributionProportions(sdk.NewDecWithPrec(8, 1), sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(1, 1)), true},
+		{"unset", DistributionProportions{}, false},
+		{"negative", NewDistributionProportions(sdk.NewDecWithPrec(11, 1), sdk.NewDecWithPrec(-1, 1), sdk.ZeroDec()), false},
+		{"sum below one", NewDistributionProportions(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(1, 1), sdk.ZeroDec()), false},
+		{"sum above one", NewDistributionProportions(sdk.OneDec(), sdk.NewDecWithPrec(1, 1), sdk.ZeroDec()), false},
+	}
+
+	for _, tc := range tests {
+		err := tc.proportions.Validate()
+		if tc.expectPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
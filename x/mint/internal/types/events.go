@@ -2,9 +2,16 @@ package types
 
 // Minting module event types
 const (
-	EventTypeMint = ModuleName
+	EventTypeMint             = ModuleName
+	EventTypeMintDistribution = "mint_distribution"
 
 	AttributeKeyBondedRatio      = "bonded_ratio"
 	AttributeKeyInflation        = "inflation"
 	AttributeKeyAnnualProvisions = "annual_provisions"
+	AttributeKeyDestination      = "destination"
+	AttributeKeyRecipient        = "recipient"
+
+	AttributeValueStaking          = "staking"
+	AttributeValueCommunityPool    = "community_pool"
+	AttributeValueDeveloperRewards = "developer_rewards"
 )
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InflationCalculationFn defines the function required to calculate the
+// annual inflation rate applied by the mint BeginBlocker. It receives the
+// current minter, the module parameters, the bonded ratio, the total supply of
+// the mint denomination and the number of blocks since the inflation rate was
+// last recalculated.
+type InflationCalculationFn func(
+	ctx sdk.Context, minter Minter, params Params, bondedRatio sdk.Dec, totalSupply sdk.Int, blocks int64,
+) sdk.Dec
+
+// DefaultInflationCalculationFn is the default inflation schedule. It targets
+// the GoalBonded ratio by adjusting the inflation rate between InflationMin and
+// InflationMax, see Minter.NextInflationRateOverBlocks.
+func DefaultInflationCalculationFn(
+	_ sdk.Context, minter Minter, params Params, bondedRatio sdk.Dec, _ sdk.Int, blocks int64,
+) sdk.Dec {
+	return minter.NextInflationRateOverBlocks(params, bondedRatio, blocks)
+}
+
+// NewHalvingInflationCalculationFn returns an inflation schedule with a fixed
+// annual inflation rate that halves every halvingYears years. Years are
+// derived from the block height and the BlocksPerYear parameter.
+func NewHalvingInflationCalculationFn(initialInflation sdk.Dec, halvingYears uint64) InflationCalculationFn {
+	if initialInflation.IsNegative() {
+		panic(fmt.Sprintf("initial inflation cannot be negative: %s", initialInflation))
+	}
+	if halvingYears == 0 {
+		panic("halving period must be positive")
+	}
+
+	return func(ctx sdk.Context, _ Minter, params Params, _ sdk.Dec, _ sdk.Int, _ int64) sdk.Dec {
+		halvingBlocks := params.BlocksPerYear * halvingYears
+		halvings := uint64(ctx.BlockHeight()) / halvingBlocks
+
+		// after 64 halvings the inflation is negligible for any sane initial rate
+		if halvings >= 64 {
+			return sdk.ZeroDec()
+		}
+
+		return initialInflation.QuoInt64(int64(1) << halvings)
+	}
+}
+
+// NewMaxSupplyInflationCalculationFn returns an inflation schedule that wraps
+// the given schedule and caps the inflation rate so that the annual provisions
+// never exceed the remaining supply below maxSupply. Once the total supply
+// reaches maxSupply no more tokens are minted.
+func NewMaxSupplyInflationCalculationFn(maxSupply sdk.Int, fn InflationCalculationFn) InflationCalculationFn {
+	if !maxSupply.IsPositive() {
+		panic(fmt.Sprintf("max supply must be positive: %s", maxSupply))
+	}
+
+	return func(ctx sdk.Context, minter Minter, params Params, bondedRatio sdk.Dec, totalSupply sdk.Int, blocks int64) sdk.Dec {
+		inflation := fn(ctx, minter, params, bondedRatio, totalSupply, blocks)
+
+		if totalSupply.GTE(maxSupply) {
+			return sdk.ZeroDec()
+		}
+		if totalSupply.IsZero() {
+			return inflation
+		}
+
+		// (maxSupply - totalSupply) / totalSupply
+		maxInflation := maxSupply.Sub(totalSupply).ToDec().QuoInt(totalSupply)
+		return sdk.MinDec(inflation, maxInflation)
+	}
+}
@@ -0,0 +1,79 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestDefaultInflationCalculationFn(t *testing.T) {
+	minter := DefaultInitialMinter()
+	params := DefaultParams()
+	bondedRatio := sdk.NewDecWithPrec(5, 1)
+
+	inflation := DefaultInflationCalculationFn(sdk.Context{}, minter, params, bondedRatio, sdk.NewInt(1000), 1)
+	require.Equal(t, minter.NextInflationRate(params, bondedRatio), inflation)
+
+	inflation = DefaultInflationCalculationFn(sdk.Context{}, minter, params, bondedRatio, sdk.NewInt(1000), 10)
+	require.Equal(t, minter.NextInflationRateOverBlocks(params, bondedRatio, 10), inflation)
+}
+
+func TestHalvingInflationCalculationFn(t *testing.T) {
+	params := DefaultParams()
+	params.BlocksPerYear = 100
+	ctx := sdk.NewContext(nil, abci.Header{}, false, nil)
+
+	fn := NewHalvingInflationCalculationFn(sdk.NewDecWithPrec(20, 2), 2)
+
+	tests := []struct {
+		height    int64
+		inflation sdk.Dec
+	}{
+		{0, sdk.NewDecWithPrec(20, 2)},
+		{199, sdk.NewDecWithPrec(20, 2)},
+		{200, sdk.NewDecWithPrec(10, 2)},
+		{399, sdk.NewDecWithPrec(10, 2)},
+		{400, sdk.NewDecWithPrec(5, 2)},
+		{200 * 64, sdk.ZeroDec()},
+	}
+
+	for i, tc := range tests {
+		ctx = ctx.WithBlockHeight(tc.height)
+		inflation := fn(ctx, DefaultInitialMinter(), params, sdk.ZeroDec(), sdk.NewInt(1000), 1)
+		require.True(t, tc.inflation.Equal(inflation), "test case #%d: expected %s, got %s", i, tc.inflation, inflation)
+	}
+
+	require.Panics(t, func() { NewHalvingInflationCalculationFn(sdk.NewDecWithPrec(-1, 2), 2) })
+	require.Panics(t, func() { NewHalvingInflationCalculationFn(sdk.NewDecWithPrec(20, 2), 0) })
+}
+
+func TestMaxSupplyInflationCalculationFn(t *testing.T) {
+	params := DefaultParams()
+	fixed := func(sdk.Context, Minter, Params, sdk.Dec, sdk.Int, int64) sdk.Dec {
+		return sdk.NewDecWithPrec(10, 2)
+	}
+
+	fn := NewMaxSupplyInflationCalculationFn(sdk.NewInt(1100), fixed)
+
+	tests := []struct {
+		totalSupply sdk.Int
+		inflation   sdk.Dec
+	}{
+		{sdk.NewInt(0), sdk.NewDecWithPrec(10, 2)},    // no supply to compute a cap from
+		{sdk.NewInt(500), sdk.NewDecWithPrec(10, 2)},  // far from the cap
+		{sdk.NewInt(1000), sdk.NewDecWithPrec(10, 2)}, // exactly reaches the cap within a year
+		{sdk.NewInt(1050), sdk.NewDec(50).QuoInt64(1050)},
+		{sdk.NewInt(1100), sdk.ZeroDec()},
+		{sdk.NewInt(1200), sdk.ZeroDec()},
+	}
+
+	for i, tc := range tests {
+		inflation := fn(sdk.Context{}, DefaultInitialMinter(), params, sdk.ZeroDec(), tc.totalSupply, 1)
+		require.True(t, tc.inflation.Equal(inflation), "test case #%d: expected %s, got %s", i, tc.inflation, inflation)
+	}
+
+	require.Panics(t, func() { NewMaxSupplyInflationCalculationFn(sdk.ZeroInt(), fixed) })
+}
@@ -8,6 +8,10 @@ const (
 	// module name
 	ModuleName = "mint"
 
+	// DeveloperVestingModuleAcctName is the name of the module account
+	// receiving the developer rewards share of the minted coins
+	DeveloperVestingModuleAcctName = "developer_vesting"
+
 	// default paramspace for params keeper
 	DefaultParamspace = ModuleName
 
@@ -18,7 +22,8 @@ const (
 	QuerierRoute = StoreKey
 
 	// Query endpoints supported by the minting querier
-	QueryParameters       = "parameters"
-	QueryInflation        = "inflation"
-	QueryAnnualProvisions = "annual_provisions"
+	QueryParameters              = "parameters"
+	QueryInflation               = "inflation"
+	QueryAnnualProvisions        = "annual_provisions"
+	QueryDistributionProportions = "distribution_proportions"
 )
@@ -54,6 +54,23 @@ func TestNextInflation(t *testing.T) {
 	}
 }
 
+func TestNextInflationRateOverBlocks(t *testing.T) {
+	minter := InitialMinter(sdk.NewDecWithPrec(10, 2))
+	params := DefaultParams()
+	bondedRatio := sdk.NewDecWithPrec(5, 1)
+
+	// the rate change of a block is applied once per block
+	change := minter.NextInflationRate(params, bondedRatio).Sub(minter.Inflation)
+	inflation := minter.NextInflationRateOverBlocks(params, bondedRatio, 100)
+	require.True(t, minter.Inflation.Add(change.MulInt64(100)).Equal(inflation))
+
+	// the inflation is still capped between InflationMin and InflationMax
+	inflation = minter.NextInflationRateOverBlocks(params, bondedRatio, int64(params.BlocksPerYear)*10)
+	require.True(t, params.InflationMax.Equal(inflation))
+	inflation = minter.NextInflationRateOverBlocks(params, sdk.OneDec(), int64(params.BlocksPerYear)*10)
+	require.True(t, params.InflationMin.Equal(inflation))
+}
+
 func TestBlockProvision(t *testing.T) {
 	minter := InitialMinter(sdk.NewDecWithPrec(1, 1))
 	params := DefaultParams()
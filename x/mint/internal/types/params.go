@@ -11,12 +11,14 @@ import (
 
 // Parameter store keys
 var (
-	KeyMintDenom           = []byte("MintDenom")
-	KeyInflationRateChange = []byte("InflationRateChange")
-	KeyInflationMax        = []byte("InflationMax")
-	KeyInflationMin        = []byte("InflationMin")
-	KeyGoalBonded          = []byte("GoalBonded")
-	KeyBlocksPerYear       = []byte("BlocksPerYear")
+	KeyMintDenom               = []byte("MintDenom")
+	KeyInflationRateChange     = []byte("InflationRateChange")
+	KeyInflationMax            = []byte("InflationMax")
+	KeyInflationMin            = []byte("InflationMin")
+	KeyGoalBonded              = []byte("GoalBonded")
+	KeyBlocksPerYear           = []byte("BlocksPerYear")
+	KeyDistributionProportions = []byte("DistributionProportions")
+	KeyEpochIdentifier         = []byte("EpochIdentifier")
 )
 
 // mint parameters
@@ -27,6 +29,9 @@ type Params struct {
 	InflationMin        sdk.Dec `json:"inflation_min" yaml:"inflation_min"`                 // minimum inflation rate
 	GoalBonded          sdk.Dec `json:"goal_bonded" yaml:"goal_bonded"`                     // goal of percent bonded atoms
 	BlocksPerYear       uint64  `json:"blocks_per_year" yaml:"blocks_per_year"`             // expected blocks per year
+
+	DistributionProportions DistributionProportions `json:"distribution_proportions" yaml:"distribution_proportions"` // distribution of minted coins
+	EpochIdentifier         string                  `json:"epoch_identifier" yaml:"epoch_identifier"`                 // epoch at the end of which inflation is recalculated, every block if empty
 }
 
 // ParamTable for minting module.
@@ -36,15 +41,18 @@ func ParamKeyTable() params.KeyTable {
 
 func NewParams(
 	mintDenom string, inflationRateChange, inflationMax, inflationMin, goalBonded sdk.Dec, blocksPerYear uint64,
+	distributionProportions DistributionProportions, epochIdentifier string,
 ) Params {
 
 	return Params{
-		MintDenom:           mintDenom,
-		InflationRateChange: inflationRateChange,
-		InflationMax:        inflationMax,
-		InflationMin:        inflationMin,
-		GoalBonded:          goalBonded,
-		BlocksPerYear:       blocksPerYear,
+		MintDenom:               mintDenom,
+		InflationRateChange:     inflationRateChange,
+		InflationMax:            inflationMax,
+		InflationMin:            inflationMin,
+		GoalBonded:              goalBonded,
+		BlocksPerYear:           blocksPerYear,
+		DistributionProportions: distributionProportions,
+		EpochIdentifier:         epochIdentifier,
 	}
 }
 
@@ -57,6 +65,8 @@ func DefaultParams() Params {
 		InflationMin:        sdk.NewDecWithPrec(7, 2),
 		GoalBonded:          sdk.NewDecWithPrec(67, 2),
 		BlocksPerYear:       uint64(60 * 60 * 8766 / 5), // assuming 5 second block times
+
+		DistributionProportions: DefaultDistributionProportions(),
 	}
 }
 
@@ -80,6 +90,12 @@ func (p Params) Validate() error {
 	if err := validateBlocksPerYear(p.BlocksPerYear); err != nil {
 		return err
 	}
+	if err := validateDistributionProportions(p.DistributionProportions); err != nil {
+		return err
+	}
+	if err := validateEpochIdentifier(p.EpochIdentifier); err != nil {
+		return err
+	}
 	if p.InflationMax.LT(p.InflationMin) {
 		return fmt.Errorf(
 			"max inflation (%s) must be greater than or equal to min inflation (%s)",
@@ -99,9 +115,16 @@ func (p Params) String() string {
   Inflation Min:          %s
   Goal Bonded:            %s
   Blocks Per Year:        %d
+  Distribution Proportions:
+    Staking:              %s
+    Community Pool:       %s
+    Developer Rewards:    %s
+  Epoch Identifier:       %s
 `,
 		p.MintDenom, p.InflationRateChange, p.InflationMax,
 		p.InflationMin, p.GoalBonded, p.BlocksPerYear,
+		p.DistributionProportions.Staking, p.DistributionProportions.CommunityPool,
+		p.DistributionProportions.DeveloperRewards, p.EpochIdentifier,
 	)
 }
 
@@ -114,6 +137,8 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		params.NewParamSetPair(KeyInflationMin, &p.InflationMin, validateInflationMin),
 		params.NewParamSetPair(KeyGoalBonded, &p.GoalBonded, validateGoalBonded),
 		params.NewParamSetPair(KeyBlocksPerYear, &p.BlocksPerYear, validateBlocksPerYear),
+		params.NewParamSetPair(KeyDistributionProportions, &p.DistributionProportions, validateDistributionProportions),
+		params.NewParamSetPair(KeyEpochIdentifier, &p.EpochIdentifier, validateEpochIdentifier),
 	}
 }
 
@@ -209,3 +234,25 @@ func validateBlocksPerYear(i interface{}) error {
 
 	return nil
 }
+
+func validateDistributionProportions(i interface{}) error {
+	v, ok := i.(DistributionProportions)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return v.Validate()
+}
+
+func validateEpochIdentifier(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v != strings.TrimSpace(v) {
+		return fmt.Errorf("epoch identifier cannot have surrounding whitespace: %q", v)
+	}
+
+	return nil
+}
@@ -75,13 +75,22 @@ type AppModule struct {
 	AppModuleBasic
 
 	keeper Keeper
+
+	// inflationCalculator is used to calculate the inflation rate during BeginBlock.
+	inflationCalculator InflationCalculationFn
 }
 
-// NewAppModule creates a new AppModule object
-func NewAppModule(keeper Keeper) AppModule {
+// NewAppModule creates a new AppModule object. If a nil InflationCalculationFn
+// is provided, DefaultInflationCalculationFn is used.
+func NewAppModule(keeper Keeper, ic InflationCalculationFn) AppModule {
+	if ic == nil {
+		ic = DefaultInflationCalculationFn
+	}
+
 	return AppModule{
-		AppModuleBasic: AppModuleBasic{},
-		keeper:         keeper,
+		AppModuleBasic:      AppModuleBasic{},
+		keeper:              keeper,
+		inflationCalculator: ic,
 	}
 }
 
@@ -127,7 +136,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
 
 // BeginBlock returns the begin blocker for the mint module.
 func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
-	BeginBlocker(ctx, am.keeper)
+	BeginBlocker(ctx, am.keeper, am.inflationCalculator)
 }
 
 // EndBlock returns the end blocker for the mint module. It returns no validator
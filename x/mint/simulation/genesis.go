@@ -20,6 +20,9 @@ const (
 	InflationMax        = "inflation_max"
 	InflationMin        = "inflation_min"
 	GoalBonded          = "goal_bonded"
+
+	DistributionProportions = "distribution_proportions"
+	EpochIdentifier         = "epoch_identifier"
 )
 
 // GenInflation randomized Inflation
@@ -47,6 +50,24 @@ func GenGoalBonded(r *rand.Rand) sdk.Dec {
 	return sdk.NewDecWithPrec(67, 2)
 }
 
+// GenDistributionProportions randomized DistributionProportions
+func GenDistributionProportions(r *rand.Rand) types.DistributionProportions {
+	communityPool := sdk.NewDecWithPrec(int64(r.Intn(26)), 2)
+	developerRewards := sdk.NewDecWithPrec(int64(r.Intn(26)), 2)
+	staking := sdk.OneDec().Sub(communityPool).Sub(developerRewards)
+
+	return types.NewDistributionProportions(staking, communityPool, developerRewards)
+}
+
+// GenEpochIdentifier randomized EpochIdentifier, either recalculating inflation
+// every block or at the end of the block based epoch of the epochs simulation
+func GenEpochIdentifier(r *rand.Rand) string {
+	if r.Intn(2) == 0 {
+		return ""
+	}
+	return "blocks"
+}
+
 // RandomizedGenState generates a random GenesisState for mint
 func RandomizedGenState(simState *module.SimulationState) {
 	// minter
@@ -81,9 +102,24 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { goalBonded = GenGoalBonded(r) },
 	)
 
+	var distributionProportions types.DistributionProportions
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DistributionProportions, &distributionProportions, simState.Rand,
+		func(r *rand.Rand) { distributionProportions = GenDistributionProportions(r) },
+	)
+
+	var epochIdentifier string
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, EpochIdentifier, &epochIdentifier, simState.Rand,
+		func(r *rand.Rand) { epochIdentifier = GenEpochIdentifier(r) },
+	)
+
 	mintDenom := sdk.DefaultBondDenom
 	blocksPerYear := uint64(60 * 60 * 8766 / 5)
-	params := types.NewParams(mintDenom, inflationRateChange, inflationMax, inflationMin, goalBonded, blocksPerYear)
+	params := types.NewParams(
+		mintDenom, inflationRateChange, inflationMax, inflationMin, goalBonded, blocksPerYear,
+		distributionProportions, epochIdentifier,
+	)
 
 	mintGenesis := types.NewGenesisState(types.InitialMinter(inflation), params)
 
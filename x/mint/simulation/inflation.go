@@ -0,0 +1,28 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/internal/types"
+)
+
+// RandomizedInflationCalculationFn randomly selects one of the built-in
+// inflation schedules with randomized arguments, so that simulations can
+// exercise the mint BeginBlocker under every schedule.
+func RandomizedInflationCalculationFn(r *rand.Rand) types.InflationCalculationFn {
+	switch r.Intn(3) {
+	case 0:
+		return types.NewHalvingInflationCalculationFn(GenInflation(r), uint64(r.Intn(4)+1))
+
+	case 1:
+		// cap the supply between 1x and 2x the default simulation supply
+		maxSupply := sdk.TokensFromConsensusPower(int64(r.Intn(1000000) + 1000000))
+		return types.NewMaxSupplyInflationCalculationFn(maxSupply, types.DefaultInflationCalculationFn)
+
+	default:
+		return types.DefaultInflationCalculationFn
+	}
+}
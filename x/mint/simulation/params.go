@@ -15,6 +15,8 @@ const (
 	keyInflationMax        = "InflationMax"
 	keyInflationMin        = "InflationMin"
 	keyGoalBonded          = "GoalBonded"
+
+	keyDistributionProportions = "DistributionProportions"
 )
 
 // ParamChanges defines the parameters that can be modified by param change proposals
@@ -41,5 +43,14 @@ func ParamChanges(r *rand.Rand) []simulation.ParamChange {
 				return fmt.Sprintf("\"%s\"", GenGoalBonded(r))
 			},
 		),
+		simulation.NewSimParamChange(types.ModuleName, keyDistributionProportions,
+			func(r *rand.Rand) string {
+				dp := GenDistributionProportions(r)
+				return fmt.Sprintf(
+					`{"staking":"%s","community_pool":"%s","developer_rewards":"%s"}`,
+					dp.Staking, dp.CommunityPool, dp.DeveloperRewards,
+				)
+			},
+		),
 	}
 }
@@ -3,6 +3,7 @@ package slashing
 // nolint
 
 import (
+	"github.com/cosmos/cosmos-sdk/x/slashing/client"
 	"github.com/cosmos/cosmos-sdk/x/slashing/internal/keeper"
 	"github.com/cosmos/cosmos-sdk/x/slashing/internal/types"
 )
@@ -18,15 +19,18 @@ const (
 	QueryParameters             = types.QueryParameters
 	QuerySigningInfo            = types.QuerySigningInfo
 	QuerySigningInfos           = types.QuerySigningInfos
+	ProposalTypeUntombstone     = types.ProposalTypeUntombstone
 
 	EventTypeSlash                 = types.EventTypeSlash
 	EventTypeLiveness              = types.EventTypeLiveness
+	EventTypeUntombstone           = types.EventTypeUntombstone
 	AttributeKeyAddress            = types.AttributeKeyAddress
 	AttributeKeyHeight             = types.AttributeKeyHeight
 	AttributeKeyPower              = types.AttributeKeyPower
 	AttributeKeyReason             = types.AttributeKeyReason
 	AttributeKeyJailed             = types.AttributeKeyJailed
 	AttributeKeyMissedBlocks       = types.AttributeKeyMissedBlocks
+	AttributeKeyRefund             = types.AttributeKeyRefund
 	AttributeValueDoubleSign       = types.AttributeValueDoubleSign
 	AttributeValueMissingSignature = types.AttributeValueMissingSignature
 	AttributeValueCategory         = types.AttributeValueCategory
@@ -36,6 +40,7 @@ var (
 	// functions aliases
 	NewKeeper                                = keeper.NewKeeper
 	NewQuerier                               = keeper.NewQuerier
+	HandleUntombstoneProposal                = keeper.HandleUntombstoneProposal
 	RegisterCodec                            = types.RegisterCodec
 	ErrNoValidatorForAddress                 = types.ErrNoValidatorForAddress
 	ErrBadValidatorAddr                      = types.ErrBadValidatorAddr
@@ -44,6 +49,9 @@ var (
 	ErrMissingSelfDelegation                 = types.ErrMissingSelfDelegation
 	ErrSelfDelegationTooLowToUnjail          = types.ErrSelfDelegationTooLowToUnjail
 	ErrNoSigningInfoFound                    = types.ErrNoSigningInfoFound
+	ErrValidatorNotTombstoned                = types.ErrValidatorNotTombstoned
+	ErrInvalidProposalRefund                 = types.ErrInvalidProposalRefund
+	ErrValidatorRetired                      = types.ErrValidatorRetired
 	NewGenesisState                          = types.NewGenesisState
 	NewMissedBlock                           = types.NewMissedBlock
 	DefaultGenesisState                      = types.DefaultGenesisState
@@ -60,9 +68,11 @@ var (
 	NewQuerySigningInfoParams                = types.NewQuerySigningInfoParams
 	NewQuerySigningInfosParams               = types.NewQuerySigningInfosParams
 	NewValidatorSigningInfo                  = types.NewValidatorSigningInfo
+	NewUntombstoneProposal                   = types.NewUntombstoneProposal
 
 	// variable aliases
 	ModuleCdc                       = types.ModuleCdc
+	ProposalHandler                 = client.ProposalHandler
 	ValidatorSigningInfoKey         = types.ValidatorSigningInfoKey
 	ValidatorMissedBlockBitArrayKey = types.ValidatorMissedBlockBitArrayKey
 	AddrPubkeyRelationKey           = types.AddrPubkeyRelationKey
@@ -86,4 +96,5 @@ type (
 	QuerySigningInfoParams  = types.QuerySigningInfoParams
 	QuerySigningInfosParams = types.QuerySigningInfosParams
 	ValidatorSigningInfo    = types.ValidatorSigningInfo
+	UntombstoneProposal     = types.UntombstoneProposal
 )
@@ -2,6 +2,8 @@ package cli
 
 import (
 	"bufio"
+	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -10,8 +12,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/x/gov"
 	"github.com/cosmos/cosmos-sdk/x/slashing/internal/types"
 )
 
@@ -54,3 +58,70 @@ $ <appcli> tx slashing unjail --from mykey
 		},
 	}
 }
+
+// GetCmdSubmitProposal implements the command to submit an untombstone proposal
+func GetCmdSubmitProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "untombstone [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit an untombstone proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal clearing the tombstone of a validator along with an initial
+deposit. The validator can unjail itself once the proposal passes. An optional refund is paid
+from the community pool to the refund recipient. The proposal details must be supplied via a
+JSON file.
+
+Example:
+$ %s tx gov submit-proposal untombstone <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Untombstone Validator",
+  "description": "The double sign was caused by a documented bug of the signer",
+  "cons_address": "cosmosvalcons1mzp3hdvawnyq5n5xxfkszt7mxvxqk9l2rkaayd",
+  "refund_recipient": "cosmos1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq",
+  "refund_amount": [
+    {
+      "denom": "stake",
+      "amount": "10000"
+    }
+  ],
+  "deposit": [
+    {
+      "denom": "stake",
+      "amount": "10000"
+    }
+  ]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			proposal, err := ParseUntombstoneProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewUntombstoneProposal(
+				proposal.Title, proposal.Description, proposal.ConsAddress,
+				proposal.RefundRecipient, proposal.RefundAmount,
+			)
+
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
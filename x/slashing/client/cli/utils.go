@@ -0,0 +1,36 @@
+package cli
+
+import (
+	"io/ioutil"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type (
+	// UntombstoneProposalJSON defines an UntombstoneProposal with a deposit
+	UntombstoneProposalJSON struct {
+		Title           string          `json:"title" yaml:"title"`
+		Description     string          `json:"description" yaml:"description"`
+		ConsAddress     sdk.ConsAddress `json:"cons_address" yaml:"cons_address"`
+		RefundRecipient sdk.AccAddress  `json:"refund_recipient" yaml:"refund_recipient"`
+		RefundAmount    sdk.Coins       `json:"refund_amount" yaml:"refund_amount"`
+		Deposit         sdk.Coins       `json:"deposit" yaml:"deposit"`
+	}
+)
+
+// ParseUntombstoneProposalJSON reads and parses an UntombstoneProposalJSON from a file.
+func ParseUntombstoneProposalJSON(cdc *codec.Codec, proposalFile string) (UntombstoneProposalJSON, error) {
+	proposal := UntombstoneProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
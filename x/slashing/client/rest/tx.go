@@ -10,6 +10,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
 	"github.com/cosmos/cosmos-sdk/x/slashing/internal/types"
 )
 
@@ -68,3 +70,50 @@ func unjailRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		authclient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+// UntombstoneProposalReq defines an untombstone proposal request body.
+type UntombstoneProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title           string          `json:"title" yaml:"title"`
+	Description     string          `json:"description" yaml:"description"`
+	ConsAddress     sdk.ConsAddress `json:"cons_address" yaml:"cons_address"`
+	RefundRecipient sdk.AccAddress  `json:"refund_recipient" yaml:"refund_recipient"`
+	RefundAmount    sdk.Coins       `json:"refund_amount" yaml:"refund_amount"`
+	Proposer        sdk.AccAddress  `json:"proposer" yaml:"proposer"`
+	Deposit         sdk.Coins       `json:"deposit" yaml:"deposit"`
+}
+
+// ProposalRESTHandler returns a ProposalRESTHandler that exposes the untombstone REST handler with a given sub-route.
+func ProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "untombstone",
+		Handler:  postProposalHandlerFn(cliCtx),
+	}
+}
+
+func postProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req UntombstoneProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewUntombstoneProposal(
+			req.Title, req.Description, req.ConsAddress, req.RefundRecipient, req.RefundAmount,
+		)
+
+		msg := gov.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		authclient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
@@ -3,6 +3,8 @@ package slashing
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/internal/keeper"
 	"github.com/cosmos/cosmos-sdk/x/slashing/internal/types"
 )
 
@@ -39,3 +41,18 @@ func handleMsgUnjail(ctx sdk.Context, msg MsgUnjail, k Keeper) (*sdk.Result, err
 
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
+
+// NewUntombstoneProposalHandler creates a governance handler for the
+// untombstone proposals, refunding from the community pool of the
+// distribution keeper
+func NewUntombstoneProposalHandler(k Keeper, dk types.DistributionKeeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case types.UntombstoneProposal:
+			return keeper.HandleUntombstoneProposal(ctx, k, dk, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", ModuleName, c)
+		}
+	}
+}
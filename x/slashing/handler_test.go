@@ -1,6 +1,7 @@
 package slashing
 
 import (
+	"encoding/binary"
 	"errors"
 	"strings"
 	"testing"
@@ -77,6 +78,45 @@ func TestCannotUnjailUnlessMeetMinSelfDelegation(t *testing.T) {
 	require.True(t, errors.Is(ErrSelfDelegationTooLowToUnjail, err))
 }
 
+func TestCannotUnjailRetiredValidator(t *testing.T) {
+	// initial setup
+	ctx, bk, sk, _, keeper := slashingkeeper.CreateTestInput(t, DefaultParams())
+	slh := NewHandler(keeper)
+	amt := sdk.TokensFromConsensusPower(100)
+	addr, val := slashingkeeper.Addrs[0], slashingkeeper.Pks[0]
+
+	msg := slashingkeeper.NewTestMsgCreateValidator(addr, val, amt)
+	res, err := staking.NewHandler(sk)(ctx, msg)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// more delegations than can be unbonded in a block, sorted before the self-delegation
+	bondDenom := sk.GetParams(ctx).BondDenom
+	for i := 0; i < staking.MaxRetirementUnbondingsPerBlock+1; i++ {
+		delAddr := make(sdk.AccAddress, sdk.AddrLen)
+		binary.BigEndian.PutUint32(delAddr[sdk.AddrLen-4:], uint32(i))
+		require.NoError(t, bk.SendCoins(ctx, sdk.AccAddress(slashingkeeper.Addrs[1]), delAddr, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1))))
+
+		res, err = staking.NewHandler(sk)(ctx, staking.NewMsgDelegate(delAddr, addr, sdk.NewInt64Coin(bondDenom, 1)))
+		require.NoError(t, err)
+		require.NotNil(t, res)
+	}
+	staking.EndBlocker(ctx, sk)
+
+	// the self-delegation is left to the following blocks
+	res, err = staking.NewHandler(sk)(ctx, staking.NewMsgRetireValidator(addr))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	require.True(t, sk.Validator(ctx, addr).IsRetired())
+	require.NotNil(t, sk.Delegation(ctx, sdk.AccAddress(addr), addr))
+
+	// assert retired validator can't be unjailed
+	res, err = slh(ctx, NewMsgUnjail(addr))
+	require.Error(t, err)
+	require.Nil(t, res)
+	require.True(t, errors.Is(ErrValidatorRetired, err))
+}
+
 func TestJailedValidatorDelegations(t *testing.T) {
 	ctx, _, stakingKeeper, _, slashingKeeper := slashingkeeper.CreateTestInput(t, DefaultParams())
 
@@ -97,7 +137,7 @@ func TestJailedValidatorDelegations(t *testing.T) {
 	staking.EndBlocker(ctx, stakingKeeper)
 
 	// set dummy signing info
-	newInfo := NewValidatorSigningInfo(consAddr, 0, 0, time.Unix(0, 0), false, 0)
+	newInfo := NewValidatorSigningInfo(consAddr, 0, 0, time.Unix(0, 0), false, 0, 0, time.Unix(0, 0))
 	slashingKeeper.SetValidatorSigningInfo(ctx, consAddr, newInfo)
 
 	// delegate tokens to the validator
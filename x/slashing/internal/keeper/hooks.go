@@ -21,6 +21,8 @@ func (k Keeper) AfterValidatorBonded(ctx sdk.Context, address sdk.ConsAddress, _
 			time.Unix(0, 0),
 			false,
 			0,
+			0,
+			time.Unix(0, 0),
 		)
 		k.SetValidatorSigningInfo(ctx, address, signingInfo)
 	}
@@ -37,6 +39,38 @@ func (k Keeper) AfterValidatorRemoved(ctx sdk.Context, address sdk.ConsAddress)
 	k.deleteAddrPubkeyRelation(ctx, crypto.Address(address))
 }
 
+// When a validator's consensus pubkey is rotated, add the address-pubkey
+// relation for the new key and carry the signing info over to it.
+func (k Keeper) AfterConsensusPubKeyRotated(ctx sdk.Context, oldPubKey, newPubKey crypto.PubKey) {
+	k.AddPubkey(ctx, newPubKey)
+
+	oldConsAddr := sdk.ConsAddress(oldPubKey.Address())
+	newConsAddr := sdk.ConsAddress(newPubKey.Address())
+
+	signingInfo, found := k.GetValidatorSigningInfo(ctx, oldConsAddr)
+	if !found {
+		return
+	}
+
+	signingInfo.Address = newConsAddr
+	k.SetValidatorSigningInfo(ctx, newConsAddr, signingInfo)
+	k.copyValidatorMissedBlockBitArray(ctx, oldConsAddr, newConsAddr)
+}
+
+// When a validator is retired, reset its liveness tracking so that its
+// consensus key starts afresh if it is used by a new validator.
+func (k Keeper) AfterValidatorRetired(ctx sdk.Context, consAddr sdk.ConsAddress) {
+	signingInfo, found := k.GetValidatorSigningInfo(ctx, consAddr)
+	if !found {
+		return
+	}
+
+	signingInfo.IndexOffset = 0
+	signingInfo.MissedBlocksCounter = 0
+	k.SetValidatorSigningInfo(ctx, consAddr, signingInfo)
+	k.clearValidatorMissedBlockBitArray(ctx, consAddr)
+}
+
 //_________________________________________________________________________________________
 
 // Hooks wrapper struct for slashing keeper
@@ -66,6 +100,16 @@ func (h Hooks) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) {
 	h.k.AfterValidatorCreated(ctx, valAddr)
 }
 
+// Implements sdk.ValidatorHooks
+func (h Hooks) AfterConsensusPubKeyRotated(ctx sdk.Context, oldPubKey, newPubKey crypto.PubKey, _ sdk.ValAddress) {
+	h.k.AfterConsensusPubKeyRotated(ctx, oldPubKey, newPubKey)
+}
+
+// Implements sdk.ValidatorHooks
+func (h Hooks) AfterValidatorRetired(ctx sdk.Context, consAddr sdk.ConsAddress, _ sdk.ValAddress) {
+	h.k.AfterValidatorRetired(ctx, consAddr)
+}
+
 // nolint - unused hooks
 func (h Hooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress)  {}
 func (h Hooks) BeforeValidatorModified(_ sdk.Context, _ sdk.ValAddress)                          {}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/tendermint/tendermint/crypto"
 
@@ -75,6 +76,15 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr crypto.Address, p
 			logger.Info(fmt.Sprintf("Validator %s past min height of %d and below signed blocks threshold of %d",
 				consAddr, minHeight, k.MinSignedPerWindow(ctx)))
 
+			// Repeat offenders are slashed and jailed longer for each prior offense
+			// within the offense window of the next one
+			priorOffenses := int64(0)
+			offenseWindow := k.DowntimeOffenseWindow(ctx)
+			if offenseWindow > 0 && !ctx.BlockHeader().Time.After(signInfo.LastDowntimeOffense.Add(offenseWindow)) {
+				priorOffenses = signInfo.DowntimeOffenses
+			}
+			slashFraction, jailDuration := k.downtimePenalty(ctx, priorOffenses)
+
 			// We need to retrieve the stake distribution which signed the block, so we subtract ValidatorUpdateDelay from the evidence height,
 			// and subtract an additional 1 since this is the LastCommit.
 			// Note that this *can* result in a negative "distributionHeight" up to -ValidatorUpdateDelay-1,
@@ -91,10 +101,12 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr crypto.Address, p
 					sdk.NewAttribute(types.AttributeKeyJailed, consAddr.String()),
 				),
 			)
-			k.sk.Slash(ctx, consAddr, distributionHeight, power, k.SlashFractionDowntime(ctx))
+			k.sk.Slash(ctx, consAddr, distributionHeight, power, slashFraction)
 			k.sk.Jail(ctx, consAddr)
 
-			signInfo.JailedUntil = ctx.BlockHeader().Time.Add(k.DowntimeJailDuration(ctx))
+			signInfo.JailedUntil = ctx.BlockHeader().Time.Add(jailDuration)
+			signInfo.DowntimeOffenses = priorOffenses + 1
+			signInfo.LastDowntimeOffense = ctx.BlockHeader().Time
 
 			// We need to reset the counter & array so that the validator won't be immediately slashed for downtime upon rebonding.
 			signInfo.MissedBlocksCounter = 0
@@ -111,3 +123,16 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr crypto.Address, p
 	// Set the updated signing info
 	k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
 }
+
+// downtimePenalty returns the slash fraction and jail duration of a downtime
+// offense, increased once per prior repeat offense. The slash fraction is
+// capped at one.
+func (k Keeper) downtimePenalty(ctx sdk.Context, priorOffenses int64) (sdk.Dec, time.Duration) {
+	slashFraction := k.SlashFractionDowntime(ctx).Add(k.SlashFractionDowntimeIncrement(ctx).MulInt64(priorOffenses))
+	if slashFraction.GT(sdk.OneDec()) {
+		slashFraction = sdk.OneDec()
+	}
+
+	jailDuration := k.DowntimeJailDuration(ctx) + time.Duration(priorOffenses)*k.DowntimeJailDurationIncrement(ctx)
+	return slashFraction, jailDuration
+}
@@ -221,3 +221,156 @@ func TestValidatorDippingInAndOut(t *testing.T) {
 	require.Equal(t, sdk.Unbonding, validator.Status)
 
 }
+
+// Test a validator rotating its consensus pubkey
+// Ensure that the signing info is carried over to the new key and that
+// infractions committed with the old key apply to the new one
+func TestValidatorConsPubKeyRotation(t *testing.T) {
+	ctx, _, sk, _, keeper := CreateTestInput(t, TestParams())
+	addr, oldPk, newPk := Addrs[0], Pks[0], Pks[1]
+	amt := sdk.TokensFromConsensusPower(100)
+	sh := staking.NewHandler(sk)
+
+	res, err := sh(ctx, NewTestMsgCreateValidator(addr, oldPk, amt))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	staking.EndBlocker(ctx, sk)
+
+	// miss a block with the old key
+	ctx = ctx.WithBlockHeight(1)
+	keeper.HandleValidatorSignature(ctx, oldPk.Address(), amt.Int64(), false)
+
+	res, err = sh(ctx, staking.NewMsgRotateConsPubKey(addr, newPk))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	oldConsAddr, newConsAddr := sdk.ConsAddress(oldPk.Address()), sdk.ConsAddress(newPk.Address())
+	oldInfo, found := keeper.GetValidatorSigningInfo(ctx, oldConsAddr)
+	require.True(t, found)
+	newInfo, found := keeper.GetValidatorSigningInfo(ctx, newConsAddr)
+	require.True(t, found)
+	require.Equal(t, newConsAddr, newInfo.Address)
+	require.Equal(t, oldInfo.IndexOffset, newInfo.IndexOffset)
+	require.Equal(t, int64(1), newInfo.MissedBlocksCounter)
+	require.True(t, keeper.GetValidatorMissedBlockBitArray(ctx, newConsAddr, 0))
+
+	pk, err := keeper.GetPubkey(ctx, newPk.Address())
+	require.NoError(t, err)
+	require.Equal(t, newPk, pk)
+
+	// evidence against the old key jails and tombstones the new key as well
+	jailTime := time.Unix(1000, 0).UTC()
+	keeper.JailUntil(ctx, oldConsAddr, jailTime)
+	newInfo, _ = keeper.GetValidatorSigningInfo(ctx, newConsAddr)
+	require.Equal(t, jailTime, newInfo.JailedUntil)
+
+	keeper.Tombstone(ctx, oldConsAddr)
+	require.True(t, keeper.IsTombstoned(ctx, newConsAddr))
+}
+
+// Test a validator being retired
+// Ensure that its liveness tracking is reset
+func TestRetiredValidatorSigningInfo(t *testing.T) {
+	ctx, _, sk, _, keeper := CreateTestInput(t, TestParams())
+	addr, pk := Addrs[0], Pks[0]
+	amt := sdk.TokensFromConsensusPower(100)
+	sh := staking.NewHandler(sk)
+
+	res, err := sh(ctx, NewTestMsgCreateValidator(addr, pk, amt))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	staking.EndBlocker(ctx, sk)
+
+	ctx = ctx.WithBlockHeight(1)
+	keeper.HandleValidatorSignature(ctx, pk.Address(), amt.Int64(), false)
+
+	consAddr := sdk.ConsAddress(pk.Address())
+	info, found := keeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, int64(1), info.MissedBlocksCounter)
+
+	res, err = sh(ctx, staking.NewMsgRetireValidator(addr))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	info, found = keeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, int64(0), info.IndexOffset)
+	require.Equal(t, int64(0), info.MissedBlocksCounter)
+	require.False(t, keeper.GetValidatorMissedBlockBitArray(ctx, consAddr, 0))
+
+	// the retired validator cannot be unjailed
+	require.Error(t, keeper.Unjail(ctx, addr))
+}
+
+// Test a validator being down repeatedly
+// Ensure that the repeat offenses within the offense window are slashed and
+// jailed progressively more
+func TestHandleRepeatDowntime(t *testing.T) {
+	params := TestParams()
+	params.SignedBlocksWindow = 100
+	params.DowntimeJailDuration = time.Hour
+	params.DowntimeOffenseWindow = 24 * time.Hour
+	params.SlashFractionDowntimeIncrement = sdk.NewDecWithPrec(1, 2)
+	params.DowntimeJailDurationIncrement = time.Hour
+
+	ctx, _, sk, _, keeper := CreateTestInput(t, params)
+	power := int64(100)
+	amt := sdk.TokensFromConsensusPower(power)
+	addr, val := Addrs[0], Pks[0]
+	consAddr := sdk.ConsAddress(val.Address())
+	sh := staking.NewHandler(sk)
+	res, err := sh(ctx, NewTestMsgCreateValidator(addr, val, amt))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	staking.EndBlocker(ctx, sk)
+
+	// miss blocks until the validator is jailed for downtime
+	height := int64(0)
+	downtime := func() {
+		for !sk.Validator(ctx, addr).IsJailed() {
+			ctx = ctx.WithBlockHeight(height)
+			keeper.HandleValidatorSignature(ctx, val.Address(), power, false)
+			height++
+		}
+		staking.EndBlocker(ctx, sk)
+	}
+	unjail := func(blockTime time.Time) {
+		ctx = ctx.WithBlockTime(blockTime)
+		require.NoError(t, keeper.Unjail(ctx, addr))
+		staking.EndBlocker(ctx, sk)
+	}
+
+	// first offense
+	start := time.Unix(0, 0).UTC()
+	ctx = ctx.WithBlockTime(start)
+	downtime()
+
+	info, found := keeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, int64(1), info.DowntimeOffenses)
+	require.Equal(t, start, info.LastDowntimeOffense)
+	require.Equal(t, start.Add(time.Hour), info.JailedUntil)
+	require.Equal(t, amt.Sub(sdk.TokensFromConsensusPower(1)), sk.Validator(ctx, addr).GetTokens())
+
+	// second offense within the offense window of the first one
+	unjail(info.JailedUntil)
+	downtime()
+
+	info, found = keeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, int64(2), info.DowntimeOffenses)
+	require.Equal(t, start.Add(3*time.Hour), info.JailedUntil)
+	require.Equal(t, amt.Sub(sdk.TokensFromConsensusPower(3)), sk.Validator(ctx, addr).GetTokens())
+
+	// third offense after the offense window of the second one
+	unjail(start.Add(48 * time.Hour))
+	downtime()
+
+	info, found = keeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, int64(1), info.DowntimeOffenses)
+	require.Equal(t, start.Add(49*time.Hour), info.JailedUntil)
+	require.Equal(t, amt.Sub(sdk.TokensFromConsensusPower(4)), sk.Validator(ctx, addr).GetTokens())
+}
@@ -42,6 +42,27 @@ func (k Keeper) SlashFractionDowntime(ctx sdk.Context) (res sdk.Dec) {
 	return
 }
 
+// DowntimeOffenseWindow - window after a downtime offense within which a new
+// one is a repeat offense
+func (k Keeper) DowntimeOffenseWindow(ctx sdk.Context) (res time.Duration) {
+	k.paramspace.Get(ctx, types.KeyDowntimeOffenseWindow, &res)
+	return
+}
+
+// SlashFractionDowntimeIncrement - increase of the downtime slash fraction per
+// prior repeat offense
+func (k Keeper) SlashFractionDowntimeIncrement(ctx sdk.Context) (res sdk.Dec) {
+	k.paramspace.Get(ctx, types.KeySlashFractionDowntimeIncrement, &res)
+	return
+}
+
+// DowntimeJailDurationIncrement - increase of the downtime jail duration per
+// prior repeat offense
+func (k Keeper) DowntimeJailDurationIncrement(ctx sdk.Context) (res time.Duration) {
+	k.paramspace.Get(ctx, types.KeyDowntimeJailDurationIncrement, &res)
+	return
+}
+
 // GetParams returns the total set of slashing parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramspace.GetParamSet(ctx, &params)
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/internal/types"
+)
+
+// HandleUntombstoneProposal is a handler for executing a passed untombstone
+// proposal. The refund, if any, is paid from the community pool.
+func HandleUntombstoneProposal(
+	ctx sdk.Context, k Keeper, dk types.DistributionKeeper, p types.UntombstoneProposal,
+) error {
+
+	signInfo, found := k.GetValidatorSigningInfo(ctx, p.ConsAddress)
+	if !found {
+		return types.ErrNoSigningInfoFound
+	}
+	if !signInfo.Tombstoned {
+		return types.ErrValidatorNotTombstoned
+	}
+
+	if !p.RefundAmount.Empty() {
+		if err := dk.DistributeFromFeePool(ctx, p.RefundAmount, p.RefundRecipient); err != nil {
+			return err
+		}
+	}
+
+	k.Untombstone(ctx, p.ConsAddress)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUntombstone,
+			sdk.NewAttribute(types.AttributeKeyAddress, p.ConsAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyRefund, p.RefundAmount.String()),
+		),
+	)
+
+	logger := k.Logger(ctx)
+	logger.Info(fmt.Sprintf("untombstoned validator %s, refunding %s to %s", p.ConsAddress, p.RefundAmount, p.RefundRecipient))
+	return nil
+}
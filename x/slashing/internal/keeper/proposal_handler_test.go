@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/internal/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+// mockDistributionKeeper records the payments from the community pool
+type mockDistributionKeeper struct {
+	pool     sdk.Coins
+	payments map[string]sdk.Coins
+}
+
+func (dk *mockDistributionKeeper) DistributeFromFeePool(_ sdk.Context, amount sdk.Coins, receiveAddr sdk.AccAddress) error {
+	pool, hasNeg := dk.pool.SafeSub(amount)
+	if hasNeg {
+		return errors.New("community pool does not have sufficient coins")
+	}
+
+	dk.pool = pool
+	dk.payments[receiveAddr.String()] = dk.payments[receiveAddr.String()].Add(amount...)
+	return nil
+}
+
+func TestHandleUntombstoneProposal(t *testing.T) {
+	ctx, _, sk, _, keeper := CreateTestInput(t, TestParams())
+	addr, val := Addrs[0], Pks[0]
+	consAddr := sdk.ConsAddress(val.Address())
+	amt := sdk.TokensFromConsensusPower(100)
+	sh := staking.NewHandler(sk)
+
+	res, err := sh(ctx, NewTestMsgCreateValidator(addr, val, amt))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	staking.EndBlocker(ctx, sk)
+
+	dk := &mockDistributionKeeper{
+		pool:     sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
+		payments: make(map[string]sdk.Coins),
+	}
+	refund := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 60))
+	recipient := sdk.AccAddress(addr)
+	proposal := types.NewUntombstoneProposal("title", "description", consAddr, recipient, refund)
+
+	// the validator is not tombstoned
+	err = HandleUntombstoneProposal(ctx, keeper, dk, proposal)
+	require.True(t, errors.Is(err, types.ErrValidatorNotTombstoned))
+
+	// jail and tombstone the validator as for a double sign
+	sk.Jail(ctx, consAddr)
+	keeper.JailUntil(ctx, consAddr, time.Unix(253402300799, 0))
+	keeper.Tombstone(ctx, consAddr)
+
+	ctx = ctx.WithBlockTime(time.Unix(100, 0).UTC())
+	require.Error(t, keeper.Unjail(ctx, addr))
+
+	require.NoError(t, HandleUntombstoneProposal(ctx, keeper, dk, proposal))
+	require.False(t, keeper.IsTombstoned(ctx, consAddr))
+	require.Equal(t, refund, dk.payments[recipient.String()])
+
+	info, found := keeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, ctx.BlockHeader().Time, info.JailedUntil)
+
+	// the validator can unjail itself
+	require.NoError(t, keeper.Unjail(ctx, addr))
+	require.False(t, sk.Validator(ctx, addr).IsJailed())
+
+	// the refund cannot exceed the community pool
+	keeper.Tombstone(ctx, consAddr)
+	err = HandleUntombstoneProposal(ctx, keeper, dk, proposal)
+	require.Error(t, err)
+	require.True(t, keeper.IsTombstoned(ctx, consAddr))
+}
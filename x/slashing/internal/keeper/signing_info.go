@@ -96,6 +96,15 @@ func (k Keeper) JailUntil(ctx sdk.Context, consAddr sdk.ConsAddress, jailTime ti
 
 	signInfo.JailedUntil = jailTime
 	k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
+
+	// an infraction committed with a rotated key also applies to the key the
+	// validator currently signs with
+	if curConsAddr, ok := k.getRotatedConsAddr(ctx, consAddr); ok {
+		if curInfo, found := k.GetValidatorSigningInfo(ctx, curConsAddr); found {
+			curInfo.JailedUntil = jailTime
+			k.SetValidatorSigningInfo(ctx, curConsAddr, curInfo)
+		}
+	}
 }
 
 // Tombstone attempts to tombstone a validator. It will panic if signing info for
@@ -112,6 +121,43 @@ func (k Keeper) Tombstone(ctx sdk.Context, consAddr sdk.ConsAddress) {
 
 	signInfo.Tombstoned = true
 	k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
+
+	if curConsAddr, ok := k.getRotatedConsAddr(ctx, consAddr); ok {
+		if curInfo, found := k.GetValidatorSigningInfo(ctx, curConsAddr); found && !curInfo.Tombstoned {
+			curInfo.Tombstoned = true
+			k.SetValidatorSigningInfo(ctx, curConsAddr, curInfo)
+		}
+	}
+}
+
+// Untombstone clears the tombstone of a validator, allowing it to unjail once
+// its jail time is over, which is cut short to the current block time. It
+// will panic if signing info for the given validator does not exist.
+func (k Keeper) Untombstone(ctx sdk.Context, consAddr sdk.ConsAddress) {
+	signInfo, ok := k.GetValidatorSigningInfo(ctx, consAddr)
+	if !ok {
+		panic("cannot untombstone validator that does not have any signing information")
+	}
+
+	if !signInfo.Tombstoned {
+		panic("cannot untombstone validator that is not tombstoned")
+	}
+
+	k.setUntombstoned(ctx, consAddr, signInfo)
+
+	if curConsAddr, ok := k.getRotatedConsAddr(ctx, consAddr); ok {
+		if curInfo, found := k.GetValidatorSigningInfo(ctx, curConsAddr); found && curInfo.Tombstoned {
+			k.setUntombstoned(ctx, curConsAddr, curInfo)
+		}
+	}
+}
+
+func (k Keeper) setUntombstoned(ctx sdk.Context, consAddr sdk.ConsAddress, signInfo types.ValidatorSigningInfo) {
+	signInfo.Tombstoned = false
+	if signInfo.JailedUntil.After(ctx.BlockHeader().Time) {
+		signInfo.JailedUntil = ctx.BlockHeader().Time
+	}
+	k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
 }
 
 // IsTombstoned returns if a given validator by consensus address is tombstoned.
@@ -141,3 +187,28 @@ func (k Keeper) clearValidatorMissedBlockBitArray(ctx sdk.Context, address sdk.C
 		store.Delete(iter.Key())
 	}
 }
+
+// getRotatedConsAddr returns the consensus address the validator identified by
+// the given consensus address currently signs with, if its consensus pubkey
+// has been rotated away from that address.
+func (k Keeper) getRotatedConsAddr(ctx sdk.Context, consAddr sdk.ConsAddress) (sdk.ConsAddress, bool) {
+	validator := k.sk.ValidatorByConsAddr(ctx, consAddr)
+	if validator == nil {
+		return nil, false
+	}
+
+	curConsAddr := validator.GetConsAddr()
+	if curConsAddr.Equals(consAddr) {
+		return nil, false
+	}
+	return curConsAddr, true
+}
+
+// copyValidatorMissedBlockBitArray copies the missed blocks array of a
+// validator from one consensus address to another
+func (k Keeper) copyValidatorMissedBlockBitArray(ctx sdk.Context, from, to sdk.ConsAddress) {
+	k.IterateValidatorMissedBlockBitArray(ctx, from, func(index int64, missed bool) (stop bool) {
+		k.SetValidatorMissedBlockBitArray(ctx, to, index, missed)
+		return false
+	})
+}
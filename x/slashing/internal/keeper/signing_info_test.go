@@ -21,6 +21,8 @@ func TestGetSetValidatorSigningInfo(t *testing.T) {
 		time.Unix(2, 0),
 		false,
 		int64(10),
+		int64(0),
+		time.Unix(0, 0),
 	)
 	keeper.SetValidatorSigningInfo(ctx, sdk.ConsAddress(Addrs[0]), newInfo)
 	info, found = keeper.GetValidatorSigningInfo(ctx, sdk.ConsAddress(Addrs[0]))
@@ -52,6 +54,8 @@ func TestTombstoned(t *testing.T) {
 		time.Unix(2, 0),
 		false,
 		int64(10),
+		int64(0),
+		time.Unix(0, 0),
 	)
 	keeper.SetValidatorSigningInfo(ctx, sdk.ConsAddress(Addrs[0]), newInfo)
 
@@ -72,6 +76,8 @@ func TestJailUntil(t *testing.T) {
 		time.Unix(2, 0),
 		false,
 		int64(10),
+		int64(0),
+		time.Unix(0, 0),
 	)
 	keeper.SetValidatorSigningInfo(ctx, sdk.ConsAddress(Addrs[0]), newInfo)
 	keeper.JailUntil(ctx, sdk.ConsAddress(Addrs[0]), time.Unix(253402300799, 0).UTC())
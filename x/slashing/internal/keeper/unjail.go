@@ -13,6 +13,11 @@ func (k Keeper) Unjail(ctx sdk.Context, validatorAddr sdk.ValAddress) error {
 		return types.ErrNoValidatorForAddress
 	}
 
+	// cannot be unjailed if retired
+	if validator.IsRetired() {
+		return types.ErrValidatorRetired
+	}
+
 	// cannot be unjailed if no self-delegation exists
 	selfDel := k.sk.Delegation(ctx, sdk.AccAddress(validatorAddr), validatorAddr)
 	if selfDel == nil {
@@ -13,4 +13,7 @@ var (
 	ErrMissingSelfDelegation        = sdkerrors.Register(ModuleName, 5, "validator has no self-delegation; cannot be unjailed")
 	ErrSelfDelegationTooLowToUnjail = sdkerrors.Register(ModuleName, 6, "validator's self delegation less than minimum; cannot be unjailed")
 	ErrNoSigningInfoFound           = sdkerrors.Register(ModuleName, 7, "no validator signing info found")
+	ErrValidatorNotTombstoned       = sdkerrors.Register(ModuleName, 8, "validator not tombstoned; cannot be untombstoned")
+	ErrInvalidProposalRefund        = sdkerrors.Register(ModuleName, 9, "invalid untombstone proposal refund")
+	ErrValidatorRetired             = sdkerrors.Register(ModuleName, 10, "validator is retired; cannot be unjailed")
 )
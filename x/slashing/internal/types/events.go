@@ -3,8 +3,9 @@ package types
 
 // Slashing module event types
 const (
-	EventTypeSlash    = "slash"
-	EventTypeLiveness = "liveness"
+	EventTypeSlash       = "slash"
+	EventTypeLiveness    = "liveness"
+	EventTypeUntombstone = "untombstone"
 
 	AttributeKeyAddress      = "address"
 	AttributeKeyHeight       = "height"
@@ -12,6 +13,7 @@ const (
 	AttributeKeyReason       = "reason"
 	AttributeKeyJailed       = "jailed"
 	AttributeKeyMissedBlocks = "missed_blocks"
+	AttributeKeyRefund       = "refund"
 
 	AttributeValueDoubleSign       = "double_sign"
 	AttributeValueMissingSignature = "missing_signature"
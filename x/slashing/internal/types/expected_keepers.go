@@ -3,6 +3,8 @@
 package types
 
 import (
+	"github.com/tendermint/tendermint/crypto"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
 	"github.com/cosmos/cosmos-sdk/x/params"
@@ -54,10 +56,18 @@ type StakingKeeper interface {
 	MaxValidators(sdk.Context) uint32
 }
 
+// DistributionKeeper expected distribution keeper (noalias)
+type DistributionKeeper interface {
+	DistributeFromFeePool(ctx sdk.Context, amount sdk.Coins, receiveAddr sdk.AccAddress) error
+}
+
 // StakingHooks event hooks for staking validator object (noalias)
 type StakingHooks interface {
 	AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress)                           // Must be called when a validator is created
 	AfterValidatorRemoved(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) // Must be called when a validator is deleted
 
 	AfterValidatorBonded(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) // Must be called when a validator is bonded
+
+	AfterConsensusPubKeyRotated(ctx sdk.Context, oldPubKey, newPubKey crypto.PubKey, valAddr sdk.ValAddress) // Must be called when a validator's consensus pubkey is rotated
+	AfterValidatorRetired(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress)                 // Must be called when a validator is retired
 }
@@ -76,5 +76,20 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("signed blocks window must be at least 10, is %d", signedWindow)
 	}
 
+	offenseWindow := data.Params.DowntimeOffenseWindow
+	if offenseWindow < 0 {
+		return fmt.Errorf("downtime offense window cannot be negative, is %s", offenseWindow.String())
+	}
+
+	downtimeIncrement := data.Params.SlashFractionDowntimeIncrement
+	if downtimeIncrement.IsNegative() || downtimeIncrement.GT(sdk.OneDec()) {
+		return fmt.Errorf("slashing fraction downtime increment should be less than or equal to one and greater than zero, is %s", downtimeIncrement.String())
+	}
+
+	jailIncrement := data.Params.DowntimeJailDurationIncrement
+	if jailIncrement < 0 {
+		return fmt.Errorf("downtime jail duration increment cannot be negative, is %s", jailIncrement.String())
+	}
+
 	return nil
 }
@@ -13,12 +13,17 @@ const (
 	DefaultParamspace           = ModuleName
 	DefaultSignedBlocksWindow   = int64(100)
 	DefaultDowntimeJailDuration = 60 * 10 * time.Second
+
+	DefaultDowntimeOffenseWindow         time.Duration = 0
+	DefaultDowntimeJailDurationIncrement time.Duration = 0
 )
 
 var (
 	DefaultMinSignedPerWindow      = sdk.NewDecWithPrec(5, 1)
 	DefaultSlashFractionDoubleSign = sdk.NewDec(1).Quo(sdk.NewDec(20))
 	DefaultSlashFractionDowntime   = sdk.NewDec(1).Quo(sdk.NewDec(100))
+
+	DefaultSlashFractionDowntimeIncrement = sdk.ZeroDec()
 )
 
 // Parameter store keys
@@ -28,6 +33,10 @@ var (
 	KeyDowntimeJailDuration    = []byte("DowntimeJailDuration")
 	KeySlashFractionDoubleSign = []byte("SlashFractionDoubleSign")
 	KeySlashFractionDowntime   = []byte("SlashFractionDowntime")
+
+	KeyDowntimeOffenseWindow          = []byte("DowntimeOffenseWindow")
+	KeySlashFractionDowntimeIncrement = []byte("SlashFractionDowntimeIncrement")
+	KeyDowntimeJailDurationIncrement  = []byte("DowntimeJailDurationIncrement")
 )
 
 // ParamKeyTable for slashing module
@@ -42,12 +51,19 @@ type Params struct {
 	DowntimeJailDuration    time.Duration `json:"downtime_jail_duration" yaml:"downtime_jail_duration"`
 	SlashFractionDoubleSign sdk.Dec       `json:"slash_fraction_double_sign" yaml:"slash_fraction_double_sign"`
 	SlashFractionDowntime   sdk.Dec       `json:"slash_fraction_downtime" yaml:"slash_fraction_downtime"`
+
+	// repeat downtime offenses within the offense window of the previous one
+	// are slashed and jailed longer by the increments, once per prior offense
+	DowntimeOffenseWindow          time.Duration `json:"downtime_offense_window" yaml:"downtime_offense_window"`
+	SlashFractionDowntimeIncrement sdk.Dec       `json:"slash_fraction_downtime_increment" yaml:"slash_fraction_downtime_increment"`
+	DowntimeJailDurationIncrement  time.Duration `json:"downtime_jail_duration_increment" yaml:"downtime_jail_duration_increment"`
 }
 
 // NewParams creates a new Params object
 func NewParams(
 	signedBlocksWindow int64, minSignedPerWindow sdk.Dec, downtimeJailDuration time.Duration,
-	slashFractionDoubleSign, slashFractionDowntime sdk.Dec,
+	slashFractionDoubleSign, slashFractionDowntime sdk.Dec, downtimeOffenseWindow time.Duration,
+	slashFractionDowntimeIncrement sdk.Dec, downtimeJailDurationIncrement time.Duration,
 ) Params {
 
 	return Params{
@@ -56,20 +72,28 @@ func NewParams(
 		DowntimeJailDuration:    downtimeJailDuration,
 		SlashFractionDoubleSign: slashFractionDoubleSign,
 		SlashFractionDowntime:   slashFractionDowntime,
+
+		DowntimeOffenseWindow:          downtimeOffenseWindow,
+		SlashFractionDowntimeIncrement: slashFractionDowntimeIncrement,
+		DowntimeJailDurationIncrement:  downtimeJailDurationIncrement,
 	}
 }
 
 // String implements the stringer interface for Params
 func (p Params) String() string {
 	return fmt.Sprintf(`Slashing Params:
-  SignedBlocksWindow:      %d
-  MinSignedPerWindow:      %s
-  DowntimeJailDuration:    %s
-  SlashFractionDoubleSign: %s
-  SlashFractionDowntime:   %s`,
+  SignedBlocksWindow:             %d
+  MinSignedPerWindow:             %s
+  DowntimeJailDuration:           %s
+  SlashFractionDoubleSign:        %s
+  SlashFractionDowntime:          %s
+  DowntimeOffenseWindow:          %s
+  SlashFractionDowntimeIncrement: %s
+  DowntimeJailDurationIncrement:  %s`,
 		p.SignedBlocksWindow, p.MinSignedPerWindow,
 		p.DowntimeJailDuration, p.SlashFractionDoubleSign,
-		p.SlashFractionDowntime)
+		p.SlashFractionDowntime, p.DowntimeOffenseWindow,
+		p.SlashFractionDowntimeIncrement, p.DowntimeJailDurationIncrement)
 }
 
 // ParamSetPairs - Implements params.ParamSet
@@ -80,6 +104,9 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		params.NewParamSetPair(KeyDowntimeJailDuration, &p.DowntimeJailDuration, validateDowntimeJailDuration),
 		params.NewParamSetPair(KeySlashFractionDoubleSign, &p.SlashFractionDoubleSign, validateSlashFractionDoubleSign),
 		params.NewParamSetPair(KeySlashFractionDowntime, &p.SlashFractionDowntime, validateSlashFractionDowntime),
+		params.NewParamSetPair(KeyDowntimeOffenseWindow, &p.DowntimeOffenseWindow, validateDowntimeOffenseWindow),
+		params.NewParamSetPair(KeySlashFractionDowntimeIncrement, &p.SlashFractionDowntimeIncrement, validateSlashFractionDowntimeIncrement),
+		params.NewParamSetPair(KeyDowntimeJailDurationIncrement, &p.DowntimeJailDurationIncrement, validateDowntimeJailDurationIncrement),
 	}
 }
 
@@ -87,7 +114,8 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 func DefaultParams() Params {
 	return NewParams(
 		DefaultSignedBlocksWindow, DefaultMinSignedPerWindow, DefaultDowntimeJailDuration,
-		DefaultSlashFractionDoubleSign, DefaultSlashFractionDowntime,
+		DefaultSlashFractionDoubleSign, DefaultSlashFractionDowntime, DefaultDowntimeOffenseWindow,
+		DefaultSlashFractionDowntimeIncrement, DefaultDowntimeJailDurationIncrement,
 	)
 }
 
@@ -164,3 +192,45 @@ func validateSlashFractionDowntime(i interface{}) error {
 
 	return nil
 }
+
+func validateDowntimeOffenseWindow(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("downtime offense window cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateSlashFractionDowntimeIncrement(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("downtime slash fraction increment cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("downtime slash fraction increment too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateDowntimeJailDurationIncrement(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("downtime jail duration increment cannot be negative: %s", v)
+	}
+
+	return nil
+}
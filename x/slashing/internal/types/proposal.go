@@ -0,0 +1,85 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeUntombstone defines the type for an UntombstoneProposal
+	ProposalTypeUntombstone = "Untombstone"
+)
+
+// Assert UntombstoneProposal implements govtypes.Content at compile-time
+var _ govtypes.Content = UntombstoneProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeUntombstone)
+	govtypes.RegisterProposalTypeCodec(UntombstoneProposal{}, "cosmos-sdk/UntombstoneProposal")
+}
+
+// UntombstoneProposal clears the tombstone of a validator, optionally
+// refunding a part of its slash from the community pool
+type UntombstoneProposal struct {
+	Title           string          `json:"title" yaml:"title"`
+	Description     string          `json:"description" yaml:"description"`
+	ConsAddress     sdk.ConsAddress `json:"cons_address" yaml:"cons_address"`
+	RefundRecipient sdk.AccAddress  `json:"refund_recipient" yaml:"refund_recipient"`
+	RefundAmount    sdk.Coins       `json:"refund_amount" yaml:"refund_amount"`
+}
+
+// NewUntombstoneProposal creates a new untombstone proposal.
+func NewUntombstoneProposal(
+	title, description string, consAddr sdk.ConsAddress, refundRecipient sdk.AccAddress, refundAmount sdk.Coins,
+) UntombstoneProposal {
+
+	return UntombstoneProposal{title, description, consAddr, refundRecipient, refundAmount}
+}
+
+// GetTitle returns the title of an untombstone proposal.
+func (up UntombstoneProposal) GetTitle() string { return up.Title }
+
+// GetDescription returns the description of an untombstone proposal.
+func (up UntombstoneProposal) GetDescription() string { return up.Description }
+
+// ProposalRoute returns the routing key of an untombstone proposal.
+func (up UntombstoneProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an untombstone proposal.
+func (up UntombstoneProposal) ProposalType() string { return ProposalTypeUntombstone }
+
+// ValidateBasic runs basic stateless validity checks
+func (up UntombstoneProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(up)
+	if err != nil {
+		return err
+	}
+	if up.ConsAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing validator consensus address")
+	}
+	if !up.RefundAmount.IsValid() {
+		return sdkerrors.Wrap(ErrInvalidProposalRefund, up.RefundAmount.String())
+	}
+	if !up.RefundAmount.Empty() && up.RefundRecipient.Empty() {
+		return sdkerrors.Wrap(ErrInvalidProposalRefund, "missing refund recipient")
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (up UntombstoneProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Untombstone Proposal:
+  Title:            %s
+  Description:      %s
+  Cons Address:     %s
+  Refund Recipient: %s
+  Refund Amount:    %s
+`, up.Title, up.Description, up.ConsAddress, up.RefundRecipient, up.RefundAmount))
+	return b.String()
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestUntombstoneProposalValidateBasic(t *testing.T) {
+	consAddr := sdk.ConsAddress("consaddr")
+	recipient := sdk.AccAddress("recipient")
+	refund := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+
+	tests := []struct {
+		name      string
+		proposal  UntombstoneProposal
+		expectErr bool
+	}{
+		{"valid", NewUntombstoneProposal("title", "description", consAddr, recipient, refund), false},
+		{"without refund", NewUntombstoneProposal("title", "description", consAddr, nil, nil), false},
+		{"empty title", NewUntombstoneProposal("", "description", consAddr, recipient, refund), true},
+		{"empty cons address", NewUntombstoneProposal("title", "description", nil, recipient, refund), true},
+		{"refund without recipient", NewUntombstoneProposal("title", "description", consAddr, nil, refund), true},
+		{"invalid refund", NewUntombstoneProposal("title", "description", consAddr, recipient, sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.ZeroInt()}}), true},
+	}
+
+	for _, tc := range tests {
+		err := tc.proposal.ValidateBasic()
+		if tc.expectErr {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
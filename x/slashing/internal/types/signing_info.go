@@ -15,12 +15,15 @@ type ValidatorSigningInfo struct {
 	JailedUntil         time.Time       `json:"jailed_until" yaml:"jailed_until"`                   // timestamp validator cannot be unjailed until
 	Tombstoned          bool            `json:"tombstoned" yaml:"tombstoned"`                       // whether or not a validator has been tombstoned (killed out of validator set)
 	MissedBlocksCounter int64           `json:"missed_blocks_counter" yaml:"missed_blocks_counter"` // missed blocks counter (to avoid scanning the array every time)
+	DowntimeOffenses    int64           `json:"downtime_offenses" yaml:"downtime_offenses"`         // number of consecutive downtime offenses, each within the offense window of the previous one
+	LastDowntimeOffense time.Time       `json:"last_downtime_offense" yaml:"last_downtime_offense"` // timestamp of the last downtime offense
 }
 
 // NewValidatorSigningInfo creates a new ValidatorSigningInfo instance
 func NewValidatorSigningInfo(
 	condAddr sdk.ConsAddress, startHeight, indexOffset int64,
-	jailedUntil time.Time, tombstoned bool, missedBlocksCounter int64,
+	jailedUntil time.Time, tombstoned bool, missedBlocksCounter, downtimeOffenses int64,
+	lastDowntimeOffense time.Time,
 ) ValidatorSigningInfo {
 
 	return ValidatorSigningInfo{
@@ -30,6 +33,8 @@ func NewValidatorSigningInfo(
 		JailedUntil:         jailedUntil,
 		Tombstoned:          tombstoned,
 		MissedBlocksCounter: missedBlocksCounter,
+		DowntimeOffenses:    downtimeOffenses,
+		LastDowntimeOffense: lastDowntimeOffense,
 	}
 }
 
@@ -41,7 +46,10 @@ func (i ValidatorSigningInfo) String() string {
   Index Offset:          %d
   Jailed Until:          %v
   Tombstoned:            %t
-  Missed Blocks Counter: %d`,
+  Missed Blocks Counter: %d
+  Downtime Offenses:     %d
+  Last Downtime Offense: %v`,
 		i.Address, i.StartHeight, i.IndexOffset, i.JailedUntil,
-		i.Tombstoned, i.MissedBlocksCounter)
+		i.Tombstoned, i.MissedBlocksCounter, i.DowntimeOffenses,
+		i.LastDowntimeOffense)
 }
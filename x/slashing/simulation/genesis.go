@@ -22,6 +22,10 @@ const (
 	DowntimeJailDuration    = "downtime_jail_duration"
 	SlashFractionDoubleSign = "slash_fraction_double_sign"
 	SlashFractionDowntime   = "slash_fraction_downtime"
+
+	DowntimeOffenseWindow          = "downtime_offense_window"
+	SlashFractionDowntimeIncrement = "slash_fraction_downtime_increment"
+	DowntimeJailDurationIncrement  = "downtime_jail_duration_increment"
 )
 
 // GenSignedBlocksWindow randomized SignedBlocksWindow
@@ -49,6 +53,21 @@ func GenSlashFractionDowntime(r *rand.Rand) sdk.Dec {
 	return sdk.NewDec(1).Quo(sdk.NewDec(int64(r.Intn(200) + 1)))
 }
 
+// GenDowntimeOffenseWindow randomized DowntimeOffenseWindow
+func GenDowntimeOffenseWindow(r *rand.Rand) time.Duration {
+	return time.Duration(r.Intn(60*60*24*7)) * time.Second
+}
+
+// GenSlashFractionDowntimeIncrement randomized SlashFractionDowntimeIncrement
+func GenSlashFractionDowntimeIncrement(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(10)), 3)
+}
+
+// GenDowntimeJailDurationIncrement randomized DowntimeJailDurationIncrement
+func GenDowntimeJailDurationIncrement(r *rand.Rand) time.Duration {
+	return time.Duration(r.Intn(60*60*24)) * time.Second
+}
+
 // RandomizedGenState generates a random GenesisState for slashing
 func RandomizedGenState(simState *module.SimulationState) {
 	var signedBlocksWindow int64
@@ -81,9 +100,28 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { slashFractionDowntime = GenSlashFractionDowntime(r) },
 	)
 
+	var downtimeOffenseWindow time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DowntimeOffenseWindow, &downtimeOffenseWindow, simState.Rand,
+		func(r *rand.Rand) { downtimeOffenseWindow = GenDowntimeOffenseWindow(r) },
+	)
+
+	var slashFractionDowntimeIncrement sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SlashFractionDowntimeIncrement, &slashFractionDowntimeIncrement, simState.Rand,
+		func(r *rand.Rand) { slashFractionDowntimeIncrement = GenSlashFractionDowntimeIncrement(r) },
+	)
+
+	var downtimeJailDurationIncrement time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DowntimeJailDurationIncrement, &downtimeJailDurationIncrement, simState.Rand,
+		func(r *rand.Rand) { downtimeJailDurationIncrement = GenDowntimeJailDurationIncrement(r) },
+	)
+
 	params := types.NewParams(
 		signedBlocksWindow, minSignedPerWindow, downtimeJailDuration,
-		slashFractionDoubleSign, slashFractionDowntime,
+		slashFractionDoubleSign, slashFractionDowntime, downtimeOffenseWindow,
+		slashFractionDowntimeIncrement, downtimeJailDurationIncrement,
 	)
 
 	slashingGenesis := types.NewGenesisState(params, nil, nil)
@@ -99,15 +99,19 @@ func SimulateMsgUnjail(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Kee
 
 		// result should fail if:
 		// - validator cannot be unjailed due to tombstone
+		// - validator is retired
 		// - validator is still in jailed period
 		// - self delegation too low
-		if info.Tombstoned ||
+		if info.Tombstoned || validator.IsRetired() ||
 			ctx.BlockHeader().Time.Before(info.JailedUntil) ||
 			validator.TokensFromShares(selfDel.GetShares()).TruncateInt().LT(validator.GetMinSelfDelegation()) {
 			if res != nil && err == nil {
 				if info.Tombstoned {
 					return simulation.NewOperationMsg(msg, true, ""), nil, errors.New("validator should not have been unjailed if validator tombstoned")
 				}
+				if validator.IsRetired() {
+					return simulation.NewOperationMsg(msg, true, ""), nil, errors.New("validator should not have been unjailed if validator retired")
+				}
 				if ctx.BlockHeader().Time.Before(info.JailedUntil) {
 					return simulation.NewOperationMsg(msg, true, ""), nil, errors.New("validator unjailed while validator still in jail period")
 				}
@@ -14,6 +14,8 @@ const (
 	keySignedBlocksWindow    = "SignedBlocksWindow"
 	keyMinSignedPerWindow    = "MinSignedPerWindow"
 	keySlashFractionDowntime = "SlashFractionDowntime"
+
+	keySlashFractionDowntimeIncrement = "SlashFractionDowntimeIncrement"
 )
 
 // ParamChanges defines the parameters that can be modified by param change proposals
@@ -35,5 +37,10 @@ func ParamChanges(r *rand.Rand) []simulation.ParamChange {
 				return fmt.Sprintf("\"%s\"", GenSlashFractionDowntime(r))
 			},
 		),
+		simulation.NewSimParamChange(types.ModuleName, keySlashFractionDowntimeIncrement,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenSlashFractionDowntimeIncrement(r))
+			},
+		),
 	}
 }
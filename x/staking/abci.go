@@ -8,9 +8,11 @@ import (
 )
 
 // BeginBlocker will persist the current header and validator set as a historical entry
-// and prune the oldest entry based on the HistoricalEntries parameter
+// and prune the oldest entry based on the HistoricalEntries parameter. It also prunes
+// the delegation changes based on the DelegationHistoryRetention parameter.
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	k.TrackHistoricalInfo(ctx)
+	k.PruneDelegationChanges(ctx)
 }
 
 // Called every block, update validator set
@@ -20,6 +20,8 @@ const (
 	DefaultMaxEntries                  = types.DefaultMaxEntries
 	NotBondedPoolName                  = types.NotBondedPoolName
 	BondedPoolName                     = types.BondedPoolName
+	TokenizeSharesPoolName             = types.TokenizeSharesPoolName
+	ShareTokenDenomPrefix              = types.ShareTokenDenomPrefix
 	QueryValidators                    = types.QueryValidators
 	QueryValidator                     = types.QueryValidator
 	QueryDelegatorDelegations          = types.QueryDelegatorDelegations
@@ -35,145 +37,215 @@ const (
 	QueryPool                          = types.QueryPool
 	QueryParameters                    = types.QueryParameters
 	QueryHistoricalInfo                = types.QueryHistoricalInfo
+	QueryDelegatorDelegationChanges    = types.QueryDelegatorDelegationChanges
+	QueryDelegatorDelegationsAtHeight  = types.QueryDelegatorDelegationsAtHeight
+	QueryDelegationLock                = types.QueryDelegationLock
+	DelegationChangeCreated            = types.DelegationChangeCreated
+	DelegationChangeModified           = types.DelegationChangeModified
+	DelegationChangeRemoved            = types.DelegationChangeRemoved
 	MaxMonikerLength                   = types.MaxMonikerLength
 	MaxIdentityLength                  = types.MaxIdentityLength
 	MaxWebsiteLength                   = types.MaxWebsiteLength
 	MaxDetailsLength                   = types.MaxDetailsLength
+	MaxRetirementUnbondingsPerBlock    = types.MaxRetirementUnbondingsPerBlock
 	DoNotModifyDesc                    = types.DoNotModifyDesc
 )
 
 var (
 	// functions aliases
-	RegisterInvariants                 = keeper.RegisterInvariants
-	AllInvariants                      = keeper.AllInvariants
-	ModuleAccountInvariants            = keeper.ModuleAccountInvariants
-	NonNegativePowerInvariant          = keeper.NonNegativePowerInvariant
-	PositiveDelegationInvariant        = keeper.PositiveDelegationInvariant
-	DelegatorSharesInvariant           = keeper.DelegatorSharesInvariant
-	NewKeeper                          = keeper.NewKeeper
-	ParamKeyTable                      = keeper.ParamKeyTable
-	NewQuerier                         = keeper.NewQuerier
-	RegisterCodec                      = types.RegisterCodec
-	NewCommissionRates                 = types.NewCommissionRates
-	NewCommission                      = types.NewCommission
-	NewCommissionWithTime              = types.NewCommissionWithTime
-	NewDelegation                      = types.NewDelegation
-	MustMarshalDelegation              = types.MustMarshalDelegation
-	MustUnmarshalDelegation            = types.MustUnmarshalDelegation
-	UnmarshalDelegation                = types.UnmarshalDelegation
-	NewUnbondingDelegation             = types.NewUnbondingDelegation
-	NewUnbondingDelegationEntry        = types.NewUnbondingDelegationEntry
-	MustMarshalUBD                     = types.MustMarshalUBD
-	MustUnmarshalUBD                   = types.MustUnmarshalUBD
-	UnmarshalUBD                       = types.UnmarshalUBD
-	NewRedelegation                    = types.NewRedelegation
-	NewRedelegationEntry               = types.NewRedelegationEntry
-	MustMarshalRED                     = types.MustMarshalRED
-	MustUnmarshalRED                   = types.MustUnmarshalRED
-	UnmarshalRED                       = types.UnmarshalRED
-	NewDelegationResp                  = types.NewDelegationResp
-	NewRedelegationResponse            = types.NewRedelegationResponse
-	NewRedelegationEntryResponse       = types.NewRedelegationEntryResponse
-	NewHistoricalInfo                  = types.NewHistoricalInfo
-	MustMarshalHistoricalInfo          = types.MustMarshalHistoricalInfo
-	MustUnmarshalHistoricalInfo        = types.MustUnmarshalHistoricalInfo
-	UnmarshalHistoricalInfo            = types.UnmarshalHistoricalInfo
-	ErrEmptyValidatorAddr              = types.ErrEmptyValidatorAddr
-	ErrBadValidatorAddr                = types.ErrBadValidatorAddr
-	ErrNoValidatorFound                = types.ErrNoValidatorFound
-	ErrValidatorOwnerExists            = types.ErrValidatorOwnerExists
-	ErrValidatorPubKeyExists           = types.ErrValidatorPubKeyExists
-	ErrValidatorPubKeyTypeNotSupported = types.ErrValidatorPubKeyTypeNotSupported
-	ErrValidatorJailed                 = types.ErrValidatorJailed
-	ErrBadRemoveValidator              = types.ErrBadRemoveValidator
-	ErrCommissionNegative              = types.ErrCommissionNegative
-	ErrCommissionHuge                  = types.ErrCommissionHuge
-	ErrCommissionGTMaxRate             = types.ErrCommissionGTMaxRate
-	ErrCommissionUpdateTime            = types.ErrCommissionUpdateTime
-	ErrCommissionChangeRateNegative    = types.ErrCommissionChangeRateNegative
-	ErrCommissionChangeRateGTMaxRate   = types.ErrCommissionChangeRateGTMaxRate
-	ErrCommissionGTMaxChangeRate       = types.ErrCommissionGTMaxChangeRate
-	ErrSelfDelegationBelowMinimum      = types.ErrSelfDelegationBelowMinimum
-	ErrMinSelfDelegationInvalid        = types.ErrMinSelfDelegationInvalid
-	ErrMinSelfDelegationDecreased      = types.ErrMinSelfDelegationDecreased
-	ErrEmptyDelegatorAddr              = types.ErrEmptyDelegatorAddr
-	ErrBadDenom                        = types.ErrBadDenom
-	ErrBadDelegationAddr               = types.ErrBadDelegationAddr
-	ErrBadDelegationAmount             = types.ErrBadDelegationAmount
-	ErrNoDelegation                    = types.ErrNoDelegation
-	ErrBadDelegatorAddr                = types.ErrBadDelegatorAddr
-	ErrNoDelegatorForAddress           = types.ErrNoDelegatorForAddress
-	ErrInsufficientShares              = types.ErrInsufficientShares
-	ErrDelegationValidatorEmpty        = types.ErrDelegationValidatorEmpty
-	ErrNotEnoughDelegationShares       = types.ErrNotEnoughDelegationShares
-	ErrBadSharesAmount                 = types.ErrBadSharesAmount
-	ErrBadSharesPercent                = types.ErrBadSharesPercent
-	ErrNotMature                       = types.ErrNotMature
-	ErrNoUnbondingDelegation           = types.ErrNoUnbondingDelegation
-	ErrMaxUnbondingDelegationEntries   = types.ErrMaxUnbondingDelegationEntries
-	ErrBadRedelegationAddr             = types.ErrBadRedelegationAddr
-	ErrNoRedelegation                  = types.ErrNoRedelegation
-	ErrSelfRedelegation                = types.ErrSelfRedelegation
-	ErrTinyRedelegationAmount          = types.ErrTinyRedelegationAmount
-	ErrBadRedelegationDst              = types.ErrBadRedelegationDst
-	ErrTransitiveRedelegation          = types.ErrTransitiveRedelegation
-	ErrMaxRedelegationEntries          = types.ErrMaxRedelegationEntries
-	ErrDelegatorShareExRateInvalid     = types.ErrDelegatorShareExRateInvalid
-	ErrBothShareMsgsGiven              = types.ErrBothShareMsgsGiven
-	ErrNeitherShareMsgsGiven           = types.ErrNeitherShareMsgsGiven
-	ErrInvalidHistoricalInfo           = types.ErrInvalidHistoricalInfo
-	ErrNoHistoricalInfo                = types.ErrNoHistoricalInfo
-	ErrEmptyValidatorPubKey            = types.ErrEmptyValidatorPubKey
-	NewGenesisState                    = types.NewGenesisState
-	DefaultGenesisState                = types.DefaultGenesisState
-	NewMultiStakingHooks               = types.NewMultiStakingHooks
-	GetValidatorKey                    = types.GetValidatorKey
-	GetValidatorByConsAddrKey          = types.GetValidatorByConsAddrKey
-	AddressFromLastValidatorPowerKey   = types.AddressFromLastValidatorPowerKey
-	GetValidatorsByPowerIndexKey       = types.GetValidatorsByPowerIndexKey
-	GetLastValidatorPowerKey           = types.GetLastValidatorPowerKey
-	ParseValidatorPowerRankKey         = types.ParseValidatorPowerRankKey
-	GetValidatorQueueTimeKey           = types.GetValidatorQueueTimeKey
-	GetDelegationKey                   = types.GetDelegationKey
-	GetDelegationsKey                  = types.GetDelegationsKey
-	GetUBDKey                          = types.GetUBDKey
-	GetUBDByValIndexKey                = types.GetUBDByValIndexKey
-	GetUBDKeyFromValIndexKey           = types.GetUBDKeyFromValIndexKey
-	GetUBDsKey                         = types.GetUBDsKey
-	GetUBDsByValIndexKey               = types.GetUBDsByValIndexKey
-	GetUnbondingDelegationTimeKey      = types.GetUnbondingDelegationTimeKey
-	GetREDKey                          = types.GetREDKey
-	GetREDByValSrcIndexKey             = types.GetREDByValSrcIndexKey
-	GetREDByValDstIndexKey             = types.GetREDByValDstIndexKey
-	GetREDKeyFromValSrcIndexKey        = types.GetREDKeyFromValSrcIndexKey
-	GetREDKeyFromValDstIndexKey        = types.GetREDKeyFromValDstIndexKey
-	GetRedelegationTimeKey             = types.GetRedelegationTimeKey
-	GetREDsKey                         = types.GetREDsKey
-	GetREDsFromValSrcIndexKey          = types.GetREDsFromValSrcIndexKey
-	GetREDsToValDstIndexKey            = types.GetREDsToValDstIndexKey
-	GetREDsByDelToValDstIndexKey       = types.GetREDsByDelToValDstIndexKey
-	GetHistoricalInfoKey               = types.GetHistoricalInfoKey
-	NewMsgCreateValidator              = types.NewMsgCreateValidator
-	NewMsgEditValidator                = types.NewMsgEditValidator
-	NewMsgDelegate                     = types.NewMsgDelegate
-	NewMsgBeginRedelegate              = types.NewMsgBeginRedelegate
-	NewMsgUndelegate                   = types.NewMsgUndelegate
-	NewParams                          = types.NewParams
-	DefaultParams                      = types.DefaultParams
-	MustUnmarshalParams                = types.MustUnmarshalParams
-	UnmarshalParams                    = types.UnmarshalParams
-	NewPool                            = types.NewPool
-	NewQueryDelegatorParams            = types.NewQueryDelegatorParams
-	NewQueryValidatorParams            = types.NewQueryValidatorParams
-	NewQueryBondsParams                = types.NewQueryBondsParams
-	NewQueryRedelegationParams         = types.NewQueryRedelegationParams
-	NewQueryValidatorsParams           = types.NewQueryValidatorsParams
-	NewQueryHistoricalInfoParams       = types.NewQueryHistoricalInfoParams
-	NewValidator                       = types.NewValidator
-	MustMarshalValidator               = types.MustMarshalValidator
-	MustUnmarshalValidator             = types.MustUnmarshalValidator
-	UnmarshalValidator                 = types.UnmarshalValidator
-	NewDescription                     = types.NewDescription
+	RegisterInvariants                   = keeper.RegisterInvariants
+	AllInvariants                        = keeper.AllInvariants
+	ModuleAccountInvariants              = keeper.ModuleAccountInvariants
+	NonNegativePowerInvariant            = keeper.NonNegativePowerInvariant
+	PositiveDelegationInvariant          = keeper.PositiveDelegationInvariant
+	DelegatorSharesInvariant             = keeper.DelegatorSharesInvariant
+	NewKeeper                            = keeper.NewKeeper
+	ParamKeyTable                        = keeper.ParamKeyTable
+	NewQuerier                           = keeper.NewQuerier
+	RegisterCodec                        = types.RegisterCodec
+	NewCommissionRates                   = types.NewCommissionRates
+	NewCommission                        = types.NewCommission
+	NewCommissionWithTime                = types.NewCommissionWithTime
+	NewDelegation                        = types.NewDelegation
+	MustMarshalDelegation                = types.MustMarshalDelegation
+	MustUnmarshalDelegation              = types.MustUnmarshalDelegation
+	UnmarshalDelegation                  = types.UnmarshalDelegation
+	NewUnbondingDelegation               = types.NewUnbondingDelegation
+	NewUnbondingDelegationEntry          = types.NewUnbondingDelegationEntry
+	MustMarshalUBD                       = types.MustMarshalUBD
+	MustUnmarshalUBD                     = types.MustUnmarshalUBD
+	UnmarshalUBD                         = types.UnmarshalUBD
+	NewRedelegation                      = types.NewRedelegation
+	NewRedelegationEntry                 = types.NewRedelegationEntry
+	MustMarshalRED                       = types.MustMarshalRED
+	MustUnmarshalRED                     = types.MustUnmarshalRED
+	UnmarshalRED                         = types.UnmarshalRED
+	NewDelegationResp                    = types.NewDelegationResp
+	NewRedelegationResponse              = types.NewRedelegationResponse
+	NewRedelegationEntryResponse         = types.NewRedelegationEntryResponse
+	NewHistoricalInfo                    = types.NewHistoricalInfo
+	MustMarshalHistoricalInfo            = types.MustMarshalHistoricalInfo
+	MustUnmarshalHistoricalInfo          = types.MustUnmarshalHistoricalInfo
+	UnmarshalHistoricalInfo              = types.UnmarshalHistoricalInfo
+	NewConsPubKeyRotation                = types.NewConsPubKeyRotation
+	MustMarshalConsPubKeyRotation        = types.MustMarshalConsPubKeyRotation
+	MustUnmarshalConsPubKeyRotation      = types.MustUnmarshalConsPubKeyRotation
+	UnmarshalConsPubKeyRotation          = types.UnmarshalConsPubKeyRotation
+	NewTokenizeShareRecord               = types.NewTokenizeShareRecord
+	ParseShareTokenDenom                 = types.ParseShareTokenDenom
+	MustMarshalTokenizeShareRecord       = types.MustMarshalTokenizeShareRecord
+	MustUnmarshalTokenizeShareRecord     = types.MustUnmarshalTokenizeShareRecord
+	UnmarshalTokenizeShareRecord         = types.UnmarshalTokenizeShareRecord
+	NewLockTier                          = types.NewLockTier
+	NewDelegationLock                    = types.NewDelegationLock
+	MustMarshalDelegationLock            = types.MustMarshalDelegationLock
+	MustUnmarshalDelegationLock          = types.MustUnmarshalDelegationLock
+	UnmarshalDelegationLock              = types.UnmarshalDelegationLock
+	ErrEmptyValidatorAddr                = types.ErrEmptyValidatorAddr
+	ErrBadValidatorAddr                  = types.ErrBadValidatorAddr
+	ErrNoValidatorFound                  = types.ErrNoValidatorFound
+	ErrValidatorOwnerExists              = types.ErrValidatorOwnerExists
+	ErrValidatorPubKeyExists             = types.ErrValidatorPubKeyExists
+	ErrValidatorPubKeyTypeNotSupported   = types.ErrValidatorPubKeyTypeNotSupported
+	ErrValidatorJailed                   = types.ErrValidatorJailed
+	ErrBadRemoveValidator                = types.ErrBadRemoveValidator
+	ErrCommissionNegative                = types.ErrCommissionNegative
+	ErrCommissionHuge                    = types.ErrCommissionHuge
+	ErrCommissionGTMaxRate               = types.ErrCommissionGTMaxRate
+	ErrCommissionUpdateTime              = types.ErrCommissionUpdateTime
+	ErrCommissionChangeRateNegative      = types.ErrCommissionChangeRateNegative
+	ErrCommissionChangeRateGTMaxRate     = types.ErrCommissionChangeRateGTMaxRate
+	ErrCommissionGTMaxChangeRate         = types.ErrCommissionGTMaxChangeRate
+	ErrSelfDelegationBelowMinimum        = types.ErrSelfDelegationBelowMinimum
+	ErrMinSelfDelegationInvalid          = types.ErrMinSelfDelegationInvalid
+	ErrMinSelfDelegationDecreased        = types.ErrMinSelfDelegationDecreased
+	ErrEmptyDelegatorAddr                = types.ErrEmptyDelegatorAddr
+	ErrBadDenom                          = types.ErrBadDenom
+	ErrBadDelegationAddr                 = types.ErrBadDelegationAddr
+	ErrBadDelegationAmount               = types.ErrBadDelegationAmount
+	ErrNoDelegation                      = types.ErrNoDelegation
+	ErrBadDelegatorAddr                  = types.ErrBadDelegatorAddr
+	ErrNoDelegatorForAddress             = types.ErrNoDelegatorForAddress
+	ErrInsufficientShares                = types.ErrInsufficientShares
+	ErrDelegationValidatorEmpty          = types.ErrDelegationValidatorEmpty
+	ErrNotEnoughDelegationShares         = types.ErrNotEnoughDelegationShares
+	ErrBadSharesAmount                   = types.ErrBadSharesAmount
+	ErrBadSharesPercent                  = types.ErrBadSharesPercent
+	ErrNotMature                         = types.ErrNotMature
+	ErrNoUnbondingDelegation             = types.ErrNoUnbondingDelegation
+	ErrMaxUnbondingDelegationEntries     = types.ErrMaxUnbondingDelegationEntries
+	ErrBadRedelegationAddr               = types.ErrBadRedelegationAddr
+	ErrNoRedelegation                    = types.ErrNoRedelegation
+	ErrSelfRedelegation                  = types.ErrSelfRedelegation
+	ErrTinyRedelegationAmount            = types.ErrTinyRedelegationAmount
+	ErrBadRedelegationDst                = types.ErrBadRedelegationDst
+	ErrTransitiveRedelegation            = types.ErrTransitiveRedelegation
+	ErrMaxRedelegationEntries            = types.ErrMaxRedelegationEntries
+	ErrDelegatorShareExRateInvalid       = types.ErrDelegatorShareExRateInvalid
+	ErrBothShareMsgsGiven                = types.ErrBothShareMsgsGiven
+	ErrNeitherShareMsgsGiven             = types.ErrNeitherShareMsgsGiven
+	ErrInvalidHistoricalInfo             = types.ErrInvalidHistoricalInfo
+	ErrNoHistoricalInfo                  = types.ErrNoHistoricalInfo
+	ErrEmptyValidatorPubKey              = types.ErrEmptyValidatorPubKey
+	ErrNoUnbondingDelegationEntry        = types.ErrNoUnbondingDelegationEntry
+	ErrUnbondingEntryBalanceExceeded     = types.ErrUnbondingEntryBalanceExceeded
+	ErrConsPubKeyRotationInProgress      = types.ErrConsPubKeyRotationInProgress
+	ErrTokenizeSelfDelegation            = types.ErrTokenizeSelfDelegation
+	ErrGlobalLiquidStakingCapExceeded    = types.ErrGlobalLiquidStakingCapExceeded
+	ErrValidatorLiquidStakingCapExceeded = types.ErrValidatorLiquidStakingCapExceeded
+	ErrNoTokenizeShareRecord             = types.ErrNoTokenizeShareRecord
+	ErrNotEnoughShareTokens              = types.ErrNotEnoughShareTokens
+	ErrCommissionLTMinRate               = types.ErrCommissionLTMinRate
+	ErrValidatorRetired                  = types.ErrValidatorRetired
+	ErrInvalidHistoricalHeight           = types.ErrInvalidHistoricalHeight
+	ErrDelegationHistoryPruned           = types.ErrDelegationHistoryPruned
+	ErrDelegationLocked                  = types.ErrDelegationLocked
+	ErrInvalidLockDuration               = types.ErrInvalidLockDuration
+	ErrDelegationLockShortened           = types.ErrDelegationLockShortened
+	ErrNoDelegationLock                  = types.ErrNoDelegationLock
+	ErrRedelegationInProgress            = types.ErrRedelegationInProgress
+	ErrValidatorSharesTokenized          = types.ErrValidatorSharesTokenized
+	ErrExceedingFreeVestingDelegations   = types.ErrExceedingFreeVestingDelegations
+	NewGenesisState                      = types.NewGenesisState
+	DefaultGenesisState                  = types.DefaultGenesisState
+	NewMultiStakingHooks                 = types.NewMultiStakingHooks
+	GetValidatorKey                      = types.GetValidatorKey
+	GetValidatorByConsAddrKey            = types.GetValidatorByConsAddrKey
+	AddressFromLastValidatorPowerKey     = types.AddressFromLastValidatorPowerKey
+	GetValidatorsByPowerIndexKey         = types.GetValidatorsByPowerIndexKey
+	GetLastValidatorPowerKey             = types.GetLastValidatorPowerKey
+	ParseValidatorPowerRankKey           = types.ParseValidatorPowerRankKey
+	GetValidatorQueueTimeKey             = types.GetValidatorQueueTimeKey
+	GetRetirementQueueKey                = types.GetRetirementQueueKey
+	GetDelegationKey                     = types.GetDelegationKey
+	GetDelegationsKey                    = types.GetDelegationsKey
+	GetDelegationByValIndexKey           = types.GetDelegationByValIndexKey
+	GetDelegationsByValIndexKey          = types.GetDelegationsByValIndexKey
+	GetDelegationKeyFromValIndexKey      = types.GetDelegationKeyFromValIndexKey
+	GetUBDKey                            = types.GetUBDKey
+	GetUBDByValIndexKey                  = types.GetUBDByValIndexKey
+	GetUBDKeyFromValIndexKey             = types.GetUBDKeyFromValIndexKey
+	GetUBDsKey                           = types.GetUBDsKey
+	GetUBDsByValIndexKey                 = types.GetUBDsByValIndexKey
+	GetUnbondingDelegationTimeKey        = types.GetUnbondingDelegationTimeKey
+	GetREDKey                            = types.GetREDKey
+	GetREDByValSrcIndexKey               = types.GetREDByValSrcIndexKey
+	GetREDByValDstIndexKey               = types.GetREDByValDstIndexKey
+	GetREDKeyFromValSrcIndexKey          = types.GetREDKeyFromValSrcIndexKey
+	GetREDKeyFromValDstIndexKey          = types.GetREDKeyFromValDstIndexKey
+	GetRedelegationTimeKey               = types.GetRedelegationTimeKey
+	GetREDsKey                           = types.GetREDsKey
+	GetREDsFromValSrcIndexKey            = types.GetREDsFromValSrcIndexKey
+	GetREDsToValDstIndexKey              = types.GetREDsToValDstIndexKey
+	GetREDsByDelToValDstIndexKey         = types.GetREDsByDelToValDstIndexKey
+	GetHistoricalInfoKey                 = types.GetHistoricalInfoKey
+	GetConsPubKeyRotationKey             = types.GetConsPubKeyRotationKey
+	GetConsPubKeyRotationUpdateKey       = types.GetConsPubKeyRotationUpdateKey
+	GetTokenizeShareRecordKey            = types.GetTokenizeShareRecordKey
+	GetTokenizeShareRecordByValKey       = types.GetTokenizeShareRecordByValKey
+	GetTokenizeShareRecordsByValKey      = types.GetTokenizeShareRecordsByValKey
+	SplitTokenizeShareRecordByValKey     = types.SplitTokenizeShareRecordByValKey
+	GetValidatorTokenizedSharesKey       = types.GetValidatorTokenizedSharesKey
+	GetDelegationChangeKey               = types.GetDelegationChangeKey
+	GetDelegationChangesKey              = types.GetDelegationChangesKey
+	GetDelegationChangeByHeightKey       = types.GetDelegationChangeByHeightKey
+	GetDelegationLockKey                 = types.GetDelegationLockKey
+	GetDelegationLocksKey                = types.GetDelegationLocksKey
+	GetDelegationLockTimeKey             = types.GetDelegationLockTimeKey
+	NewDelegationChange                  = types.NewDelegationChange
+	MustMarshalDelegationChange          = types.MustMarshalDelegationChange
+	MustUnmarshalDelegationChange        = types.MustUnmarshalDelegationChange
+	UnmarshalDelegationChange            = types.UnmarshalDelegationChange
+	NewMsgCreateValidator                = types.NewMsgCreateValidator
+	NewMsgEditValidator                  = types.NewMsgEditValidator
+	NewMsgDelegate                       = types.NewMsgDelegate
+	NewMsgBeginRedelegate                = types.NewMsgBeginRedelegate
+	NewMsgUndelegate                     = types.NewMsgUndelegate
+	NewMsgCancelUnbondingDelegation      = types.NewMsgCancelUnbondingDelegation
+	NewMsgRotateConsPubKey               = types.NewMsgRotateConsPubKey
+	NewMsgRetireValidator                = types.NewMsgRetireValidator
+	NewMsgTokenizeShares                 = types.NewMsgTokenizeShares
+	NewMsgRedeemTokensForShares          = types.NewMsgRedeemTokensForShares
+	NewMsgLockDelegation                 = types.NewMsgLockDelegation
+	NewParams                            = types.NewParams
+	DefaultParams                        = types.DefaultParams
+	MustUnmarshalParams                  = types.MustUnmarshalParams
+	UnmarshalParams                      = types.UnmarshalParams
+	NewPool                              = types.NewPool
+	NewQueryDelegatorParams              = types.NewQueryDelegatorParams
+	NewQueryValidatorParams              = types.NewQueryValidatorParams
+	NewQueryBondsParams                  = types.NewQueryBondsParams
+	NewQueryRedelegationParams           = types.NewQueryRedelegationParams
+	NewQueryValidatorsParams             = types.NewQueryValidatorsParams
+	NewQueryHistoricalInfoParams         = types.NewQueryHistoricalInfoParams
+	NewQueryTokenizeShareRecordsParams   = types.NewQueryTokenizeShareRecordsParams
+	ValidateValidatorFilter              = types.ValidateValidatorFilter
+	MatchesValidatorFilter               = types.MatchesValidatorFilter
+	NewValidator                         = types.NewValidator
+	MustMarshalValidator                 = types.MustMarshalValidator
+	MustUnmarshalValidator               = types.MustUnmarshalValidator
+	UnmarshalValidator                   = types.UnmarshalValidator
+	NewDescription                       = types.NewDescription
 
 	// variable aliases
 	NewCodec                         = types.NewCodec
@@ -189,56 +261,88 @@ var (
 	RedelegationKey                  = types.RedelegationKey
 	RedelegationByValSrcIndexKey     = types.RedelegationByValSrcIndexKey
 	RedelegationByValDstIndexKey     = types.RedelegationByValDstIndexKey
+	DelegationByValIndexKey          = types.DelegationByValIndexKey
 	UnbondingQueueKey                = types.UnbondingQueueKey
 	RedelegationQueueKey             = types.RedelegationQueueKey
 	ValidatorQueueKey                = types.ValidatorQueueKey
+	RetirementQueueKey               = types.RetirementQueueKey
 	HistoricalInfoKey                = types.HistoricalInfoKey
+	ConsPubKeyRotationKey            = types.ConsPubKeyRotationKey
+	ConsPubKeyRotationUpdatesKey     = types.ConsPubKeyRotationUpdatesKey
+	TokenizeShareRecordKey           = types.TokenizeShareRecordKey
+	TokenizeShareRecordByValKey      = types.TokenizeShareRecordByValKey
+	LastTokenizeShareRecordIDKey     = types.LastTokenizeShareRecordIDKey
+	TotalLiquidStakedTokensKey       = types.TotalLiquidStakedTokensKey
+	ValidatorTokenizedSharesKey      = types.ValidatorTokenizedSharesKey
+	DelegationChangeKey              = types.DelegationChangeKey
+	DelegationChangeByHeightKey      = types.DelegationChangeByHeightKey
+	DelegationLockKey                = types.DelegationLockKey
+	DelegationLockQueueKey           = types.DelegationLockQueueKey
 	KeyUnbondingTime                 = types.KeyUnbondingTime
 	KeyMaxValidators                 = types.KeyMaxValidators
 	KeyMaxEntries                    = types.KeyMaxEntries
 	KeyBondDenom                     = types.KeyBondDenom
+	KeyKeyRotationFee                = types.KeyKeyRotationFee
+	KeyGlobalLiquidStakingCap        = types.KeyGlobalLiquidStakingCap
+	KeyValidatorLiquidStakingCap     = types.KeyValidatorLiquidStakingCap
+	KeyMinCommissionRate             = types.KeyMinCommissionRate
+	KeyDelegationHistoryRetention    = types.KeyDelegationHistoryRetention
+	KeyLockTiers                     = types.KeyLockTiers
+	KeyMaxCommissionChangeRate       = types.KeyMaxCommissionChangeRate
 )
 
 type (
-	Keeper                    = keeper.Keeper
-	Codec                     = types.Codec
-	Commission                = types.Commission
-	CommissionRates           = types.CommissionRates
-	DVPair                    = types.DVPair
-	DVVTriplet                = types.DVVTriplet
-	Delegation                = types.Delegation
-	Delegations               = types.Delegations
-	UnbondingDelegation       = types.UnbondingDelegation
-	UnbondingDelegationEntry  = types.UnbondingDelegationEntry
-	UnbondingDelegations      = types.UnbondingDelegations
-	Redelegation              = types.Redelegation
-	RedelegationEntry         = types.RedelegationEntry
-	Redelegations             = types.Redelegations
-	HistoricalInfo            = types.HistoricalInfo
-	DelegationResponse        = types.DelegationResponse
-	DelegationResponses       = types.DelegationResponses
-	RedelegationResponse      = types.RedelegationResponse
-	RedelegationEntryResponse = types.RedelegationEntryResponse
-	RedelegationResponses     = types.RedelegationResponses
-	GenesisState              = types.GenesisState
-	LastValidatorPower        = types.LastValidatorPower
-	MultiStakingHooks         = types.MultiStakingHooks
-	MsgCreateValidator        = types.MsgCreateValidator
-	MsgEditValidator          = types.MsgEditValidator
-	MsgDelegate               = types.MsgDelegate
-	MsgBeginRedelegate        = types.MsgBeginRedelegate
-	MsgUndelegate             = types.MsgUndelegate
-	Params                    = types.Params
-	Pool                      = types.Pool
-	QueryDelegatorParams      = types.QueryDelegatorParams
-	QueryValidatorParams      = types.QueryValidatorParams
-	QueryBondsParams          = types.QueryBondsParams
-	QueryRedelegationParams   = types.QueryRedelegationParams
-	QueryValidatorsParams     = types.QueryValidatorsParams
-	QueryHistoricalInfoParams = types.QueryHistoricalInfoParams
-	Validator                 = types.Validator
-	Validators                = types.Validators
-	Description               = types.Description
-	DelegationI               = exported.DelegationI
-	ValidatorI                = exported.ValidatorI
+	Keeper                       = keeper.Keeper
+	Codec                        = types.Codec
+	Commission                   = types.Commission
+	CommissionRates              = types.CommissionRates
+	DVPair                       = types.DVPair
+	DVVTriplet                   = types.DVVTriplet
+	Delegation                   = types.Delegation
+	Delegations                  = types.Delegations
+	UnbondingDelegation          = types.UnbondingDelegation
+	UnbondingDelegationEntry     = types.UnbondingDelegationEntry
+	UnbondingDelegations         = types.UnbondingDelegations
+	Redelegation                 = types.Redelegation
+	RedelegationEntry            = types.RedelegationEntry
+	Redelegations                = types.Redelegations
+	HistoricalInfo               = types.HistoricalInfo
+	ConsPubKeyRotation           = types.ConsPubKeyRotation
+	TokenizeShareRecord          = types.TokenizeShareRecord
+	DelegationChange             = types.DelegationChange
+	DelegationChangeType         = types.DelegationChangeType
+	LockTier                     = types.LockTier
+	DelegationLock               = types.DelegationLock
+	DelegationResponse           = types.DelegationResponse
+	DelegationResponses          = types.DelegationResponses
+	RedelegationResponse         = types.RedelegationResponse
+	RedelegationEntryResponse    = types.RedelegationEntryResponse
+	RedelegationResponses        = types.RedelegationResponses
+	GenesisState                 = types.GenesisState
+	LastValidatorPower           = types.LastValidatorPower
+	MultiStakingHooks            = types.MultiStakingHooks
+	MsgCreateValidator           = types.MsgCreateValidator
+	MsgEditValidator             = types.MsgEditValidator
+	MsgDelegate                  = types.MsgDelegate
+	MsgBeginRedelegate           = types.MsgBeginRedelegate
+	MsgUndelegate                = types.MsgUndelegate
+	MsgCancelUnbondingDelegation = types.MsgCancelUnbondingDelegation
+	MsgRotateConsPubKey          = types.MsgRotateConsPubKey
+	MsgRetireValidator           = types.MsgRetireValidator
+	MsgTokenizeShares            = types.MsgTokenizeShares
+	MsgRedeemTokensForShares     = types.MsgRedeemTokensForShares
+	MsgLockDelegation            = types.MsgLockDelegation
+	Params                       = types.Params
+	Pool                         = types.Pool
+	QueryDelegatorParams         = types.QueryDelegatorParams
+	QueryValidatorParams         = types.QueryValidatorParams
+	QueryBondsParams             = types.QueryBondsParams
+	QueryRedelegationParams      = types.QueryRedelegationParams
+	QueryValidatorsParams        = types.QueryValidatorsParams
+	QueryHistoricalInfoParams    = types.QueryHistoricalInfoParams
+	Validator                    = types.Validator
+	Validators                   = types.Validators
+	Description                  = types.Description
+	DelegationI                  = exported.DelegationI
+	ValidatorI                   = exported.ValidatorI
 )
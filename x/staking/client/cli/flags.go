@@ -28,6 +28,9 @@ const (
 
 	FlagMinSelfDelegation = "min-self-delegation"
 
+	FlagStatus = "status"
+	FlagJailed = "jailed"
+
 	FlagGenesisFormat = "genesis-format"
 	FlagNodeID        = "node-id"
 	FlagIP            = "ip"
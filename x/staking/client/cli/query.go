@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -28,6 +29,9 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	stakingQueryCmd.AddCommand(flags.GetCommands(
 		GetCmdQueryDelegation(queryRoute, cdc),
 		GetCmdQueryDelegations(queryRoute, cdc),
+		GetCmdQueryDelegationChanges(queryRoute, cdc),
+		GetCmdQueryDelegationsAtHeight(queryRoute, cdc),
+		GetCmdQueryDelegationLock(queryRoute, cdc),
 		GetCmdQueryUnbondingDelegation(queryRoute, cdc),
 		GetCmdQueryUnbondingDelegations(queryRoute, cdc),
 		GetCmdQueryRedelegation(queryRoute, cdc),
@@ -38,6 +42,7 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdQueryValidatorUnbondingDelegations(queryRoute, cdc),
 		GetCmdQueryValidatorRedelegations(queryRoute, cdc),
 		GetCmdQueryHistoricalInfo(queryRoute, cdc),
+		GetCmdQueryTokenizeShareRecords(queryRoute, cdc),
 		GetCmdQueryParams(queryRoute, cdc),
 		GetCmdQueryPool(queryRoute, cdc))...)
 
@@ -88,46 +93,60 @@ $ %s query staking validator cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhff
 }
 
 // GetCmdQueryValidators implements the query all validators command.
-func GetCmdQueryValidators(storeName string, cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+func GetCmdQueryValidators(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
 		Use:   "validators",
 		Short: "Query for all validators",
 		Args:  cobra.NoArgs,
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Query details about all validators on a network.
+			fmt.Sprintf(`Query details about all validators on a network, optionally filtered by
+status (bonded, unbonding or unbonded) and jail state.
 
 Example:
 $ %s query staking validators
+$ %s query staking validators --status=bonded --jailed=false --page=2 --limit=50
 `,
-				version.ClientName,
+				version.ClientName, version.ClientName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
-			resKVs, _, err := cliCtx.QuerySubspace(types.ValidatorsKey, storeName)
+			status, jailed, err := getValidatorFilter()
 			if err != nil {
 				return err
 			}
 
-			var validators types.Validators
-			for _, kv := range resKVs {
-				validator, err := types.UnmarshalValidator(types.ModuleCdc, kv.Value)
-				if err != nil {
-					return err
-				}
+			params := types.NewQueryValidatorsParams(viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit), status, jailed)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
 
-				validators = append(validators, validator)
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryValidators)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var validators types.Validators
+			if err := cdc.UnmarshalJSON(res, &validators); err != nil {
+				return err
 			}
 
 			return cliCtx.PrintOutput(validators)
 		},
 	}
+
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of validators to query for")
+	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit of validators to query for")
+	addValidatorFilterFlags(cmd)
+	return cmd
 }
 
 // GetCmdQueryValidatorUnbondingDelegations implements the query all unbonding delegatations from a validator command.
 func GetCmdQueryValidatorUnbondingDelegations(queryRoute string, cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "unbonding-delegations-from [validator-addr]",
 		Short: "Query all unbonding delegatations from a validator",
 		Long: strings.TrimSpace(
@@ -148,7 +167,8 @@ $ %s query staking unbonding-delegations-from cosmosvaloper1gghjut3ccd8ay0zduzj6
 				return err
 			}
 
-			bz, err := cdc.MarshalJSON(types.NewQueryValidatorParams(valAddr))
+			params := types.NewQueryValidatorParams(valAddr, viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit))
+			bz, err := cdc.MarshalJSON(params)
 			if err != nil {
 				return err
 			}
@@ -164,12 +184,16 @@ $ %s query staking unbonding-delegations-from cosmosvaloper1gghjut3ccd8ay0zduzj6
 			return cliCtx.PrintOutput(ubds)
 		},
 	}
+
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of unbonding delegations to query for")
+	cmd.Flags().Int(flags.FlagLimit, 0, "pagination limit of unbonding delegations to query for (0 for all)")
+	return cmd
 }
 
 // GetCmdQueryValidatorRedelegations implements the query all redelegatations
 // from a validator command.
 func GetCmdQueryValidatorRedelegations(queryRoute string, cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "redelegations-from [validator-addr]",
 		Short: "Query all outgoing redelegatations from a validator",
 		Long: strings.TrimSpace(
@@ -190,7 +214,10 @@ $ %s query staking redelegations-from cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fx
 				return err
 			}
 
-			bz, err := cdc.MarshalJSON(types.QueryRedelegationParams{SrcValidatorAddr: valSrcAddr})
+			params := types.NewQueryRedelegationParams(
+				nil, valSrcAddr, nil, viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit),
+			)
+			bz, err := cdc.MarshalJSON(params)
 			if err != nil {
 				return err
 			}
@@ -209,6 +236,10 @@ $ %s query staking redelegations-from cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fx
 			return cliCtx.PrintOutput(resp)
 		},
 	}
+
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of redelegations to query for")
+	cmd.Flags().Int(flags.FlagLimit, 0, "pagination limit of redelegations to query for (0 for all)")
+	return cmd
 }
 
 // GetCmdQueryDelegation the query delegation command.
@@ -263,7 +294,7 @@ $ %s query staking delegation cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p cosm
 // GetCmdQueryDelegations implements the command to query all the delegations
 // made from one delegator.
 func GetCmdQueryDelegations(queryRoute string, cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "delegations [delegator-addr]",
 		Short: "Query all delegations made by one delegator",
 		Long: strings.TrimSpace(
@@ -284,7 +315,15 @@ $ %s query staking delegations cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
 				return err
 			}
 
-			bz, err := cdc.MarshalJSON(types.NewQueryDelegatorParams(delAddr))
+			status, jailed, err := getValidatorFilter()
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryDelegatorParams(
+				delAddr, viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit), status, jailed,
+			)
+			bz, err := cdc.MarshalJSON(params)
 			if err != nil {
 				return err
 			}
@@ -303,12 +342,128 @@ $ %s query staking delegations cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
 			return cliCtx.PrintOutput(resp)
 		},
 	}
+
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of delegations to query for")
+	cmd.Flags().Int(flags.FlagLimit, 0, "pagination limit of delegations to query for (0 for all)")
+	addValidatorFilterFlags(cmd)
+	return cmd
+}
+
+// GetCmdQueryDelegationChanges implements the command to query the delegation
+// changes of a delegator.
+func GetCmdQueryDelegationChanges(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegation-changes [delegator-addr]",
+		Short: "Query the delegation changes of one delegator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the retained delegation changes of an individual delegator, in order of
+height. Each change holds the delegation shares before and after the changes of its block.
+
+Example:
+$ %s query staking delegation-changes cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --page=2 --limit=10
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			delAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryDelegatorDelegationChangesParams(
+				delAddr, viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit),
+			)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryDelegatorDelegationChanges)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var resp []types.DelegationChange
+			if err := cdc.UnmarshalJSON(res, &resp); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(resp)
+		},
+	}
+
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of delegation changes to query for")
+	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit of delegation changes to query for")
+	return cmd
+}
+
+// GetCmdQueryDelegationsAtHeight implements the command to query the
+// delegations of a delegator at a past height.
+func GetCmdQueryDelegationsAtHeight(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegations-at-height [delegator-addr] [height]",
+		Short: "Query the delegations made by one delegator at a past height",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the delegations of an individual delegator on all validators at the end of
+a past block. The height must be within the delegation history retention.
+
+Example:
+$ %s query staking delegations-at-height cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p 1200
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			delAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil || height < 0 {
+				return fmt.Errorf("height argument provided must be a non-negative-integer: %v", err)
+			}
+
+			params := types.NewQueryDelegatorDelegationsAtHeightParams(
+				delAddr, height, viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit),
+			)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryDelegatorDelegationsAtHeight)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var resp types.Delegations
+			if err := cdc.UnmarshalJSON(res, &resp); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(resp)
+		},
+	}
+
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of delegations to query for")
+	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit of delegations to query for")
+	return cmd
 }
 
 // GetCmdQueryValidatorDelegations implements the command to query all the
 // delegations to a specific validator.
 func GetCmdQueryValidatorDelegations(queryRoute string, cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "delegations-to [validator-addr]",
 		Short: "Query all delegations made to one validator",
 		Long: strings.TrimSpace(
@@ -329,7 +484,8 @@ $ %s query staking delegations-to cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ld
 				return err
 			}
 
-			bz, err := cdc.MarshalJSON(types.NewQueryValidatorParams(valAddr))
+			params := types.NewQueryValidatorParams(valAddr, viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit))
+			bz, err := cdc.MarshalJSON(params)
 			if err != nil {
 				return err
 			}
@@ -348,6 +504,10 @@ $ %s query staking delegations-to cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ld
 			return cliCtx.PrintOutput(resp)
 		},
 	}
+
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of delegations to query for")
+	cmd.Flags().Int(flags.FlagLimit, 0, "pagination limit of delegations to query for (0 for all)")
+	return cmd
 }
 
 // GetCmdQueryUnbondingDelegation implements the command to query a single
@@ -403,7 +563,7 @@ $ %s query staking unbonding-delegation cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld7
 // GetCmdQueryUnbondingDelegations implements the command to query all the
 // unbonding-delegation records for a delegator.
 func GetCmdQueryUnbondingDelegations(queryRoute string, cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "unbonding-delegations [delegator-addr]",
 		Short: "Query all unbonding-delegations records for one delegator",
 		Long: strings.TrimSpace(
@@ -424,7 +584,15 @@ $ %s query staking unbonding-delegation cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld7
 				return err
 			}
 
-			bz, err := cdc.MarshalJSON(types.NewQueryDelegatorParams(delegatorAddr))
+			status, jailed, err := getValidatorFilter()
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryDelegatorParams(
+				delegatorAddr, viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit), status, jailed,
+			)
+			bz, err := cdc.MarshalJSON(params)
 			if err != nil {
 				return err
 			}
@@ -443,6 +611,11 @@ $ %s query staking unbonding-delegation cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld7
 			return cliCtx.PrintOutput(ubds)
 		},
 	}
+
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of unbonding delegations to query for")
+	cmd.Flags().Int(flags.FlagLimit, 0, "pagination limit of unbonding delegations to query for (0 for all)")
+	addValidatorFilterFlags(cmd)
+	return cmd
 }
 
 // GetCmdQueryRedelegation implements the command to query a single
@@ -479,7 +652,7 @@ $ %s query staking redelegation cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p co
 				return err
 			}
 
-			bz, err := cdc.MarshalJSON(types.NewQueryRedelegationParams(delAddr, valSrcAddr, valDstAddr))
+			bz, err := cdc.MarshalJSON(types.NewQueryRedelegationParams(delAddr, valSrcAddr, valDstAddr, 0, 0))
 			if err != nil {
 				return err
 			}
@@ -503,7 +676,7 @@ $ %s query staking redelegation cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p co
 // GetCmdQueryRedelegations implements the command to query all the
 // redelegation records for a delegator.
 func GetCmdQueryRedelegations(queryRoute string, cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "redelegations [delegator-addr]",
 		Args:  cobra.ExactArgs(1),
 		Short: "Query all redelegations records for one delegator",
@@ -524,7 +697,10 @@ $ %s query staking redelegation cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
 				return err
 			}
 
-			bz, err := cdc.MarshalJSON(types.QueryRedelegationParams{DelegatorAddr: delAddr})
+			params := types.NewQueryRedelegationParams(
+				delAddr, nil, nil, viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit),
+			)
+			bz, err := cdc.MarshalJSON(params)
 			if err != nil {
 				return err
 			}
@@ -543,6 +719,10 @@ $ %s query staking redelegation cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
 			return cliCtx.PrintOutput(resp)
 		},
 	}
+
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of redelegations to query for")
+	cmd.Flags().Int(flags.FlagLimit, 0, "pagination limit of redelegations to query for (0 for all)")
+	return cmd
 }
 
 // GetCmdQueryHistoricalInfo implements the historical info query command
@@ -589,6 +769,101 @@ $ %s query staking historical-info 5
 	}
 }
 
+// GetCmdQueryTokenizeShareRecords implements the tokenize share records query command.
+func GetCmdQueryTokenizeShareRecords(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tokenize-share-records",
+		Args:  cobra.NoArgs,
+		Short: "Query all the tokenize share records",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the records holding the tokenized delegations of the validators, along
+with the share token denomination of each validator.
+
+Example:
+$ %s query staking tokenize-share-records
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := types.NewQueryTokenizeShareRecordsParams(viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit))
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryTokenizeShareRecords)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var records []types.TokenizeShareRecord
+			if err := cdc.UnmarshalJSON(res, &records); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(records)
+		},
+	}
+
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of tokenize share records to query for")
+	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit of tokenize share records to query for")
+	return cmd
+}
+
+// GetCmdQueryDelegationLock implements the delegation lock query command.
+func GetCmdQueryDelegationLock(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "delegation-lock [delegator-addr] [validator-addr]",
+		Short: "Query the lock of a delegation based on address and validator address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the end time and reward multiplier of the lock of an individual
+delegator on an individual validator.
+
+Example:
+$ %s query staking delegation-lock cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			delAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryBondsParams(delAddr, valAddr))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryDelegationLock)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var lock types.DelegationLock
+			if err := cdc.UnmarshalJSON(res, &lock); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(lock)
+		},
+	}
+}
+
 // GetCmdQueryPool implements the pool query command.
 func GetCmdQueryPool(storeName string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -652,3 +927,31 @@ $ %s query staking params
 		},
 	}
 }
+
+// addValidatorFilterFlags adds the flags filtering the results of a query by
+// validator status and jail state.
+func addValidatorFilterFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagStatus, "", "only include validators with the given status (bonded|unbonding|unbonded)")
+	cmd.Flags().String(FlagJailed, "", "only include jailed (true) or unjailed (false) validators")
+}
+
+// getValidatorFilter returns the validator status and jail state filters
+// set by the flags.
+func getValidatorFilter() (string, *bool, error) {
+	status := viper.GetString(FlagStatus)
+	if err := types.ValidateValidatorFilter(status); err != nil {
+		return "", nil, err
+	}
+
+	jailedStr := viper.GetString(FlagJailed)
+	if jailedStr == "" {
+		return status, nil, nil
+	}
+
+	jailed, err := strconv.ParseBool(jailedStr)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid jailed filter %s: %w", jailedStr, err)
+	}
+
+	return status, &jailed, nil
+}
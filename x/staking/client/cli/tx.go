@@ -4,7 +4,9 @@ import (
 	"bufio"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	flag "github.com/spf13/pflag"
@@ -40,6 +42,12 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 		GetCmdDelegate(cdc),
 		GetCmdRedelegate(storeKey, cdc),
 		GetCmdUnbond(storeKey, cdc),
+		GetCmdCancelUnbond(cdc),
+		GetCmdRotateConsPubKey(cdc),
+		GetCmdRetireValidator(cdc),
+		GetCmdTokenizeShares(cdc),
+		GetCmdRedeemTokensForShares(cdc),
+		GetCmdLockDelegation(cdc),
 	)...)
 
 	return stakingTxCmd
@@ -255,6 +263,226 @@ $ %s tx staking unbond cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100s
 	}
 }
 
+// GetCmdCancelUnbond implements the command to cancel an unbonding delegation
+// entry and re-bond the tokens to the original validator.
+func GetCmdCancelUnbond(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel-unbond [validator-addr] [amount] [creation-height]",
+		Short: "Cancel an unbonding delegation and delegate back to the validator",
+		Args:  cobra.ExactArgs(3),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel (part of) the unbonding delegation entry created at the given height
+and delegate the tokens back to the original validator.
+
+Example:
+$ %s tx staking cancel-unbond cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100stake 2 --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(auth.DefaultTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			delAddr := cliCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoin(args[1])
+			if err != nil {
+				return err
+			}
+
+			creationHeight, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid creation height %s: %w", args[2], err)
+			}
+
+			msg := types.NewMsgCancelUnbondingDelegation(delAddr, valAddr, creationHeight, amount)
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdRotateConsPubKey implements the rotate validator consensus pubkey command.
+func GetCmdRotateConsPubKey(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "rotate-cons-pubkey [pubkey]",
+		Short: "Rotate the consensus public key of your validator",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Replace the consensus public key your validator signs blocks with. The key
+rotation fee is burned from the operator account and a validator can only
+rotate its key once per unbonding period.
+
+Example:
+$ %s tx staking rotate-cons-pubkey cosmosvalconspub1zcjduepq0vu2zgkgk49efa0nqwzndanq5m4c7pa3u4apz4g2r9gspqg6g9cs3k9cuf --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(auth.DefaultTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			pk, err := sdk.GetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, args[0])
+			if err != nil {
+				return err
+			}
+
+			valAddr := cliCtx.GetFromAddress()
+			msg := types.NewMsgRotateConsPubKey(sdk.ValAddress(valAddr), pk)
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdRetireValidator implements the retire validator command.
+func GetCmdRetireValidator(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "retire-validator",
+		Short: "Permanently retire your validator",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Permanently retire your validator. The validator is jailed and all of its
+delegations, including the self-delegation, start unbonding. The validator is
+removed once it is unbonded, after which the operator address can be used to
+create a new validator.
+
+Example:
+$ %s tx staking retire-validator --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(auth.DefaultTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			valAddr := cliCtx.GetFromAddress()
+			msg := types.NewMsgRetireValidator(sdk.ValAddress(valAddr))
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdTokenizeShares implements the tokenize delegation shares command.
+func GetCmdTokenizeShares(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "tokenize-share [validator-addr] [amount]",
+		Short: "Convert (part of) a delegation into transferable share tokens",
+		Args:  cobra.ExactArgs(2),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Convert the delegation shares worth the given amount of tokens into share
+tokens of the validator. Share tokens can be transferred and redeemed for a
+delegation to the validator by their holder.
+
+Example:
+$ %s tx staking tokenize-share cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 1000stake --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(auth.DefaultTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			delAddr := cliCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoin(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTokenizeShares(delAddr, valAddr, amount)
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdRedeemTokensForShares implements the redeem share tokens command.
+func GetCmdRedeemTokensForShares(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "redeem-tokens [amount]",
+		Short: "Redeem share tokens for a delegation to their validator",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Burn share tokens and receive the matching delegation shares of their
+validator, along with the share of the rewards accrued by the tokenized
+delegation since the share tokens were issued.
+
+Example:
+$ %s tx staking redeem-tokens 1000share/1 --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(auth.DefaultTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			amount, err := sdk.ParseCoin(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRedeemTokensForShares(cliCtx.GetFromAddress(), amount)
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdLockDelegation implements the lock delegation command.
+func GetCmdLockDelegation(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "lock-delegation [validator-addr] [duration]",
+		Short: "Lock a delegation for the duration of a lock tier in return for a reward multiplier",
+		Args:  cobra.ExactArgs(2),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Lock your delegation to a validator for the duration of one of the lock tiers
+of the staking parameters. The delegation cannot be unbonded, redelegated or
+tokenized until the lock ends, and earns the reward multiplier of the tier in
+the meantime. A lock can only be replaced by a lock which ends later.
+
+Example:
+$ %s tx staking lock-delegation cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 720h --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(auth.DefaultTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			delAddr := cliCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			lockDuration, err := time.ParseDuration(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid lock duration %s: %w", args[1], err)
+			}
+
+			msg := types.NewMsgLockDelegation(delAddr, valAddr, lockDuration)
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
 //__________________________________________________________
 
 var (
@@ -21,6 +21,18 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		delegatorDelegationsHandlerFn(cliCtx),
 	).Methods("GET")
 
+	// Get the delegation changes of a delegator
+	r.HandleFunc(
+		"/staking/delegators/{delegatorAddr}/delegation_changes",
+		delegatorDelegationChangesHandlerFn(cliCtx),
+	).Methods("GET")
+
+	// Get all delegations from a delegator at a past height
+	r.HandleFunc(
+		"/staking/delegators/{delegatorAddr}/delegations_at_height/{height}",
+		delegatorDelegationsAtHeightHandlerFn(cliCtx),
+	).Methods("GET")
+
 	// Get all unbonding delegations from a delegator
 	r.HandleFunc(
 		"/staking/delegators/{delegatorAddr}/unbonding_delegations",
@@ -51,6 +63,12 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		delegationHandlerFn(cliCtx),
 	).Methods("GET")
 
+	// Query the lock of a delegation between a delegator and a validator
+	r.HandleFunc(
+		"/staking/delegators/{delegatorAddr}/delegation_locks/{validatorAddr}",
+		delegationLockHandlerFn(cliCtx),
+	).Methods("GET")
+
 	// Query all unbonding delegations between a delegator and a validator
 	r.HandleFunc(
 		"/staking/delegators/{delegatorAddr}/unbonding_delegations/{validatorAddr}",
@@ -112,6 +130,86 @@ func delegatorDelegationsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return queryDelegator(cliCtx, fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDelegatorDelegations))
 }
 
+// HTTP request handler to query the delegation changes of a delegator
+func delegatorDelegationChangesHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, 0)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		delegatorAddr, err := sdk.AccAddressFromBech32(mux.Vars(r)["delegatorAddr"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		params := types.NewQueryDelegatorDelegationChangesParams(delegatorAddr, page, limit)
+		bz, err := cliCtx.Codec.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDelegatorDelegationChanges)
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// HTTP request handler to query a delegator delegations at a past height
+func delegatorDelegationsAtHeightHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, 0)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		vars := mux.Vars(r)
+		delegatorAddr, err := sdk.AccAddressFromBech32(vars["delegatorAddr"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		snapshotHeight, err := strconv.ParseInt(vars["height"], 10, 64)
+		if err != nil || snapshotHeight < 0 {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("Must provide non-negative integer for height: %v", err))
+			return
+		}
+
+		params := types.NewQueryDelegatorDelegationsAtHeightParams(delegatorAddr, snapshotHeight, page, limit)
+		bz, err := cliCtx.Codec.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDelegatorDelegationsAtHeight)
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 // HTTP request handler to query a delegator unbonding delegations
 func delegatorUnbondingDelegationsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return queryDelegator(cliCtx, "custom/staking/delegatorUnbondingDelegations")
@@ -199,6 +297,13 @@ func redelegationsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var params types.QueryRedelegationParams
 
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, 0)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		params.Page, params.Limit = page, limit
+
 		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
 		if !ok {
 			return
@@ -257,6 +362,11 @@ func delegationHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return queryBonds(cliCtx, fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDelegation))
 }
 
+// HTTP request handler to query the lock of a delegation
+func delegationLockHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return queryBonds(cliCtx, fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDelegationLock))
+}
+
 // HTTP request handler to query all delegator bonded validators
 func delegatorValidatorsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return queryDelegator(cliCtx, "custom/staking/delegatorValidators")
@@ -281,12 +391,17 @@ func validatorsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		status := r.FormValue("status")
+		status, jailed, err := parseValidatorFilter(r)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
 		if status == "" {
 			status = sdk.BondStatusBonded
 		}
 
-		params := types.NewQueryValidatorsParams(page, limit, status)
+		params := types.NewQueryValidatorsParams(page, limit, status, jailed)
 		bz, err := cliCtx.Codec.MarshalJSON(params)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -3,6 +3,7 @@ package rest
 import (
 	"bytes"
 	"net/http"
+	"time"
 
 	"github.com/gorilla/mux"
 
@@ -22,10 +23,18 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		"/staking/delegators/{delegatorAddr}/unbonding_delegations",
 		postUnbondingDelegationsHandlerFn(cliCtx),
 	).Methods("POST")
+	r.HandleFunc(
+		"/staking/delegators/{delegatorAddr}/unbonding_delegations/cancel",
+		postCancelUnbondingDelegationHandlerFn(cliCtx),
+	).Methods("POST")
 	r.HandleFunc(
 		"/staking/delegators/{delegatorAddr}/redelegations",
 		postRedelegationsHandlerFn(cliCtx),
 	).Methods("POST")
+	r.HandleFunc(
+		"/staking/delegators/{delegatorAddr}/delegation_locks",
+		postDelegationLocksHandlerFn(cliCtx),
+	).Methods("POST")
 }
 
 type (
@@ -53,6 +62,25 @@ type (
 		ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"` // in bech32
 		Amount           sdk.Coin       `json:"amount" yaml:"amount"`
 	}
+
+	// CancelUnbondingDelegationRequest defines the properties of a cancel
+	// unbonding delegation request's body.
+	CancelUnbondingDelegationRequest struct {
+		BaseReq          rest.BaseReq   `json:"base_req" yaml:"base_req"`
+		DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"` // in bech32
+		ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"` // in bech32
+		Amount           sdk.Coin       `json:"amount" yaml:"amount"`
+		CreationHeight   int64          `json:"creation_height,string" yaml:"creation_height"`
+	}
+
+	// LockDelegationRequest defines the properties of a lock delegation
+	// request's body.
+	LockDelegationRequest struct {
+		BaseReq          rest.BaseReq   `json:"base_req" yaml:"base_req"`
+		DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"` // in bech32
+		ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"` // in bech32
+		LockDuration     time.Duration  `json:"lock_duration" yaml:"lock_duration"`
+	}
 )
 
 func postDelegationsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
@@ -156,3 +184,73 @@ func postUnbondingDelegationsHandlerFn(cliCtx context.CLIContext) http.HandlerFu
 		authclient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+func postCancelUnbondingDelegationHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CancelUnbondingDelegationRequest
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		msg := types.NewMsgCancelUnbondingDelegation(
+			req.DelegatorAddress, req.ValidatorAddress, req.CreationHeight, req.Amount,
+		)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		if !bytes.Equal(fromAddr, req.DelegatorAddress) {
+			rest.WriteErrorResponse(w, http.StatusUnauthorized, "must use own delegator address")
+			return
+		}
+
+		authclient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func postDelegationLocksHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req LockDelegationRequest
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		msg := types.NewMsgLockDelegation(req.DelegatorAddress, req.ValidatorAddress, req.LockDuration)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		if !bytes.Equal(fromAddr, req.DelegatorAddress) {
+			rest.WriteErrorResponse(w, http.StatusUnauthorized, "must use own delegator address")
+			return
+		}
+
+		authclient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
@@ -3,6 +3,7 @@ package rest
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/gorilla/mux"
 
@@ -93,7 +94,19 @@ func queryDelegator(cliCtx context.CLIContext, endpoint string) http.HandlerFunc
 			return
 		}
 
-		params := types.NewQueryDelegatorParams(delegatorAddr)
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, 0)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		status, jailed, err := parseValidatorFilter(r)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		params := types.NewQueryDelegatorParams(delegatorAddr, page, limit, status, jailed)
 
 		bz, err := cliCtx.Codec.MarshalJSON(params)
 		if err != nil {
@@ -128,7 +141,13 @@ func queryValidator(cliCtx context.CLIContext, endpoint string) http.HandlerFunc
 			return
 		}
 
-		params := types.NewQueryValidatorParams(validatorAddr)
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, 0)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		params := types.NewQueryValidatorParams(validatorAddr, page, limit)
 
 		bz, err := cliCtx.Codec.MarshalJSON(params)
 		if err != nil {
@@ -146,3 +165,24 @@ func queryValidator(cliCtx context.CLIContext, endpoint string) http.HandlerFunc
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
+
+// parseValidatorFilter parses the validator status and jail state filters of
+// a query from the "status" and "jailed" query parameters
+func parseValidatorFilter(r *http.Request) (string, *bool, error) {
+	status := r.FormValue("status")
+	if err := types.ValidateValidatorFilter(status); err != nil {
+		return "", nil, err
+	}
+
+	jailedStr := r.FormValue("jailed")
+	if jailedStr == "" {
+		return status, nil, nil
+	}
+
+	jailed, err := strconv.ParseBool(jailedStr)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid jailed filter %s: %w", jailedStr, err)
+	}
+
+	return status, &jailed, nil
+}
@@ -16,6 +16,7 @@ type DelegationI interface {
 // ValidatorI expected validator functions
 type ValidatorI interface {
 	IsJailed() bool                                         // whether the validator is jailed
+	IsRetired() bool                                        // whether the validator is retired
 	GetMoniker() string                                     // moniker of the validator
 	GetStatus() sdk.BondStatus                              // status of the validator
 	IsBonded() bool                                         // check if has a bonded status
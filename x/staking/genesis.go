@@ -74,6 +74,13 @@ func InitGenesis(
 		}
 	}
 
+	// the remaining delegations of retired validators keep being unbonded
+	for _, validator := range data.Validators {
+		if validator.Retired && len(keeper.GetValidatorDelegationsPaginated(ctx, validator.OperatorAddress, 1, 1)) > 0 {
+			keeper.InsertRetirementQueue(ctx, validator.OperatorAddress)
+		}
+	}
+
 	for _, ubd := range data.UnbondingDelegations {
 		keeper.SetUnbondingDelegation(ctx, ubd)
 		for _, entry := range ubd.Entries {
@@ -89,6 +96,43 @@ func InitGenesis(
 		}
 	}
 
+	for _, rotation := range data.ConsPubKeyRotations {
+		validator, found := keeper.GetValidator(ctx, rotation.OperatorAddress)
+		if !found {
+			panic(fmt.Sprintf("validator %s not found", rotation.OperatorAddress))
+		}
+
+		// keep resolving the old consensus address to the validator until the
+		// rotation matures
+		validator.ConsensusPubkey = rotation.OldConsensusPubkey
+		keeper.SetValidatorByConsAddr(ctx, validator)
+		keeper.SetConsPubKeyRotation(ctx, rotation)
+	}
+
+	for _, record := range data.TokenizeShareRecords {
+		keeper.SetTokenizeShareRecord(ctx, record)
+
+		// rebuild the running totals of the tokenized delegations
+		if delegation, found := keeper.GetDelegation(ctx, record.ModuleAccount, record.ValidatorAddress); found {
+			validator, _ := keeper.GetValidator(ctx, record.ValidatorAddress)
+			keeper.AddTokenizedShares(ctx, validator, delegation.Shares)
+		}
+	}
+	// the exported total keeps the rounding of its running updates
+	if data.Exported && !data.TotalLiquidStakedTokens.IsNil() {
+		keeper.SetTotalLiquidStakedTokens(ctx, data.TotalLiquidStakedTokens)
+	}
+	keeper.SetLastTokenizeShareRecordID(ctx, data.LastTokenizeShareRecordID)
+
+	for _, change := range data.DelegationChanges {
+		keeper.SetDelegationChange(ctx, change)
+	}
+
+	for _, lock := range data.DelegationLocks {
+		keeper.SetDelegationLock(ctx, lock)
+		keeper.InsertDelegationLockQueue(ctx, lock)
+	}
+
 	bondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, bondedTokens))
 	notBondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, notBondedTokens))
 
@@ -165,14 +209,20 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 	})
 
 	return types.GenesisState{
-		Params:               params,
-		LastTotalPower:       lastTotalPower,
-		LastValidatorPowers:  lastValidatorPowers,
-		Validators:           validators,
-		Delegations:          delegations,
-		UnbondingDelegations: unbondingDelegations,
-		Redelegations:        redelegations,
-		Exported:             true,
+		Params:                    params,
+		LastTotalPower:            lastTotalPower,
+		LastValidatorPowers:       lastValidatorPowers,
+		Validators:                validators,
+		Delegations:               delegations,
+		UnbondingDelegations:      unbondingDelegations,
+		Redelegations:             redelegations,
+		ConsPubKeyRotations:       keeper.GetAllConsPubKeyRotations(ctx),
+		TokenizeShareRecords:      keeper.GetAllTokenizeShareRecords(ctx),
+		LastTokenizeShareRecordID: keeper.GetLastTokenizeShareRecordID(ctx),
+		TotalLiquidStakedTokens:   keeper.GetTotalLiquidStakedTokens(ctx),
+		DelegationChanges:         keeper.GetAllDelegationChanges(ctx),
+		DelegationLocks:           keeper.GetAllDelegationLocks(ctx),
+		Exported:                  true,
 	}
 }
 
@@ -202,6 +252,10 @@ func ValidateGenesis(data types.GenesisState) error {
 	if err != nil {
 		return err
 	}
+	err = validateGenesisStateDelegationLocks(data.DelegationLocks)
+	if err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -227,3 +281,24 @@ func validateGenesisStateValidators(validators []types.Validator) (err error) {
 
 	return
 }
+
+func validateGenesisStateDelegationLocks(locks []types.DelegationLock) error {
+	lockMap := make(map[string]bool, len(locks))
+	for _, lock := range locks {
+		if lock.DelegatorAddress.Empty() || lock.ValidatorAddress.Empty() {
+			return fmt.Errorf("delegation lock without delegator or validator address in genesis state: %v", lock)
+		}
+		if lock.RewardMultiplier.IsNil() || lock.RewardMultiplier.LT(sdk.OneDec()) {
+			return fmt.Errorf("delegation lock reward multiplier must be at least one in genesis state: %v", lock)
+		}
+
+		strKey := string(types.GetDelegationLockKey(lock.DelegatorAddress, lock.ValidatorAddress))
+		if lockMap[strKey] {
+			return fmt.Errorf("duplicate delegation lock in genesis state: delegator %s, validator %s", lock.DelegatorAddress, lock.ValidatorAddress)
+		}
+
+		lockMap[strKey] = true
+	}
+
+	return nil
+}
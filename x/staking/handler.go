@@ -1,6 +1,7 @@
 package staking
 
 import (
+	"fmt"
 	"time"
 
 	gogotypes "github.com/gogo/protobuf/types"
@@ -33,6 +34,24 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case types.MsgUndelegate:
 			return handleMsgUndelegate(ctx, msg, k)
 
+		case types.MsgCancelUnbondingDelegation:
+			return handleMsgCancelUnbondingDelegation(ctx, msg, k)
+
+		case types.MsgRotateConsPubKey:
+			return handleMsgRotateConsPubKey(ctx, msg, k)
+
+		case types.MsgRetireValidator:
+			return handleMsgRetireValidator(ctx, msg, k)
+
+		case types.MsgTokenizeShares:
+			return handleMsgTokenizeShares(ctx, msg, k)
+
+		case types.MsgRedeemTokensForShares:
+			return handleMsgRedeemTokensForShares(ctx, msg, k)
+
+		case types.MsgLockDelegation:
+			return handleMsgLockDelegation(ctx, msg, k)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", ModuleName, msg)
 		}
@@ -75,6 +94,14 @@ func handleMsgCreateValidator(ctx sdk.Context, msg types.MsgCreateValidator, k k
 		}
 	}
 
+	if msg.Commission.Rate.LT(k.MinCommissionRate(ctx)) {
+		return nil, sdkerrors.Wrapf(ErrCommissionLTMinRate, "minimum: %s", k.MinCommissionRate(ctx))
+	}
+
+	if msg.Commission.MaxChangeRate.GT(k.MaxCommissionChangeRate(ctx)) {
+		return nil, sdkerrors.Wrapf(ErrCommissionGTMaxChangeRate, "maximum: %s", k.MaxCommissionChangeRate(ctx))
+	}
+
 	validator := NewValidator(msg.ValidatorAddress, pk, msg.Description)
 	commission := NewCommissionWithTime(
 		msg.Commission.Rate, msg.Commission.MaxRate,
@@ -126,6 +153,10 @@ func handleMsgEditValidator(ctx sdk.Context, msg types.MsgEditValidator, k keepe
 		return nil, ErrNoValidatorFound
 	}
 
+	if validator.Retired {
+		return nil, ErrValidatorRetired
+	}
+
 	// replace all editable fields (clients should autofill existing values)
 	description, err := validator.Description.UpdateDescription(msg.Description)
 	if err != nil {
@@ -247,6 +278,183 @@ func handleMsgUndelegate(ctx sdk.Context, msg types.MsgUndelegate, k keeper.Keep
 	return &sdk.Result{Data: completionTimeBz, Events: ctx.EventManager().Events()}, nil
 }
 
+func handleMsgCancelUnbondingDelegation(
+	ctx sdk.Context, msg types.MsgCancelUnbondingDelegation, k keeper.Keeper,
+) (*sdk.Result, error) {
+
+	if msg.Amount.Denom != k.BondDenom(ctx) {
+		return nil, ErrBadDenom
+	}
+
+	err := k.CancelUnbondingDelegation(
+		ctx, msg.DelegatorAddress, msg.ValidatorAddress, msg.CreationHeight, msg.Amount.Amount,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelUnbondingDelegation,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCreationHeight, fmt.Sprintf("%d", msg.CreationHeight)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgRotateConsPubKey(ctx sdk.Context, msg types.MsgRotateConsPubKey, k keeper.Keeper) (*sdk.Result, error) {
+	validator, found := k.GetValidator(ctx, msg.ValidatorAddress)
+	if !found {
+		return nil, ErrNoValidatorFound
+	}
+
+	if validator.Retired {
+		return nil, ErrValidatorRetired
+	}
+
+	pk, err := sdk.GetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, msg.NewPubkey)
+	if err != nil {
+		return nil, err
+	}
+
+	// the new key must not belong, or have recently belonged, to any validator
+	if _, found := k.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(pk)); found {
+		return nil, ErrValidatorPubKeyExists
+	}
+
+	if ctx.ConsensusParams() != nil {
+		tmPubKey := tmtypes.TM2PB.PubKey(pk)
+		if !tmstrings.StringInSlice(tmPubKey.Type, ctx.ConsensusParams().Validator.PubKeyTypes) {
+			return nil, sdkerrors.Wrapf(
+				ErrValidatorPubKeyTypeNotSupported,
+				"got: %s, expected: %s", tmPubKey.Type, ctx.ConsensusParams().Validator.PubKeyTypes,
+			)
+		}
+	}
+
+	oldPubKey := validator.ConsensusPubkey
+	if err := k.RotateConsPubKey(ctx, validator, pk); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRotateConsPubKey,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyOldConsPubKey, oldPubKey),
+			sdk.NewAttribute(types.AttributeKeyNewConsPubKey, msg.NewPubkey),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sdk.AccAddress(msg.ValidatorAddress).String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgRetireValidator(ctx sdk.Context, msg types.MsgRetireValidator, k keeper.Keeper) (*sdk.Result, error) {
+	validator, found := k.GetValidator(ctx, msg.ValidatorAddress)
+	if !found {
+		return nil, ErrNoValidatorFound
+	}
+
+	completionTime, err := k.RetireValidator(ctx, validator)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRetireValidator,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sdk.AccAddress(msg.ValidatorAddress).String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgTokenizeShares(ctx sdk.Context, msg types.MsgTokenizeShares, k keeper.Keeper) (*sdk.Result, error) {
+	if msg.Amount.Denom != k.BondDenom(ctx) {
+		return nil, ErrBadDenom
+	}
+
+	validator, found := k.GetValidator(ctx, msg.ValidatorAddress)
+	if !found {
+		return nil, ErrNoValidatorFound
+	}
+
+	shares, err := k.ValidateUnbondAmount(
+		ctx, msg.DelegatorAddress, msg.ValidatorAddress, msg.Amount.Amount,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	shareTokens, err := k.TokenizeShares(ctx, msg.DelegatorAddress, validator, shares)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTokenizeShares,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyShareTokens, shareTokens.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgRedeemTokensForShares(
+	ctx sdk.Context, msg types.MsgRedeemTokensForShares, k keeper.Keeper,
+) (*sdk.Result, error) {
+
+	shares, rewards, err := k.RedeemTokensForShares(ctx, msg.DelegatorAddress, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRedeemShares,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyShareTokens, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyShares, shares.String()),
+			sdk.NewAttribute(types.AttributeKeyRewards, rewards.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
 func handleMsgBeginRedelegate(ctx sdk.Context, msg types.MsgBeginRedelegate, k keeper.Keeper) (*sdk.Result, error) {
 	shares, err := k.ValidateUnbondAmount(
 		ctx, msg.DelegatorAddress, msg.ValidatorSrcAddress, msg.Amount.Amount,
@@ -289,3 +497,26 @@ func handleMsgBeginRedelegate(ctx sdk.Context, msg types.MsgBeginRedelegate, k k
 
 	return &sdk.Result{Data: completionTimeBz, Events: ctx.EventManager().Events()}, nil
 }
+
+func handleMsgLockDelegation(ctx sdk.Context, msg types.MsgLockDelegation, k keeper.Keeper) (*sdk.Result, error) {
+	lock, err := k.LockDelegation(ctx, msg.DelegatorAddress, msg.ValidatorAddress, msg.LockDuration)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeLockDelegation,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyLockEndTime, lock.EndTime.Format(time.RFC3339)),
+			sdk.NewAttribute(types.AttributeKeyRewardMultiplier, lock.RewardMultiplier.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
@@ -13,6 +13,8 @@ import (
 	tmtypes "github.com/tendermint/tendermint/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
 	keep "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -945,44 +947,80 @@ func TestTransitiveRedelegation(t *testing.T) {
 	ctx = ctx.WithBlockTime(blockTime)
 
 	// create the validators
-	msgCreateValidator := NewTestMsgCreateValidator(validatorAddr, keep.PKs[0], sdk.NewInt(10))
+	valTokens := sdk.TokensFromConsensusPower(10)
+	msgCreateValidator := NewTestMsgCreateValidator(validatorAddr, keep.PKs[0], valTokens)
 	res, err := handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
-	msgCreateValidator = NewTestMsgCreateValidator(validatorAddr2, keep.PKs[1], sdk.NewInt(10))
+	msgCreateValidator = NewTestMsgCreateValidator(validatorAddr2, keep.PKs[1], valTokens)
 	res, err = handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
-	msgCreateValidator = NewTestMsgCreateValidator(validatorAddr3, keep.PKs[2], sdk.NewInt(10))
+	msgCreateValidator = NewTestMsgCreateValidator(validatorAddr3, keep.PKs[2], valTokens)
 	res, err = handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
+	// bond the validators
+	EndBlocker(ctx, keeper)
+
 	// begin redelegate
-	redAmt := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10))
+	redAmt := sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(5))
 	msgBeginRedelegate := NewMsgBeginRedelegate(sdk.AccAddress(validatorAddr), validatorAddr, validatorAddr2, redAmt)
 	res, err = handleMsgBeginRedelegate(ctx, msgBeginRedelegate, keeper)
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
-	// cannot redelegation to next validator while first delegation exists
+	red, found := keeper.GetRedelegation(ctx, sdk.AccAddress(validatorAddr), validatorAddr, validatorAddr2)
+	require.True(t, found)
+	require.Len(t, red.Entries, 1)
+	entry := red.Entries[0]
+
+	// redelegate to the next validator while the first redelegation exists
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(blockTime.Add(time.Hour))
 	msgBeginRedelegate = NewMsgBeginRedelegate(sdk.AccAddress(validatorAddr), validatorAddr2, validatorAddr3, redAmt)
 	res, err = handleMsgBeginRedelegate(ctx, msgBeginRedelegate, keeper)
-	require.Error(t, err)
-	require.Nil(t, res)
+	require.NoError(t, err)
+	require.NotNil(t, res)
 
-	params := keeper.GetParams(ctx)
-	ctx = ctx.WithBlockTime(blockTime.Add(params.UnbondingTime))
+	// the first redelegation entry is carried over to the third validator
+	_, found = keeper.GetRedelegation(ctx, sdk.AccAddress(validatorAddr), validatorAddr, validatorAddr2)
+	require.False(t, found)
 
-	// complete first redelegation
-	EndBlocker(ctx, keeper)
+	red, found = keeper.GetRedelegation(ctx, sdk.AccAddress(validatorAddr), validatorAddr, validatorAddr3)
+	require.True(t, found)
+	require.Equal(t, []types.RedelegationEntry{entry}, red.Entries)
 
-	// now should be able to redelegate from the second validator to the third
+	red, found = keeper.GetRedelegation(ctx, sdk.AccAddress(validatorAddr), validatorAddr2, validatorAddr3)
+	require.True(t, found)
+	require.Len(t, red.Entries, 1)
+
+	// redelegating back to the first validator drops the entry carried over
+	// from it, while the entry from the second validator is carried over again
+	msgBeginRedelegate = NewMsgBeginRedelegate(sdk.AccAddress(validatorAddr), validatorAddr3, validatorAddr, redAmt)
 	res, err = handleMsgBeginRedelegate(ctx, msgBeginRedelegate, keeper)
 	require.NoError(t, err)
 	require.NotNil(t, res)
+
+	_, found = keeper.GetRedelegation(ctx, sdk.AccAddress(validatorAddr), validatorAddr, validatorAddr3)
+	require.False(t, found)
+
+	red, found = keeper.GetRedelegation(ctx, sdk.AccAddress(validatorAddr), validatorAddr2, validatorAddr)
+	require.True(t, found)
+	require.Len(t, red.Entries, 1)
+
+	red, found = keeper.GetRedelegation(ctx, sdk.AccAddress(validatorAddr), validatorAddr3, validatorAddr)
+	require.True(t, found)
+	require.Len(t, red.Entries, 1)
+
+	params := keeper.GetParams(ctx)
+	ctx = ctx.WithBlockTime(blockTime.Add(params.UnbondingTime).Add(time.Hour))
+
+	// complete all the redelegations
+	EndBlocker(ctx, keeper)
+	require.Empty(t, keeper.GetRedelegations(ctx, sdk.AccAddress(validatorAddr), 10))
 }
 
 func TestMultipleRedelegationAtSameTime(t *testing.T) {
@@ -1373,6 +1411,518 @@ func TestBondUnbondRedelegateSlashTwice(t *testing.T) {
 	require.Equal(t, validator.GetStatus(), sdk.Unbonding)
 }
 
+func TestCancelUnbondingDelegation(t *testing.T) {
+	ctx, _, bk, keeper, _ := keep.CreateTestInput(t, false, 1000)
+	valAddr, del := sdk.ValAddress(keep.Addrs[0]), keep.Addrs[1]
+
+	params := keeper.GetParams(ctx)
+	params.MaxEntries = 1
+	keeper.SetParams(ctx, params)
+
+	valTokens := sdk.TokensFromConsensusPower(10)
+	msgCreateValidator := NewTestMsgCreateValidator(valAddr, keep.PKs[0], valTokens)
+	res, err := handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	msgDelegate := NewTestMsgDelegate(del, valAddr, valTokens)
+	res, err = handleMsgDelegate(ctx, msgDelegate, keeper)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	EndBlocker(ctx, keeper)
+	ctx = ctx.WithBlockHeight(1)
+
+	// begin unbonding 4 stake
+	unbondAmt := sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(4))
+	res, err = handleMsgUndelegate(ctx, NewMsgUndelegate(del, valAddr, unbondAmt), keeper)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// the entry limit is reached
+	_, err = handleMsgUndelegate(ctx, NewMsgUndelegate(del, valAddr, unbondAmt), keeper)
+	require.Error(t, err)
+
+	// an entry must exist at the given height
+	cancelAmt := sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(1))
+	_, err = handleMsgCancelUnbondingDelegation(ctx, NewMsgCancelUnbondingDelegation(del, valAddr, 2, cancelAmt), keeper)
+	require.True(t, types.ErrNoUnbondingDelegationEntry.Is(err))
+
+	// the amount cannot exceed the entry balance
+	tooMuch := sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(5))
+	_, err = handleMsgCancelUnbondingDelegation(ctx, NewMsgCancelUnbondingDelegation(del, valAddr, 1, tooMuch), keeper)
+	require.True(t, types.ErrUnbondingEntryBalanceExceeded.Is(err))
+
+	// partially cancel the entry
+	res, err = handleMsgCancelUnbondingDelegation(ctx, NewMsgCancelUnbondingDelegation(del, valAddr, 1, cancelAmt), keeper)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	ubd, found := keeper.GetUnbondingDelegation(ctx, del, valAddr)
+	require.True(t, found)
+	require.Len(t, ubd.Entries, 1)
+	require.Equal(t, sdk.TokensFromConsensusPower(3), ubd.Entries[0].Balance)
+	require.Equal(t, sdk.TokensFromConsensusPower(3), ubd.Entries[0].InitialBalance)
+
+	delegation, found := keeper.GetDelegation(ctx, del, valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecFromInt(sdk.TokensFromConsensusPower(7)), delegation.Shares)
+
+	// slash the validator by half, the unbonding entry is slashed as well
+	consAddr := sdk.ConsAddress(keep.PKs[0].Address())
+	keeper.Slash(ctx, consAddr, 0, 20, sdk.NewDecWithPrec(5, 1))
+
+	ubd, found = keeper.GetUnbondingDelegation(ctx, del, valAddr)
+	require.True(t, found)
+	slashedBalance := ubd.Entries[0].Balance
+	require.True(t, slashedBalance.LT(sdk.TokensFromConsensusPower(3)))
+
+	// only the slashed balance can be cancelled
+	remaining := sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(3))
+	_, err = handleMsgCancelUnbondingDelegation(ctx, NewMsgCancelUnbondingDelegation(del, valAddr, 1, remaining), keeper)
+	require.True(t, types.ErrUnbondingEntryBalanceExceeded.Is(err))
+
+	bondedPoolBefore := bk.GetBalance(ctx, keeper.GetBondedPool(ctx).GetAddress(), sdk.DefaultBondDenom).Amount
+	remaining = sdk.NewCoin(sdk.DefaultBondDenom, slashedBalance)
+	res, err = handleMsgCancelUnbondingDelegation(ctx, NewMsgCancelUnbondingDelegation(del, valAddr, 1, remaining), keeper)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// the tokens are moved back to the bonded pool
+	bondedPoolAfter := bk.GetBalance(ctx, keeper.GetBondedPool(ctx).GetAddress(), sdk.DefaultBondDenom).Amount
+	require.Equal(t, slashedBalance, bondedPoolAfter.Sub(bondedPoolBefore))
+
+	// the entry and the unbonding delegation are removed, freeing an entry slot
+	_, found = keeper.GetUnbondingDelegation(ctx, del, valAddr)
+	require.False(t, found)
+
+	res, err = handleMsgUndelegate(ctx, NewMsgUndelegate(del, valAddr, unbondAmt), keeper)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// the cancelled entry is skipped when its queue slot matures
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(params.UnbondingTime))
+	EndBlocker(ctx, keeper)
+
+	_, found = keeper.GetUnbondingDelegation(ctx, del, valAddr)
+	require.False(t, found)
+}
+
+func TestRotateConsPubKey(t *testing.T) {
+	ctx, _, bk, keeper, _ := keep.CreateTestInput(t, false, 1000)
+	valAddr := sdk.ValAddress(keep.Addrs[0])
+	oldPK, newPK := keep.PKs[0], keep.PKs[1]
+	params := keeper.GetParams(ctx)
+
+	valTokens := sdk.TokensFromConsensusPower(10)
+	msgCreateValidator := NewTestMsgCreateValidator(valAddr, oldPK, valTokens)
+	res, err := handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	updates := EndBlocker(ctx, keeper)
+	require.Len(t, updates, 1)
+
+	// the new key cannot be used by another validator
+	_, err = handleMsgRotateConsPubKey(ctx, NewMsgRotateConsPubKey(valAddr, oldPK), keeper)
+	require.True(t, ErrValidatorPubKeyExists.Is(err))
+
+	balanceBefore := bk.GetBalance(ctx, sdk.AccAddress(valAddr), sdk.DefaultBondDenom).Amount
+	res, err = handleMsgRotateConsPubKey(ctx, NewMsgRotateConsPubKey(valAddr, newPK), keeper)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// the rotation fee is charged to the operator
+	balanceAfter := bk.GetBalance(ctx, sdk.AccAddress(valAddr), sdk.DefaultBondDenom).Amount
+	require.Equal(t, params.KeyRotationFee, balanceBefore.Sub(balanceAfter))
+
+	validator, found := keeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.Equal(t, newPK, validator.GetConsPubKey())
+
+	// both the old and the new consensus addresses resolve to the validator
+	_, found = keeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(oldPK))
+	require.True(t, found)
+	_, found = keeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(newPK))
+	require.True(t, found)
+
+	// the old key is replaced by the new one in the tendermint validator set
+	updates = EndBlocker(ctx, keeper)
+	require.Len(t, updates, 2)
+	require.Equal(t, tmtypes.TM2PB.PubKey(oldPK), updates[0].PubKey)
+	require.Equal(t, int64(0), updates[0].Power)
+	require.Equal(t, validator.ABCIValidatorUpdate(), updates[1])
+
+	updates = EndBlocker(ctx, keeper)
+	require.Len(t, updates, 0)
+
+	// only one rotation is allowed per unbonding period
+	_, err = handleMsgRotateConsPubKey(ctx, NewMsgRotateConsPubKey(valAddr, keep.PKs[2]), keeper)
+	require.True(t, ErrConsPubKeyRotationInProgress.Is(err))
+
+	// the old consensus address is released once the rotation matures
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(params.UnbondingTime))
+	EndBlocker(ctx, keeper)
+
+	_, found = keeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(oldPK))
+	require.False(t, found)
+	_, found = keeper.GetConsPubKeyRotation(ctx, valAddr)
+	require.False(t, found)
+
+	res, err = handleMsgRotateConsPubKey(ctx, NewMsgRotateConsPubKey(valAddr, keep.PKs[2]), keeper)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+}
+
+func TestRetireValidator(t *testing.T) {
+	ctx, _, bk, keeper, _ := keep.CreateTestInput(t, false, 1000)
+	valAddr, delAddr := sdk.ValAddress(keep.Addrs[0]), keep.Addrs[1]
+	params := keeper.GetParams(ctx)
+
+	valTokens := sdk.TokensFromConsensusPower(10)
+	res, err := handleMsgCreateValidator(ctx, NewTestMsgCreateValidator(valAddr, keep.PKs[0], valTokens), keeper)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	delTokens := sdk.TokensFromConsensusPower(10)
+	res, err = handleMsgDelegate(ctx, NewTestMsgDelegate(delAddr, valAddr, delTokens), keeper)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	updates := EndBlocker(ctx, keeper)
+	require.Len(t, updates, 1)
+
+	valBalance := bk.GetBalance(ctx, sdk.AccAddress(valAddr), params.BondDenom).Amount
+	delBalance := bk.GetBalance(ctx, delAddr, params.BondDenom).Amount
+
+	res, err = handleMsgRetireValidator(ctx, NewMsgRetireValidator(valAddr), keeper)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// the validator is jailed and all of its delegations are unbonding
+	validator, found := keeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.True(t, validator.Retired)
+	require.True(t, validator.Jailed)
+	require.True(t, validator.Tokens.IsZero())
+	require.Empty(t, keeper.GetValidatorDelegations(ctx, valAddr))
+
+	ubd, found := keeper.GetUnbondingDelegation(ctx, sdk.AccAddress(valAddr), valAddr)
+	require.True(t, found)
+	require.Equal(t, valTokens, ubd.Entries[0].Balance)
+	ubd, found = keeper.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	require.Equal(t, delTokens, ubd.Entries[0].Balance)
+
+	// the validator leaves the validator set
+	updates = EndBlocker(ctx, keeper)
+	require.Len(t, updates, 1)
+	require.Equal(t, int64(0), updates[0].Power)
+
+	// retired validators cannot be retired again, edited or delegated to
+	_, err = handleMsgRetireValidator(ctx, NewMsgRetireValidator(valAddr), keeper)
+	require.True(t, ErrValidatorRetired.Is(err))
+	_, err = handleMsgEditValidator(ctx, NewMsgEditValidator(valAddr, Description{Moniker: "retired"}, nil, nil), keeper)
+	require.True(t, ErrValidatorRetired.Is(err))
+	_, err = handleMsgDelegate(ctx, NewTestMsgDelegate(delAddr, valAddr, delTokens), keeper)
+	require.True(t, ErrValidatorRetired.Is(err))
+
+	// the operator address cannot be reused until the validator is removed
+	_, err = handleMsgCreateValidator(ctx, NewTestMsgCreateValidator(valAddr, keep.PKs[1], valTokens), keeper)
+	require.True(t, ErrValidatorOwnerExists.Is(err))
+
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(params.UnbondingTime))
+	EndBlocker(ctx, keeper)
+
+	_, found = keeper.GetValidator(ctx, valAddr)
+	require.False(t, found)
+	require.Equal(t, valBalance.Add(valTokens), bk.GetBalance(ctx, sdk.AccAddress(valAddr), params.BondDenom).Amount)
+	require.Equal(t, delBalance.Add(delTokens), bk.GetBalance(ctx, delAddr, params.BondDenom).Amount)
+
+	res, err = handleMsgCreateValidator(ctx, NewTestMsgCreateValidator(valAddr, keep.PKs[1], valTokens), keeper)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	validator, found = keeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.False(t, validator.Retired)
+}
+
+func TestMinCommissionRate(t *testing.T) {
+	ctx, _, _, keeper, _ := keep.CreateTestInput(t, false, 1000)
+	valAddr := sdk.ValAddress(keep.Addrs[0])
+	valTokens := sdk.TokensFromConsensusPower(10)
+
+	params := keeper.GetParams(ctx)
+	params.MinCommissionRate = sdk.NewDecWithPrec(5, 2)
+	keeper.SetParams(ctx, params)
+
+	// validators cannot be created with a commission rate below the minimum
+	msgCreateValidator := NewTestMsgCreateValidatorWithCommission(valAddr, keep.PKs[0], valTokens, sdk.NewDecWithPrec(1, 2))
+	_, err := handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
+	require.True(t, ErrCommissionLTMinRate.Is(err))
+
+	msgCreateValidator = NewTestMsgCreateValidatorWithCommission(valAddr, keep.PKs[0], valTokens, sdk.NewDecWithPrec(1, 1))
+	res, err := handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// nor lower their commission rate below the minimum
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(24 * time.Hour))
+	newRate := sdk.NewDecWithPrec(4, 2)
+	_, err = handleMsgEditValidator(ctx, NewMsgEditValidator(valAddr, Description{}, &newRate, nil), keeper)
+	require.True(t, ErrCommissionLTMinRate.Is(err))
+
+	newRate = sdk.NewDecWithPrec(5, 2)
+	res, err = handleMsgEditValidator(ctx, NewMsgEditValidator(valAddr, Description{}, &newRate, nil), keeper)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+}
+
+func TestMaxCommissionChangeRate(t *testing.T) {
+	ctx, _, _, keeper, _ := keep.CreateTestInput(t, false, 1000)
+	valAddr := sdk.ValAddress(keep.Addrs[0])
+	valCoin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10))
+
+	params := keeper.GetParams(ctx)
+	params.MaxCommissionChangeRate = sdk.NewDecWithPrec(5, 2)
+	keeper.SetParams(ctx, params)
+
+	// validators cannot be created with a max change rate above the maximum
+	commission := NewCommissionRates(sdk.NewDecWithPrec(1, 1), sdk.OneDec(), sdk.NewDecWithPrec(1, 1))
+	msgCreateValidator := NewMsgCreateValidator(valAddr, keep.PKs[0], valCoin, Description{}, commission, sdk.OneInt())
+	_, err := handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
+	require.True(t, ErrCommissionGTMaxChangeRate.Is(err))
+
+	commission = NewCommissionRates(sdk.NewDecWithPrec(1, 1), sdk.OneDec(), sdk.NewDecWithPrec(5, 2))
+	msgCreateValidator = NewMsgCreateValidator(valAddr, keep.PKs[0], valCoin, Description{}, commission, sdk.OneInt())
+	res, err := handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// nor lower their commission rate by more than the maximum
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(24 * time.Hour))
+	newRate := sdk.NewDecWithPrec(4, 2)
+	_, err = handleMsgEditValidator(ctx, NewMsgEditValidator(valAddr, Description{}, &newRate, nil), keeper)
+	require.True(t, ErrCommissionGTMaxChangeRate.Is(err))
+
+	newRate = sdk.NewDecWithPrec(5, 2)
+	res, err = handleMsgEditValidator(ctx, NewMsgEditValidator(valAddr, Description{}, &newRate, nil), keeper)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+}
+
+func TestTokenizeShares(t *testing.T) {
+	ctx, _, bk, keeper, _ := keep.CreateTestInput(t, false, 1000)
+	valAddr, delAddr, holderAddr := sdk.ValAddress(keep.Addrs[0]), keep.Addrs[1], keep.Addrs[2]
+
+	valTokens := sdk.TokensFromConsensusPower(10)
+	res, err := handleMsgCreateValidator(ctx, NewTestMsgCreateValidator(valAddr, keep.PKs[0], valTokens), keeper)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	delTokens := sdk.TokensFromConsensusPower(10)
+	res, err = handleMsgDelegate(ctx, NewTestMsgDelegate(delAddr, valAddr, delTokens), keeper)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	EndBlocker(ctx, keeper)
+
+	// the operator cannot tokenize its self-delegation
+	tokenizeTokens := sdk.TokensFromConsensusPower(4)
+	amount := sdk.NewCoin(sdk.DefaultBondDenom, tokenizeTokens)
+	_, err = handleMsgTokenizeShares(ctx, NewMsgTokenizeShares(sdk.AccAddress(valAddr), valAddr, amount), keeper)
+	require.True(t, ErrTokenizeSelfDelegation.Is(err))
+
+	// the liquid staking caps are enforced
+	params := keeper.GetParams(ctx)
+	params.ValidatorLiquidStakingCap = sdk.NewDecWithPrec(1, 1)
+	keeper.SetParams(ctx, params)
+	_, err = handleMsgTokenizeShares(ctx, NewMsgTokenizeShares(delAddr, valAddr, amount), keeper)
+	require.True(t, ErrValidatorLiquidStakingCapExceeded.Is(err))
+
+	params.ValidatorLiquidStakingCap = sdk.OneDec()
+	params.GlobalLiquidStakingCap = sdk.NewDecWithPrec(1, 1)
+	keeper.SetParams(ctx, params)
+	_, err = handleMsgTokenizeShares(ctx, NewMsgTokenizeShares(delAddr, valAddr, amount), keeper)
+	require.True(t, ErrGlobalLiquidStakingCapExceeded.Is(err))
+
+	params.GlobalLiquidStakingCap = sdk.OneDec()
+	keeper.SetParams(ctx, params)
+	res, err = handleMsgTokenizeShares(ctx, NewMsgTokenizeShares(delAddr, valAddr, amount), keeper)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// the shares are moved to the record and the share tokens minted to the delegator
+	records := keeper.GetTokenizeShareRecordsByValidator(ctx, valAddr)
+	require.Len(t, records, 1)
+	record := records[0]
+	require.Equal(t, "share/1", record.GetShareTokenDenom())
+	require.Equal(t, tokenizeTokens, bk.GetBalance(ctx, delAddr, record.GetShareTokenDenom()).Amount)
+
+	delegation, found := keeper.GetDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	require.Equal(t, delTokens.Sub(tokenizeTokens).ToDec(), delegation.Shares)
+
+	delegation, found = keeper.GetDelegation(ctx, record.ModuleAccount, valAddr)
+	require.True(t, found)
+	require.Equal(t, tokenizeTokens.ToDec(), delegation.Shares)
+
+	// the validator tokens are unchanged
+	validator, found := keeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.Equal(t, valTokens.Add(delTokens), validator.Tokens)
+
+	// the running totals of the tokenized delegations are updated
+	require.Equal(t, tokenizeTokens.ToDec(), keeper.GetValidatorTokenizedShares(ctx, valAddr))
+	require.Equal(t, tokenizeTokens.ToDec(), keeper.GetTotalLiquidStakedTokens(ctx))
+
+	// the share tokens can be transferred and redeemed by their holder
+	redeemTokens := tokenizeTokens.QuoRaw(2)
+	shareTokens := sdk.NewCoins(sdk.NewCoin(record.GetShareTokenDenom(), redeemTokens))
+	require.NoError(t, bk.SetBalances(ctx, delAddr, bk.GetAllBalances(ctx, delAddr).Sub(shareTokens)))
+	require.NoError(t, bk.SetBalances(ctx, holderAddr, bk.GetAllBalances(ctx, holderAddr).Add(shareTokens...)))
+
+	res, err = handleMsgRedeemTokensForShares(ctx, NewMsgRedeemTokensForShares(holderAddr, shareTokens[0]), keeper)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	require.True(t, bk.GetBalance(ctx, holderAddr, record.GetShareTokenDenom()).IsZero())
+
+	delegation, found = keeper.GetDelegation(ctx, holderAddr, valAddr)
+	require.True(t, found)
+	require.Equal(t, redeemTokens.ToDec(), delegation.Shares)
+
+	delegation, found = keeper.GetDelegation(ctx, record.ModuleAccount, valAddr)
+	require.True(t, found)
+	require.Equal(t, tokenizeTokens.Sub(redeemTokens).ToDec(), delegation.Shares)
+
+	require.Equal(t, tokenizeTokens.Sub(redeemTokens).ToDec(), keeper.GetValidatorTokenizedShares(ctx, valAddr))
+	require.Equal(t, tokenizeTokens.Sub(redeemTokens).ToDec(), keeper.GetTotalLiquidStakedTokens(ctx))
+
+	// share tokens cannot be redeemed beyond the balance of the holder
+	_, err = handleMsgRedeemTokensForShares(ctx, NewMsgRedeemTokensForShares(holderAddr, shareTokens[0]), keeper)
+	require.True(t, ErrNotEnoughShareTokens.Is(err))
+
+	// every tokenization gets its own record
+	res, err = handleMsgTokenizeShares(ctx, NewMsgTokenizeShares(delAddr, valAddr, amount), keeper)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	records = keeper.GetTokenizeShareRecordsByValidator(ctx, valAddr)
+	require.Len(t, records, 2)
+	require.Equal(t, "share/2", records[1].GetShareTokenDenom())
+
+	// the record is deleted once all its share tokens are redeemed
+	shareTokens = sdk.NewCoins(sdk.NewCoin(record.GetShareTokenDenom(), tokenizeTokens.Sub(redeemTokens)))
+	res, err = handleMsgRedeemTokensForShares(ctx, NewMsgRedeemTokensForShares(delAddr, shareTokens[0]), keeper)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	_, found = keeper.GetTokenizeShareRecord(ctx, record.Id)
+	require.False(t, found)
+	require.Len(t, keeper.GetTokenizeShareRecordsByValidator(ctx, valAddr), 1)
+	require.Equal(t, tokenizeTokens.ToDec(), keeper.GetTotalLiquidStakedTokens(ctx))
+
+	// shares received through a redelegation in progress cannot be tokenized
+	otherValAddr := sdk.ValAddress(keep.Addrs[3])
+	res, err = handleMsgCreateValidator(ctx, NewTestMsgCreateValidator(otherValAddr, keep.PKs[3], valTokens), keeper)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	res, err = handleMsgDelegate(ctx, NewTestMsgDelegate(holderAddr, otherValAddr, delTokens), keeper)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	EndBlocker(ctx, keeper)
+
+	res, err = handleMsgBeginRedelegate(ctx, NewMsgBeginRedelegate(holderAddr, otherValAddr, valAddr, amount), keeper)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	_, err = handleMsgTokenizeShares(ctx, NewMsgTokenizeShares(holderAddr, valAddr, amount), keeper)
+	require.True(t, ErrRedelegationInProgress.Is(err))
+
+	// validators with tokenized shares cannot be retired
+	_, err = handleMsgRetireValidator(ctx, NewMsgRetireValidator(valAddr), keeper)
+	require.True(t, ErrValidatorSharesTokenized.Is(err))
+
+	validator, found = keeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.False(t, validator.Retired)
+}
+
+func TestTokenizeSharesSlash(t *testing.T) {
+	ctx, _, _, keeper, _ := keep.CreateTestInput(t, false, 1000)
+	valAddr, delAddr := sdk.ValAddress(keep.Addrs[0]), keep.Addrs[1]
+
+	valTokens := sdk.TokensFromConsensusPower(10)
+	res, err := handleMsgCreateValidator(ctx, NewTestMsgCreateValidator(valAddr, keep.PKs[0], valTokens), keeper)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	res, err = handleMsgDelegate(ctx, NewTestMsgDelegate(delAddr, valAddr, valTokens), keeper)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	EndBlocker(ctx, keeper)
+
+	amount := sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(4))
+	res, err = handleMsgTokenizeShares(ctx, NewMsgTokenizeShares(delAddr, valAddr, amount), keeper)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// slashing the validator slashes the total liquid staked tokens alike
+	keeper.Slash(ctx, sdk.ConsAddress(keep.PKs[0].Address()), ctx.BlockHeight(), 20, sdk.NewDecWithPrec(1, 1))
+
+	record := keeper.GetTokenizeShareRecordsByValidator(ctx, valAddr)[0]
+	tokenizedTokens := keeper.GetTokenizedTokens(ctx, record)
+	require.Equal(t, amount.Amount.ToDec().Mul(sdk.NewDecWithPrec(9, 1)), tokenizedTokens)
+	require.Equal(t, tokenizedTokens, keeper.GetTotalLiquidStakedTokens(ctx))
+
+	validator, found := keeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.Equal(t, tokenizedTokens, keeper.GetValidatorTokenizedTokens(ctx, validator))
+
+	// redeeming all the share tokens clears the totals
+	shareTokens := sdk.NewCoin(record.GetShareTokenDenom(), amount.Amount)
+	res, err = handleMsgRedeemTokensForShares(ctx, NewMsgRedeemTokensForShares(delAddr, shareTokens), keeper)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	require.True(t, keeper.GetValidatorTokenizedShares(ctx, valAddr).IsZero())
+	require.True(t, keeper.GetTotalLiquidStakedTokens(ctx).IsZero())
+}
+
+func TestTokenizeSharesVesting(t *testing.T) {
+	ctx, ak, _, keeper, _ := keep.CreateTestInput(t, false, 1000)
+	valAddr, delAddr := sdk.ValAddress(keep.Addrs[0]), keep.Addrs[1]
+
+	valTokens := sdk.TokensFromConsensusPower(10)
+	res, err := handleMsgCreateValidator(ctx, NewTestMsgCreateValidator(valAddr, keep.PKs[0], valTokens), keeper)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// the first 10 delegated tokens of the delegator are vesting
+	vestingTokens := sdk.TokensFromConsensusPower(10)
+	vestingCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, vestingTokens))
+	startTime := ctx.BlockHeader().Time.Unix()
+	baseAcc := auth.NewBaseAccount(delAddr, keep.PKs[1], 1, 0)
+	ak.SetAccount(ctx, vesting.NewContinuousVestingAccount(baseAcc, vestingCoins, startTime, startTime+1000000))
+
+	delTokens := sdk.TokensFromConsensusPower(15)
+	res, err = handleMsgDelegate(ctx, NewTestMsgDelegate(delAddr, valAddr, delTokens), keeper)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	EndBlocker(ctx, keeper)
+
+	// vesting accounts cannot tokenize more than their free delegations
+	amount := sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(6))
+	_, err = handleMsgTokenizeShares(ctx, NewMsgTokenizeShares(delAddr, valAddr, amount), keeper)
+	require.True(t, ErrExceedingFreeVestingDelegations.Is(err))
+
+	amount = sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(5))
+	res, err = handleMsgTokenizeShares(ctx, NewMsgTokenizeShares(delAddr, valAddr, amount), keeper)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+}
+
 func TestInvalidMsg(t *testing.T) {
 	k := keep.Keeper{}
 	h := NewHandler(k)
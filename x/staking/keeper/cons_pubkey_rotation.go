@@ -0,0 +1,163 @@
+package keeper
+
+import (
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetConsPubKeyRotation gets the pending consensus pubkey rotation of a validator
+func (k Keeper) GetConsPubKeyRotation(ctx sdk.Context, valAddr sdk.ValAddress) (rotation types.ConsPubKeyRotation, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	value := store.Get(types.GetConsPubKeyRotationKey(valAddr))
+	if value == nil {
+		return rotation, false
+	}
+
+	rotation = types.MustUnmarshalConsPubKeyRotation(k.cdc, value)
+	return rotation, true
+}
+
+// SetConsPubKeyRotation sets the pending consensus pubkey rotation of a validator
+func (k Keeper) SetConsPubKeyRotation(ctx sdk.Context, rotation types.ConsPubKeyRotation) {
+	store := ctx.KVStore(k.storeKey)
+	bz := types.MustMarshalConsPubKeyRotation(k.cdc, rotation)
+	store.Set(types.GetConsPubKeyRotationKey(rotation.OperatorAddress), bz)
+}
+
+// DeleteConsPubKeyRotation removes the pending consensus pubkey rotation of a
+// validator
+func (k Keeper) DeleteConsPubKeyRotation(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetConsPubKeyRotationKey(valAddr))
+}
+
+// IterateConsPubKeyRotations iterates through all the pending consensus pubkey
+// rotations
+func (k Keeper) IterateConsPubKeyRotations(ctx sdk.Context, fn func(rotation types.ConsPubKeyRotation) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ConsPubKeyRotationKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		rotation := types.MustUnmarshalConsPubKeyRotation(k.cdc, iterator.Value())
+		if fn(rotation) {
+			break
+		}
+	}
+}
+
+// GetAllConsPubKeyRotations returns all the pending consensus pubkey rotations
+func (k Keeper) GetAllConsPubKeyRotations(ctx sdk.Context) (rotations []types.ConsPubKeyRotation) {
+	k.IterateConsPubKeyRotations(ctx, func(rotation types.ConsPubKeyRotation) bool {
+		rotations = append(rotations, rotation)
+		return false
+	})
+	return rotations
+}
+
+// RotateConsPubKey replaces the consensus pubkey of a validator. The old
+// consensus address keeps resolving to the validator until the end of the
+// unbonding period so that evidence of infractions committed with the old key
+// can still be handled. The rotation fee is burned from the operator account.
+func (k Keeper) RotateConsPubKey(ctx sdk.Context, validator types.Validator, newPubKey crypto.PubKey) error {
+	if _, found := k.GetConsPubKeyRotation(ctx, validator.OperatorAddress); found {
+		return types.ErrConsPubKeyRotationInProgress
+	}
+
+	fee := k.KeyRotationFee(ctx)
+	if fee.IsPositive() {
+		coins := sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), fee))
+		err := k.supplyKeeper.SendCoinsFromAccountToModule(
+			ctx, sdk.AccAddress(validator.OperatorAddress), types.NotBondedPoolName, coins,
+		)
+		if err != nil {
+			return err
+		}
+
+		if err := k.supplyKeeper.BurnCoins(ctx, types.NotBondedPoolName, coins); err != nil {
+			panic(err)
+		}
+	}
+
+	oldPubKey := validator.GetConsPubKey()
+	completionTime := ctx.BlockHeader().Time.Add(k.UnbondingTime(ctx))
+	rotation := types.NewConsPubKeyRotation(
+		validator.OperatorAddress, oldPubKey, newPubKey, ctx.BlockHeight(), completionTime,
+	)
+
+	validator.ConsensusPubkey = rotation.NewConsensusPubkey
+	k.SetValidator(ctx, validator)
+	k.SetValidatorByConsAddr(ctx, validator)
+
+	k.SetConsPubKeyRotation(ctx, rotation)
+	k.setConsPubKeyRotationUpdate(ctx, validator.OperatorAddress)
+
+	k.AfterConsensusPubKeyRotated(ctx, oldPubKey, newPubKey, validator.OperatorAddress)
+	return nil
+}
+
+// CompleteMatureConsPubKeyRotations removes the old consensus address index of
+// all the rotations whose unbonding period has elapsed.
+func (k Keeper) CompleteMatureConsPubKeyRotations(ctx sdk.Context) {
+	var matured []types.ConsPubKeyRotation
+	k.IterateConsPubKeyRotations(ctx, func(rotation types.ConsPubKeyRotation) bool {
+		if rotation.IsMature(ctx.BlockHeader().Time) {
+			matured = append(matured, rotation)
+		}
+		return false
+	})
+
+	for _, rotation := range matured {
+		k.removeRotatedConsAddr(ctx, rotation)
+		k.DeleteConsPubKeyRotation(ctx, rotation.OperatorAddress)
+	}
+}
+
+// removeRotatedConsAddr deletes the index from the old consensus address of a
+// rotation, if it still refers to the rotated validator.
+func (k Keeper) removeRotatedConsAddr(ctx sdk.Context, rotation types.ConsPubKeyRotation) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetValidatorByConsAddrKey(sdk.GetConsAddress(rotation.GetOldConsPubKey()))
+	if sdk.ValAddress(store.Get(key)).Equals(rotation.OperatorAddress) {
+		store.Delete(key)
+	}
+}
+
+// setConsPubKeyRotationUpdate marks the rotation of a validator as not yet
+// reflected in the validator set updates returned to Tendermint
+func (k Keeper) setConsPubKeyRotationUpdate(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetConsPubKeyRotationUpdateKey(valAddr), []byte{})
+}
+
+// getConsPubKeyRotationUpdates returns the consensus pubkeys being replaced by
+// the rotations not yet reflected in the validator set updates, by validator
+// operator, and clears the markers
+func (k Keeper) getConsPubKeyRotationUpdates(ctx sdk.Context) map[[sdk.AddrLen]byte]crypto.PubKey {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ConsPubKeyRotationUpdatesKey)
+
+	var valAddrs []sdk.ValAddress
+	for ; iterator.Valid(); iterator.Next() {
+		valAddrs = append(valAddrs, sdk.ValAddress(iterator.Key()[1:]))
+	}
+	iterator.Close()
+
+	rotated := make(map[[sdk.AddrLen]byte]crypto.PubKey)
+	for _, valAddr := range valAddrs {
+		store.Delete(types.GetConsPubKeyRotationUpdateKey(valAddr))
+
+		rotation, found := k.GetConsPubKeyRotation(ctx, valAddr)
+		if !found {
+			continue
+		}
+
+		var valAddrBytes [sdk.AddrLen]byte
+		copy(valAddrBytes[:], valAddr[:])
+		rotated[valAddrBytes] = rotation.GetOldConsPubKey()
+	}
+
+	return rotated
+}
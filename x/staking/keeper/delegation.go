@@ -51,19 +51,49 @@ func (k Keeper) GetAllDelegations(ctx sdk.Context) (delegations []types.Delegati
 
 // return all delegations to a specific validator. Useful for querier.
 func (k Keeper) GetValidatorDelegations(ctx sdk.Context, valAddr sdk.ValAddress) (delegations []types.Delegation) { //nolint:interfacer
+	return k.GetValidatorDelegationsPaginated(ctx, valAddr, 1, 0)
+}
+
+// GetValidatorDelegationsPaginated returns a page of the delegations to a
+// validator, ordered by delegator. The delegations are read through their
+// index by validator, skipping the ones of the previous pages, so the cost of
+// a page does not depend on the number of delegations. A zero limit returns
+// all the delegations.
+func (k Keeper) GetValidatorDelegationsPaginated(
+	ctx sdk.Context, valAddr sdk.ValAddress, page, limit int,
+) (delegations []types.Delegation) {
+
+	if page < 1 {
+		page = 1
+	}
+
 	store := ctx.KVStore(k.storeKey)
-	iterator := sdk.KVStorePrefixIterator(store, types.DelegationKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetDelegationsByValIndexKey(valAddr))
 	defer iterator.Close()
 
-	for ; iterator.Valid(); iterator.Next() {
-		delegation := types.MustUnmarshalDelegation(k.cdc, iterator.Value())
-		if delegation.GetValidatorAddr().Equals(valAddr) {
-			delegations = append(delegations, delegation)
-		}
+	for skip := (page - 1) * limit; skip > 0 && iterator.Valid(); skip-- {
+		iterator.Next()
+	}
+
+	for ; iterator.Valid() && (limit == 0 || len(delegations) < limit); iterator.Next() {
+		key := types.GetDelegationKeyFromValIndexKey(iterator.Key())
+		delegation := types.MustUnmarshalDelegation(k.cdc, store.Get(key))
+		delegations = append(delegations, delegation)
 	}
 	return delegations
 }
 
+// SetDelegationsByValIndex sets the index by validator of all the delegations.
+// It is meant to be run from the upgrade handler of a chain whose delegations
+// were stored before the index was introduced.
+func (k Keeper) SetDelegationsByValIndex(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	k.IterateAllDelegations(ctx, func(delegation types.Delegation) bool {
+		store.Set(types.GetDelegationByValIndexKey(delegation.DelegatorAddress, delegation.ValidatorAddress), []byte{})
+		return false
+	})
+}
+
 // return a given amount of all the delegations from a delegator
 func (k Keeper) GetDelegatorDelegations(ctx sdk.Context, delegator sdk.AccAddress,
 	maxRetrieve uint16) (delegations []types.Delegation) {
@@ -89,6 +119,7 @@ func (k Keeper) SetDelegation(ctx sdk.Context, delegation types.Delegation) {
 	store := ctx.KVStore(k.storeKey)
 	b := types.MustMarshalDelegation(k.cdc, delegation)
 	store.Set(types.GetDelegationKey(delegation.DelegatorAddress, delegation.ValidatorAddress), b)
+	store.Set(types.GetDelegationByValIndexKey(delegation.DelegatorAddress, delegation.ValidatorAddress), []byte{}) // index, store empty bytes
 }
 
 // remove a delegation
@@ -97,6 +128,12 @@ func (k Keeper) RemoveDelegation(ctx sdk.Context, delegation types.Delegation) {
 	k.BeforeDelegationRemoved(ctx, delegation.DelegatorAddress, delegation.ValidatorAddress)
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetDelegationKey(delegation.DelegatorAddress, delegation.ValidatorAddress))
+	store.Delete(types.GetDelegationByValIndexKey(delegation.DelegatorAddress, delegation.ValidatorAddress))
+
+	if lock, found := k.GetDelegationLock(ctx, delegation.DelegatorAddress, delegation.ValidatorAddress); found {
+		k.RemoveFromDelegationLockQueue(ctx, lock)
+		k.RemoveDelegationLock(ctx, delegation.DelegatorAddress, delegation.ValidatorAddress)
+	}
 }
 
 // return a given amount of all the delegator unbonding-delegations
@@ -323,6 +360,24 @@ func (k Keeper) GetRedelegationsFromSrcValidator(ctx sdk.Context, valAddr sdk.Va
 	return reds
 }
 
+// GetReceivingRedelegations returns all the redelegations of a delegator
+// towards a destination validator.
+func (k Keeper) GetReceivingRedelegations(
+	ctx sdk.Context, delAddr sdk.AccAddress, valDstAddr sdk.ValAddress,
+) (reds []types.Redelegation) {
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetREDsByDelToValDstIndexKey(delAddr, valDstAddr))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := types.GetREDKeyFromValDstIndexKey(iterator.Key())
+		red := types.MustUnmarshalRED(k.cdc, store.Get(key))
+		reds = append(reds, red)
+	}
+	return reds
+}
+
 // check if validator is receiving a redelegation
 func (k Keeper) HasReceivingRedelegation(ctx sdk.Context,
 	delAddr sdk.AccAddress, valDstAddr sdk.ValAddress) bool {
@@ -376,6 +431,105 @@ func (k Keeper) SetRedelegationEntry(ctx sdk.Context,
 	return red
 }
 
+// forwardReceivingRedelegations carries the slashable entries of the
+// redelegations received by a source validator over to the destination
+// validator of a redelegation of sharesSrc out of the delegatorShares shares of
+// the delegation, which created sharesDst shares on the destination validator.
+// The redelegated shares can not be told apart from the other shares of the
+// delegation, so every immature entry of the received redelegations gives up
+// the same fraction of its shares and balance. The shares may be slashable for
+// several source validators along a chain of redelegations, so the fraction is
+// taken out of every received redelegation. Each entry moves its part to an
+// entry of the same creation height and completion time of the redelegation
+// from its own source validator to the destination validator. Slashing the
+// original source validator then slashes the delegations which hold the shares
+// now, however long the chain of redelegations is. Shares redelegated back to
+// the original source validator are slashed along with it and are not carried
+// over.
+func (k Keeper) forwardReceivingRedelegations(
+	ctx sdk.Context, delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress,
+	delegatorShares, sharesSrc, sharesDst sdk.Dec,
+) {
+
+	if !delegatorShares.IsPositive() || !sharesSrc.IsPositive() {
+		return
+	}
+
+	fraction := sdk.MinDec(sharesSrc.Quo(delegatorShares), sdk.OneDec())
+	now := ctx.BlockHeader().Time
+
+	for _, red := range k.GetReceivingRedelegations(ctx, delAddr, valSrcAddr) {
+		entries := make([]types.RedelegationEntry, 0, len(red.Entries))
+		for _, entry := range red.Entries {
+			if entry.IsMature(now) || !entry.SharesDst.IsPositive() {
+				entries = append(entries, entry)
+				continue
+			}
+
+			moved := sdk.MinDec(entry.SharesDst.Mul(fraction), sharesSrc)
+			if !moved.IsPositive() {
+				entries = append(entries, entry)
+				continue
+			}
+
+			balance := entry.InitialBalance
+			if moved.LT(entry.SharesDst) {
+				balance = entry.InitialBalance.ToDec().Mul(moved).Quo(entry.SharesDst).TruncateInt()
+			}
+
+			if !red.ValidatorSrcAddress.Equals(valDstAddr) {
+				k.setForwardedRedelegationEntry(
+					ctx, delAddr, red.ValidatorSrcAddress, valDstAddr, entry.CreationHeight,
+					entry.CompletionTime, balance, sharesDst.Mul(moved).Quo(sharesSrc),
+				)
+			}
+
+			entry.InitialBalance = entry.InitialBalance.Sub(balance)
+			entry.SharesDst = entry.SharesDst.Sub(moved)
+			if entry.SharesDst.IsPositive() {
+				entries = append(entries, entry)
+			}
+		}
+
+		// the queue slices of removed redelegations are skipped once they mature
+		red.Entries = entries
+		if len(red.Entries) == 0 {
+			k.RemoveRedelegation(ctx, red)
+		} else {
+			k.SetRedelegation(ctx, red)
+		}
+	}
+}
+
+// setForwardedRedelegationEntry adds the part of a redelegation entry carried
+// over by a subsequent redelegation to the redelegation between the original
+// source validator and the new destination validator. It is merged into an
+// entry of the same creation height and completion time if one exists, so
+// forwarded entries are not limited by the maximum number of entries.
+func (k Keeper) setForwardedRedelegationEntry(
+	ctx sdk.Context, delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress,
+	creationHeight int64, completionTime time.Time, balance sdk.Int, sharesDst sdk.Dec,
+) {
+
+	red, found := k.GetRedelegation(ctx, delAddr, valSrcAddr, valDstAddr)
+	if found {
+		for i, entry := range red.Entries {
+			if entry.CreationHeight == creationHeight && entry.CompletionTime.Equal(completionTime) {
+				red.Entries[i].InitialBalance = entry.InitialBalance.Add(balance)
+				red.Entries[i].SharesDst = entry.SharesDst.Add(sharesDst)
+				k.SetRedelegation(ctx, red)
+				return
+			}
+		}
+	}
+
+	red = k.SetRedelegationEntry(
+		ctx, delAddr, valSrcAddr, valDstAddr,
+		creationHeight, completionTime, balance, sdk.ZeroDec(), sharesDst,
+	)
+	k.InsertRedelegationQueue(ctx, red, completionTime)
+}
+
 // iterate through all redelegations
 func (k Keeper) IterateRedelegations(ctx sdk.Context, fn func(index int64, red types.Redelegation) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
@@ -480,6 +634,11 @@ func (k Keeper) Delegate(
 		return sdk.ZeroDec(), types.ErrDelegatorShareExRateInvalid
 	}
 
+	// retired validators cannot receive new delegations
+	if validator.Retired {
+		return sdk.ZeroDec(), types.ErrValidatorRetired
+	}
+
 	// Get or create the delegation object
 	delegation, found := k.GetDelegation(ctx, delAddr, validator.OperatorAddress)
 	if !found {
@@ -650,11 +809,25 @@ func (k Keeper) Undelegate(
 		return time.Time{}, types.ErrNoDelegatorForAddress
 	}
 
+	if k.IsDelegationLocked(ctx, delAddr, valAddr) {
+		return time.Time{}, types.ErrDelegationLocked
+	}
+
 	if k.HasMaxUnbondingDelegationEntries(ctx, delAddr, valAddr) {
 		return time.Time{}, types.ErrMaxUnbondingDelegationEntries
 	}
 
-	returnAmount, err := k.unbond(ctx, delAddr, valAddr, sharesAmount)
+	return k.undelegate(ctx, delAddr, validator, sharesAmount)
+}
+
+// undelegate unbonds an amount of delegator shares from a given validator and
+// creates the matching unbonding delegation entry, regardless of the maximum
+// number of entries.
+func (k Keeper) undelegate(
+	ctx sdk.Context, delAddr sdk.AccAddress, validator types.Validator, sharesAmount sdk.Dec,
+) (time.Time, error) {
+
+	returnAmount, err := k.unbond(ctx, delAddr, validator.OperatorAddress, sharesAmount)
 	if err != nil {
 		return time.Time{}, err
 	}
@@ -665,7 +838,9 @@ func (k Keeper) Undelegate(
 	}
 
 	completionTime := ctx.BlockHeader().Time.Add(k.UnbondingTime(ctx))
-	ubd := k.SetUnbondingDelegationEntry(ctx, delAddr, valAddr, ctx.BlockHeight(), completionTime, returnAmount)
+	ubd := k.SetUnbondingDelegationEntry(
+		ctx, delAddr, validator.OperatorAddress, ctx.BlockHeight(), completionTime, returnAmount,
+	)
 	k.InsertUBDQueue(ctx, ubd, completionTime)
 
 	return completionTime, nil
@@ -723,6 +898,65 @@ func (k Keeper) CompleteUnbonding(ctx sdk.Context, delAddr sdk.AccAddress, valAd
 	return err
 }
 
+// CancelUnbondingDelegation cancels (part of) the unbonding delegation entry
+// created at creationHeight and delegates the cancelled amount back to the
+// original validator. The amount is capped by the entry balance, which already
+// accounts for any slashing applied to the entry. The entry is removed once its
+// whole balance has been cancelled, freeing a slot for new unbonding entries.
+func (k Keeper) CancelUnbondingDelegation(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, creationHeight int64, amount sdk.Int,
+) error {
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrNoValidatorFound
+	}
+
+	ubd, found := k.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return types.ErrNoUnbondingDelegation
+	}
+
+	entryIndex := -1
+	for i, entry := range ubd.Entries {
+		if entry.CreationHeight == creationHeight && !entry.IsMature(ctx.BlockHeader().Time) {
+			entryIndex = i
+			break
+		}
+	}
+	if entryIndex == -1 {
+		return sdkerrors.Wrapf(types.ErrNoUnbondingDelegationEntry, "creation height %d", creationHeight)
+	}
+
+	entry := ubd.Entries[entryIndex]
+	if amount.GT(entry.Balance) {
+		return sdkerrors.Wrapf(types.ErrUnbondingEntryBalanceExceeded, "%s > %s", amount, entry.Balance)
+	}
+
+	// the tokens of an unbonding entry are held by the not bonded pool
+	if _, err := k.Delegate(ctx, delAddr, amount, sdk.Unbonding, validator, false); err != nil {
+		return err
+	}
+
+	if amount.Equal(entry.Balance) {
+		ubd.RemoveEntry(int64(entryIndex))
+	} else {
+		entry.Balance = entry.Balance.Sub(amount)
+		entry.InitialBalance = entry.InitialBalance.Sub(amount)
+		ubd.Entries[entryIndex] = entry
+	}
+
+	// the ubd queue entry is left in place and skipped once it matures if the
+	// unbonding delegation no longer exists
+	if len(ubd.Entries) == 0 {
+		k.RemoveUnbondingDelegation(ctx, ubd)
+	} else {
+		k.SetUnbondingDelegation(ctx, ubd)
+	}
+
+	return nil
+}
+
 // begin unbonding / redelegation; create a redelegation record
 func (k Keeper) BeginRedelegation(
 	ctx sdk.Context, delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress, sharesAmount sdk.Dec,
@@ -732,6 +966,10 @@ func (k Keeper) BeginRedelegation(
 		return time.Time{}, types.ErrSelfRedelegation
 	}
 
+	if k.IsDelegationLocked(ctx, delAddr, valSrcAddr) {
+		return time.Time{}, types.ErrDelegationLocked
+	}
+
 	dstValidator, found := k.GetValidator(ctx, valDstAddr)
 	if !found {
 		return time.Time{}, types.ErrBadRedelegationDst
@@ -742,15 +980,15 @@ func (k Keeper) BeginRedelegation(
 		return time.Time{}, types.ErrBadRedelegationDst
 	}
 
-	// check if this is a transitive redelegation
-	if k.HasReceivingRedelegation(ctx, delAddr, valSrcAddr) {
-		return time.Time{}, types.ErrTransitiveRedelegation
-	}
-
 	if k.HasMaxRedelegationEntries(ctx, delAddr, valSrcAddr, valDstAddr) {
 		return time.Time{}, types.ErrMaxRedelegationEntries
 	}
 
+	delegation, found := k.GetDelegation(ctx, delAddr, valSrcAddr)
+	if !found {
+		return time.Time{}, types.ErrNoDelegatorForAddress
+	}
+
 	returnAmount, err := k.unbond(ctx, delAddr, valSrcAddr, sharesAmount)
 	if err != nil {
 		return time.Time{}, err
@@ -765,6 +1003,11 @@ func (k Keeper) BeginRedelegation(
 		return time.Time{}, err
 	}
 
+	// the redelegated shares may have been received through redelegations which
+	// are still slashable, in which case they remain slashable on the
+	// destination validator
+	k.forwardReceivingRedelegations(ctx, delAddr, valSrcAddr, valDstAddr, delegation.Shares, sharesAmount, sharesCreated)
+
 	// create the unbonding delegation
 	completionTime, height, completeNow := k.getBeginInfo(ctx, valSrcAddr)
 
@@ -0,0 +1,221 @@
+package keeper
+
+import (
+	"bytes"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetDelegationChange gets the change of the delegation of a delegator to a
+// validator at a height
+func (k Keeper) GetDelegationChange(
+	ctx sdk.Context, delAddr sdk.AccAddress, height int64, valAddr sdk.ValAddress,
+) (change types.DelegationChange, found bool) {
+
+	store := ctx.KVStore(k.storeKey)
+	value := store.Get(types.GetDelegationChangeKey(delAddr, height, valAddr))
+	if value == nil {
+		return change, false
+	}
+
+	change = types.MustUnmarshalDelegationChange(k.cdc, value)
+	return change, true
+}
+
+// SetDelegationChange sets a delegation change along with its index by height
+func (k Keeper) SetDelegationChange(ctx sdk.Context, change types.DelegationChange) {
+	store := ctx.KVStore(k.storeKey)
+	bz := types.MustMarshalDelegationChange(k.cdc, change)
+	store.Set(types.GetDelegationChangeKey(change.DelegatorAddress, change.Height, change.ValidatorAddress), bz)
+	store.Set(types.GetDelegationChangeByHeightKey(change.Height, change.DelegatorAddress, change.ValidatorAddress), []byte{})
+}
+
+// DeleteDelegationChange removes a delegation change along with its index by
+// height
+func (k Keeper) DeleteDelegationChange(
+	ctx sdk.Context, delAddr sdk.AccAddress, height int64, valAddr sdk.ValAddress,
+) {
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetDelegationChangeKey(delAddr, height, valAddr))
+	store.Delete(types.GetDelegationChangeByHeightKey(height, delAddr, valAddr))
+}
+
+// IterateDelegatorDelegationChanges iterates through the delegation changes of
+// a delegator from a height on, in order of height
+func (k Keeper) IterateDelegatorDelegationChanges(
+	ctx sdk.Context, delAddr sdk.AccAddress, fromHeight int64, fn func(change types.DelegationChange) (stop bool),
+) {
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(
+		types.GetDelegationChangesFromHeightKey(delAddr, fromHeight),
+		sdk.PrefixEndBytes(types.GetDelegationChangesKey(delAddr)),
+	)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		change := types.MustUnmarshalDelegationChange(k.cdc, iterator.Value())
+		if fn(change) {
+			break
+		}
+	}
+}
+
+// IterateDelegationChanges iterates through all the delegation changes
+func (k Keeper) IterateDelegationChanges(ctx sdk.Context, fn func(change types.DelegationChange) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.DelegationChangeKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		change := types.MustUnmarshalDelegationChange(k.cdc, iterator.Value())
+		if fn(change) {
+			break
+		}
+	}
+}
+
+// GetAllDelegationChanges returns all the retained delegation changes
+func (k Keeper) GetAllDelegationChanges(ctx sdk.Context) (changes []types.DelegationChange) {
+	k.IterateDelegationChanges(ctx, func(change types.DelegationChange) bool {
+		changes = append(changes, change)
+		return false
+	})
+	return changes
+}
+
+// DeleteAllDelegationChanges removes all the delegation changes
+func (k Keeper) DeleteAllDelegationChanges(ctx sdk.Context) {
+	for _, change := range k.GetAllDelegationChanges(ctx) {
+		k.DeleteDelegationChange(ctx, change.DelegatorAddress, change.Height, change.ValidatorAddress)
+	}
+}
+
+// GetDelegatorDelegationChanges returns all the retained delegation changes of
+// a delegator, in order of height
+func (k Keeper) GetDelegatorDelegationChanges(ctx sdk.Context, delAddr sdk.AccAddress) []types.DelegationChange {
+	changes := []types.DelegationChange{}
+	k.IterateDelegatorDelegationChanges(ctx, delAddr, 0, func(change types.DelegationChange) bool {
+		changes = append(changes, change)
+		return false
+	})
+	return changes
+}
+
+// GetDelegatorDelegationsAtHeight returns the delegations of a delegator at
+// the end of a past block. The delegations are rebuilt from the current ones
+// by reverting the retained changes of the later blocks, so the height must
+// be within the delegation history retention.
+func (k Keeper) GetDelegatorDelegationsAtHeight(
+	ctx sdk.Context, delAddr sdk.AccAddress, height int64,
+) ([]types.Delegation, error) {
+
+	if height > ctx.BlockHeight() {
+		return nil, types.ErrInvalidHistoricalHeight
+	}
+	if height < ctx.BlockHeight()-int64(k.DelegationHistoryRetention(ctx)) {
+		return nil, types.ErrDelegationHistoryPruned
+	}
+
+	shares := make(map[string]sdk.Dec)
+	for _, delegation := range k.GetAllDelegatorDelegations(ctx, delAddr) {
+		shares[string(delegation.ValidatorAddress)] = delegation.Shares
+	}
+
+	// the first change of each validator after the height holds the shares of
+	// the delegation at the height
+	reverted := make(map[string]bool)
+	k.IterateDelegatorDelegationChanges(ctx, delAddr, height+1, func(change types.DelegationChange) bool {
+		valAddr := string(change.ValidatorAddress)
+		if !reverted[valAddr] {
+			shares[valAddr] = change.SharesBefore
+			reverted[valAddr] = true
+		}
+		return false
+	})
+
+	delegations := []types.Delegation{}
+	for valAddr, valShares := range shares {
+		if valShares.IsPositive() {
+			delegations = append(delegations, types.NewDelegation(delAddr, sdk.ValAddress(valAddr), valShares))
+		}
+	}
+
+	sort.Slice(delegations, func(i, j int) bool {
+		return bytes.Compare(delegations[i].ValidatorAddress, delegations[j].ValidatorAddress) < 0
+	})
+
+	return delegations, nil
+}
+
+// PruneDelegationChanges removes the delegation changes which are no longer
+// within the delegation history retention. All the changes are removed when
+// the retention is zero.
+func (k Keeper) PruneDelegationChanges(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	pruneHeight := ctx.BlockHeight() - int64(k.DelegationHistoryRetention(ctx))
+	if pruneHeight < 0 {
+		return
+	}
+
+	iterator := store.Iterator(types.DelegationChangeByHeightKey, types.GetDelegationChangesByHeightKey(pruneHeight+1))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		height, delAddr, valAddr := types.ParseDelegationChangeByHeightKey(key)
+		k.DeleteDelegationChange(ctx, delAddr, height, valAddr)
+	}
+}
+
+// beforeDelegationChange records the shares of a delegation before its first
+// change within the block, if the delegation history is retained
+func (k Keeper) beforeDelegationChange(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	if k.DelegationHistoryRetention(ctx) == 0 {
+		return
+	}
+
+	if _, found := k.GetDelegationChange(ctx, delAddr, ctx.BlockHeight(), valAddr); found {
+		return
+	}
+
+	shares := sdk.ZeroDec()
+	if delegation, found := k.GetDelegation(ctx, delAddr, valAddr); found {
+		shares = delegation.Shares
+	}
+
+	change := types.NewDelegationChange(delAddr, valAddr, ctx.BlockHeight(), types.DelegationChangeModified, shares, shares)
+	k.SetDelegationChange(ctx, change)
+}
+
+// afterDelegationChange records the shares of a delegation after a change
+// within the block, if the change was recorded by beforeDelegationChange
+func (k Keeper) afterDelegationChange(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, removed bool) {
+	change, found := k.GetDelegationChange(ctx, delAddr, ctx.BlockHeight(), valAddr)
+	if !found {
+		return
+	}
+
+	change.SharesAfter = sdk.ZeroDec()
+	if delegation, found := k.GetDelegation(ctx, delAddr, valAddr); found && !removed {
+		change.SharesAfter = delegation.Shares
+	}
+
+	switch {
+	case removed:
+		change.Type = types.DelegationChangeRemoved
+	case change.SharesBefore.IsZero():
+		change.Type = types.DelegationChangeCreated
+	default:
+		change.Type = types.DelegationChangeModified
+	}
+
+	k.SetDelegationChange(ctx, change)
+}
@@ -0,0 +1,132 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// setupDelegationHistory delegates to two validators at height 1, delegates
+// more to the first one at height 2 and removes the delegation to the second
+// one at height 3
+func setupDelegationHistory(t *testing.T, retention uint32) (sdk.Context, Keeper) {
+	ctx, _, _, keeper, _ := CreateTestInput(t, false, 1000)
+
+	params := keeper.GetParams(ctx)
+	params.DelegationHistoryRetention = retention
+	keeper.SetParams(ctx, params)
+
+	for i := 0; i < 2; i++ {
+		keeper.SetValidator(ctx, types.NewValidator(addrVals[i], PKs[i], types.Description{}))
+	}
+
+	delegate := func(valAddr sdk.ValAddress, amount int64) {
+		validator, found := keeper.GetValidator(ctx, valAddr)
+		require.True(t, found)
+		_, err := keeper.Delegate(ctx, addrDels[0], sdk.NewInt(amount), sdk.Unbonded, validator, true)
+		require.NoError(t, err)
+	}
+
+	ctx = ctx.WithBlockHeight(1)
+	delegate(addrVals[0], 100)
+	delegate(addrVals[1], 100)
+
+	ctx = ctx.WithBlockHeight(2)
+	delegate(addrVals[0], 50)
+
+	ctx = ctx.WithBlockHeight(3)
+	_, err := keeper.Undelegate(ctx, addrDels[0], addrVals[1], sdk.NewDec(100))
+	require.NoError(t, err)
+
+	return ctx, keeper
+}
+
+func TestDelegationChanges(t *testing.T) {
+	ctx, keeper := setupDelegationHistory(t, 10)
+
+	expected := []types.DelegationChange{
+		types.NewDelegationChange(addrDels[0], addrVals[0], 1, types.DelegationChangeCreated, sdk.ZeroDec(), sdk.NewDec(100)),
+		types.NewDelegationChange(addrDels[0], addrVals[1], 1, types.DelegationChangeCreated, sdk.ZeroDec(), sdk.NewDec(100)),
+		types.NewDelegationChange(addrDels[0], addrVals[0], 2, types.DelegationChangeModified, sdk.NewDec(100), sdk.NewDec(150)),
+		types.NewDelegationChange(addrDels[0], addrVals[1], 3, types.DelegationChangeRemoved, sdk.NewDec(100), sdk.ZeroDec()),
+	}
+	require.Equal(t, expected, keeper.GetDelegatorDelegationChanges(ctx, addrDels[0]))
+	require.Empty(t, keeper.GetDelegatorDelegationChanges(ctx, addrDels[1]))
+
+	// changes within the same block are merged into a single change
+	validator, found := keeper.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+	_, err := keeper.Delegate(ctx, addrDels[0], sdk.NewInt(10), sdk.Unbonded, validator, true)
+	require.NoError(t, err)
+	_, err = keeper.Undelegate(ctx, addrDels[0], addrVals[0], sdk.NewDec(160))
+	require.NoError(t, err)
+
+	change, found := keeper.GetDelegationChange(ctx, addrDels[0], 3, addrVals[0])
+	require.True(t, found)
+	require.Equal(t,
+		types.NewDelegationChange(addrDels[0], addrVals[0], 3, types.DelegationChangeRemoved, sdk.NewDec(150), sdk.ZeroDec()),
+		change,
+	)
+}
+
+func TestDelegationChangesDisabled(t *testing.T) {
+	ctx, keeper := setupDelegationHistory(t, 0)
+	require.Empty(t, keeper.GetDelegatorDelegationChanges(ctx, addrDels[0]))
+
+	_, err := keeper.GetDelegatorDelegationsAtHeight(ctx, addrDels[0], 2)
+	require.Equal(t, types.ErrDelegationHistoryPruned, err)
+
+	delegations, err := keeper.GetDelegatorDelegationsAtHeight(ctx, addrDels[0], 3)
+	require.NoError(t, err)
+	require.Equal(t, []types.Delegation{types.NewDelegation(addrDels[0], addrVals[0], sdk.NewDec(150))}, delegations)
+}
+
+func TestGetDelegatorDelegationsAtHeight(t *testing.T) {
+	ctx, keeper := setupDelegationHistory(t, 10)
+
+	del0 := func(shares int64) types.Delegation {
+		return types.NewDelegation(addrDels[0], addrVals[0], sdk.NewDec(shares))
+	}
+	del1 := func(shares int64) types.Delegation {
+		return types.NewDelegation(addrDels[0], addrVals[1], sdk.NewDec(shares))
+	}
+
+	tests := []struct {
+		height   int64
+		expected []types.Delegation
+	}{
+		{0, []types.Delegation{}},
+		{1, []types.Delegation{del0(100), del1(100)}},
+		{2, []types.Delegation{del0(150), del1(100)}},
+		{3, []types.Delegation{del0(150)}},
+	}
+	for _, tc := range tests {
+		delegations, err := keeper.GetDelegatorDelegationsAtHeight(ctx, addrDels[0], tc.height)
+		require.NoError(t, err, "height %d", tc.height)
+		require.Equal(t, tc.expected, delegations, "height %d", tc.height)
+	}
+
+	_, err := keeper.GetDelegatorDelegationsAtHeight(ctx, addrDels[0], 4)
+	require.Equal(t, types.ErrInvalidHistoricalHeight, err)
+
+	// the changes at or below height 2 are pruned at height 12
+	ctx = ctx.WithBlockHeight(12)
+	keeper.PruneDelegationChanges(ctx)
+
+	require.Equal(t,
+		[]types.DelegationChange{
+			types.NewDelegationChange(addrDels[0], addrVals[1], 3, types.DelegationChangeRemoved, sdk.NewDec(100), sdk.ZeroDec()),
+		},
+		keeper.GetDelegatorDelegationChanges(ctx, addrDels[0]),
+	)
+
+	_, err = keeper.GetDelegatorDelegationsAtHeight(ctx, addrDels[0], 1)
+	require.Equal(t, types.ErrDelegationHistoryPruned, err)
+
+	delegations, err := keeper.GetDelegatorDelegationsAtHeight(ctx, addrDels[0], 2)
+	require.NoError(t, err)
+	require.Equal(t, []types.Delegation{del0(150), del1(100)}, delegations)
+}
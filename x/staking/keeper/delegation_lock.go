@@ -0,0 +1,263 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetDelegationLock returns the lock of the delegation of a delegator to a
+// validator, if it exists.
+func (k Keeper) GetDelegationLock(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress,
+) (lock types.DelegationLock, found bool) {
+
+	store := ctx.KVStore(k.storeKey)
+	value := store.Get(types.GetDelegationLockKey(delAddr, valAddr))
+	if value == nil {
+		return lock, false
+	}
+
+	return types.MustUnmarshalDelegationLock(k.cdc, value), true
+}
+
+// SetDelegationLock sets the lock of a delegation.
+func (k Keeper) SetDelegationLock(ctx sdk.Context, lock types.DelegationLock) {
+	store := ctx.KVStore(k.storeKey)
+	bz := types.MustMarshalDelegationLock(k.cdc, lock)
+	store.Set(types.GetDelegationLockKey(lock.DelegatorAddress, lock.ValidatorAddress), bz)
+}
+
+// RemoveDelegationLock removes the lock of the delegation of a delegator to a
+// validator. The entry of the lock in the delegation lock queue must be
+// removed separately.
+func (k Keeper) RemoveDelegationLock(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetDelegationLockKey(delAddr, valAddr))
+}
+
+// IterateDelegationLocks iterates through all the delegation locks.
+func (k Keeper) IterateDelegationLocks(ctx sdk.Context, fn func(lock types.DelegationLock) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.DelegationLockKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		lock := types.MustUnmarshalDelegationLock(k.cdc, iterator.Value())
+		if fn(lock) {
+			break
+		}
+	}
+}
+
+// GetAllDelegationLocks returns all the delegation locks.
+func (k Keeper) GetAllDelegationLocks(ctx sdk.Context) (locks []types.DelegationLock) {
+	k.IterateDelegationLocks(ctx, func(lock types.DelegationLock) bool {
+		locks = append(locks, lock)
+		return false
+	})
+
+	return locks
+}
+
+// GetDelegatorDelegationLocks returns the locks of all the delegations of a
+// delegator.
+func (k Keeper) GetDelegatorDelegationLocks(ctx sdk.Context, delAddr sdk.AccAddress) (locks []types.DelegationLock) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetDelegationLocksKey(delAddr))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		locks = append(locks, types.MustUnmarshalDelegationLock(k.cdc, iterator.Value()))
+	}
+
+	return locks
+}
+
+// IsDelegationLocked returns true if the delegation of a delegator to a
+// validator cannot be unbonded or redelegated at the current block time.
+func (k Keeper) IsDelegationLocked(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) bool {
+	lock, found := k.GetDelegationLock(ctx, delAddr, valAddr)
+	return found && !lock.IsMature(ctx.BlockHeader().Time)
+}
+
+// DelegationRewardMultiplier returns the multiplier applied to the rewards of
+// the delegation of a delegator to a validator. It is one unless the
+// delegation is locked. A lock applies its multiplier to the rewards settled
+// up to and including its completion, including once it has matured.
+func (k Keeper) DelegationRewardMultiplier(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) sdk.Dec {
+	lock, found := k.GetDelegationLock(ctx, delAddr, valAddr)
+	if !found {
+		return sdk.OneDec()
+	}
+
+	return lock.RewardMultiplier
+}
+
+// LockDelegation locks the delegation of a delegator to a validator for the
+// duration of one of the lock tiers. An existing lock can be replaced by a
+// lock which ends at the same time or later. The rewards earned under the
+// previous multiplier are withdrawn before the lock is set.
+func (k Keeper) LockDelegation(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, lockDuration time.Duration,
+) (types.DelegationLock, error) {
+
+	if _, found := k.GetDelegation(ctx, delAddr, valAddr); !found {
+		return types.DelegationLock{}, types.ErrNoDelegation
+	}
+
+	tier, found := k.GetParams(ctx).GetLockTier(lockDuration)
+	if !found {
+		return types.DelegationLock{}, sdkerrors.Wrap(types.ErrInvalidLockDuration, lockDuration.String())
+	}
+
+	endTime := ctx.BlockHeader().Time.Add(lockDuration)
+	existing, found := k.GetDelegationLock(ctx, delAddr, valAddr)
+	if found && existing.EndTime.After(endTime) {
+		return types.DelegationLock{}, sdkerrors.Wrapf(
+			types.ErrDelegationLockShortened, "existing lock ends at %s", existing.EndTime,
+		)
+	}
+
+	if found {
+		k.RemoveFromDelegationLockQueue(ctx, existing)
+	}
+
+	lock := types.NewDelegationLock(delAddr, valAddr, endTime, tier.RewardMultiplier)
+	k.updateDelegationLock(ctx, delAddr, valAddr, func() { k.SetDelegationLock(ctx, lock) })
+	k.InsertDelegationLockQueue(ctx, lock)
+
+	return lock, nil
+}
+
+// updateDelegationLock performs a change of the lock of a delegation. The
+// delegation shares are not modified, but the delegation modification hooks
+// are called so that the rewards earned so far are settled under the
+// multiplier in effect before the change. The hooks are called directly as the
+// change does not belong in the delegation change log.
+func (k Keeper) updateDelegationLock(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, update func()) {
+	if k.hooks != nil {
+		k.hooks.BeforeDelegationSharesModified(ctx, delAddr, valAddr)
+	}
+
+	update()
+
+	if k.hooks != nil {
+		k.hooks.AfterDelegationModified(ctx, delAddr, valAddr)
+	}
+}
+
+// delegation lock queue timeslice operations
+
+// GetDelegationLockQueueTimeSlice gets a specific delegation lock queue
+// timeslice. A timeslice is a slice of DVPairs corresponding to the delegation
+// locks that end at a certain time.
+func (k Keeper) GetDelegationLockQueueTimeSlice(ctx sdk.Context, timestamp time.Time) (dvPairs []types.DVPair) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetDelegationLockTimeKey(timestamp))
+	if bz == nil {
+		return []types.DVPair{}
+	}
+
+	pairs := types.DVPairs{}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &pairs)
+	return pairs.Pairs
+}
+
+// SetDelegationLockQueueTimeSlice sets a specific delegation lock queue timeslice.
+func (k Keeper) SetDelegationLockQueueTimeSlice(ctx sdk.Context, timestamp time.Time, keys []types.DVPair) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(&types.DVPairs{Pairs: keys})
+	store.Set(types.GetDelegationLockTimeKey(timestamp), bz)
+}
+
+// InsertDelegationLockQueue inserts a delegation lock to the appropriate
+// timeslice in the delegation lock queue.
+func (k Keeper) InsertDelegationLockQueue(ctx sdk.Context, lock types.DelegationLock) {
+	timeSlice := k.GetDelegationLockQueueTimeSlice(ctx, lock.EndTime)
+	dvPair := types.DVPair{DelegatorAddress: lock.DelegatorAddress, ValidatorAddress: lock.ValidatorAddress}
+	k.SetDelegationLockQueueTimeSlice(ctx, lock.EndTime, append(timeSlice, dvPair))
+}
+
+// RemoveFromDelegationLockQueue removes a delegation lock from its timeslice
+// in the delegation lock queue, deleting the timeslice if it becomes empty.
+func (k Keeper) RemoveFromDelegationLockQueue(ctx sdk.Context, lock types.DelegationLock) {
+	timeSlice := k.GetDelegationLockQueueTimeSlice(ctx, lock.EndTime)
+
+	pairs := make([]types.DVPair, 0, len(timeSlice))
+	for _, dvPair := range timeSlice {
+		if dvPair.DelegatorAddress.Equals(lock.DelegatorAddress) && dvPair.ValidatorAddress.Equals(lock.ValidatorAddress) {
+			continue
+		}
+		pairs = append(pairs, dvPair)
+	}
+
+	if len(pairs) == 0 {
+		store := ctx.KVStore(k.storeKey)
+		store.Delete(types.GetDelegationLockTimeKey(lock.EndTime))
+		return
+	}
+
+	k.SetDelegationLockQueueTimeSlice(ctx, lock.EndTime, pairs)
+}
+
+// DelegationLockQueueIterator returns all the delegation lock queue timeslices
+// from time 0 until endTime.
+func (k Keeper) DelegationLockQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return store.Iterator(types.DelegationLockQueueKey,
+		sdk.InclusiveEndBytes(types.GetDelegationLockTimeKey(endTime)))
+}
+
+// DequeueAllMatureDelegationLockQueue returns a concatenated list of all the
+// timeslices inclusively previous to currTime, and deletes the timeslices from
+// the queue.
+func (k Keeper) DequeueAllMatureDelegationLockQueue(ctx sdk.Context, currTime time.Time) (matureLocks []types.DVPair) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := k.DelegationLockQueueIterator(ctx, currTime)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		timeslice := types.DVPairs{}
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &timeslice)
+
+		matureLocks = append(matureLocks, timeslice.Pairs...)
+		store.Delete(iterator.Key())
+	}
+
+	return matureLocks
+}
+
+// CompleteMatureDelegationLocks removes the delegation locks which ended at or
+// before the current block time. The rewards earned under the lock multiplier
+// are settled before a lock is removed. Queue entries of locks which were
+// removed in the meantime are skipped.
+func (k Keeper) CompleteMatureDelegationLocks(ctx sdk.Context) {
+	currTime := ctx.BlockHeader().Time
+
+	for _, dvPair := range k.DequeueAllMatureDelegationLockQueue(ctx, currTime) {
+		lock, found := k.GetDelegationLock(ctx, dvPair.DelegatorAddress, dvPair.ValidatorAddress)
+		if !found || !lock.IsMature(currTime) {
+			continue
+		}
+
+		if _, found := k.GetDelegation(ctx, lock.DelegatorAddress, lock.ValidatorAddress); found {
+			k.updateDelegationLock(ctx, lock.DelegatorAddress, lock.ValidatorAddress, func() {
+				k.RemoveDelegationLock(ctx, lock.DelegatorAddress, lock.ValidatorAddress)
+			})
+		} else {
+			k.RemoveDelegationLock(ctx, lock.DelegatorAddress, lock.ValidatorAddress)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCompleteDelegationLock,
+				sdk.NewAttribute(types.AttributeKeyValidator, lock.ValidatorAddress.String()),
+				sdk.NewAttribute(types.AttributeKeyDelegator, lock.DelegatorAddress.String()),
+			),
+		)
+	}
+}
@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// setupDelegationLocks delegates to two validators with lock tiers of one and
+// two hours
+func setupDelegationLocks(t *testing.T) (sdk.Context, Keeper) {
+	ctx, _, _, keeper, _ := CreateTestInput(t, false, 1000)
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0).UTC())
+
+	params := keeper.GetParams(ctx)
+	params.LockTiers = []types.LockTier{
+		types.NewLockTier(time.Hour, sdk.NewDecWithPrec(11, 1)),
+		types.NewLockTier(2*time.Hour, sdk.NewDecWithPrec(12, 1)),
+	}
+	keeper.SetParams(ctx, params)
+
+	for i := 0; i < 2; i++ {
+		keeper.SetValidator(ctx, types.NewValidator(addrVals[i], PKs[i], types.Description{}))
+		validator, found := keeper.GetValidator(ctx, addrVals[i])
+		require.True(t, found)
+		_, err := keeper.Delegate(ctx, addrDels[0], sdk.NewInt(100), sdk.Unbonded, validator, true)
+		require.NoError(t, err)
+	}
+
+	return ctx, keeper
+}
+
+func TestLockDelegation(t *testing.T) {
+	ctx, keeper := setupDelegationLocks(t)
+	blockTime := ctx.BlockHeader().Time
+
+	_, err := keeper.LockDelegation(ctx, addrDels[0], addrVals[0], 3*time.Hour)
+	require.True(t, types.ErrInvalidLockDuration.Is(err))
+
+	_, err = keeper.LockDelegation(ctx, addrDels[1], addrVals[0], time.Hour)
+	require.Equal(t, types.ErrNoDelegation, err)
+
+	lock, err := keeper.LockDelegation(ctx, addrDels[0], addrVals[0], 2*time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, types.NewDelegationLock(addrDels[0], addrVals[0], blockTime.Add(2*time.Hour), sdk.NewDecWithPrec(12, 1)), lock)
+	require.Equal(t, sdk.NewDecWithPrec(12, 1), keeper.DelegationRewardMultiplier(ctx, addrDels[0], addrVals[0]))
+	require.Equal(t, sdk.OneDec(), keeper.DelegationRewardMultiplier(ctx, addrDels[0], addrVals[1]))
+
+	// a lock cannot be shortened, but it can be replaced by one which ends later
+	_, err = keeper.LockDelegation(ctx, addrDels[0], addrVals[0], time.Hour)
+	require.True(t, types.ErrDelegationLockShortened.Is(err))
+
+	ctx = ctx.WithBlockTime(blockTime.Add(90 * time.Minute))
+	lock, err = keeper.LockDelegation(ctx, addrDels[0], addrVals[0], time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, blockTime.Add(150*time.Minute), lock.EndTime)
+	require.Equal(t, sdk.NewDecWithPrec(11, 1), lock.RewardMultiplier)
+	require.Equal(t, []types.DelegationLock{lock}, keeper.GetAllDelegationLocks(ctx))
+}
+
+func TestDelegationLockEnforcement(t *testing.T) {
+	ctx, keeper := setupDelegationLocks(t)
+	blockTime := ctx.BlockHeader().Time
+
+	_, err := keeper.LockDelegation(ctx, addrDels[0], addrVals[0], time.Hour)
+	require.NoError(t, err)
+
+	_, err = keeper.Undelegate(ctx, addrDels[0], addrVals[0], sdk.NewDec(10))
+	require.Equal(t, types.ErrDelegationLocked, err)
+
+	_, err = keeper.BeginRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1], sdk.NewDec(10))
+	require.Equal(t, types.ErrDelegationLocked, err)
+
+	// the delegation to the other validator is not affected
+	_, err = keeper.Undelegate(ctx, addrDels[0], addrVals[1], sdk.NewDec(10))
+	require.NoError(t, err)
+
+	// the lock no longer applies once it ends, even before it is removed
+	ctx = ctx.WithBlockTime(blockTime.Add(time.Hour))
+	_, err = keeper.Undelegate(ctx, addrDels[0], addrVals[0], sdk.NewDec(10))
+	require.NoError(t, err)
+
+	// removing the delegation removes its lock
+	_, err = keeper.Undelegate(ctx, addrDels[0], addrVals[0], sdk.NewDec(90))
+	require.NoError(t, err)
+	_, found := keeper.GetDelegationLock(ctx, addrDels[0], addrVals[0])
+	require.False(t, found)
+	require.Empty(t, keeper.GetDelegationLockQueueTimeSlice(ctx, blockTime.Add(time.Hour)))
+}
+
+func TestCompleteMatureDelegationLocks(t *testing.T) {
+	ctx, keeper := setupDelegationLocks(t)
+	blockTime := ctx.BlockHeader().Time
+
+	lock, err := keeper.LockDelegation(ctx, addrDels[0], addrVals[0], time.Hour)
+	require.NoError(t, err)
+	_, err = keeper.LockDelegation(ctx, addrDels[0], addrVals[1], time.Hour)
+	require.NoError(t, err)
+
+	// extend the second lock, which moves its queue entry
+	ctx = ctx.WithBlockTime(blockTime.Add(30 * time.Minute))
+	extended, err := keeper.LockDelegation(ctx, addrDels[0], addrVals[1], 2*time.Hour)
+	require.NoError(t, err)
+	require.Len(t, keeper.GetDelegationLockQueueTimeSlice(ctx, blockTime.Add(time.Hour)), 1)
+	require.Len(t, keeper.GetDelegationLockQueueTimeSlice(ctx, extended.EndTime), 1)
+
+	ctx = ctx.WithBlockTime(blockTime.Add(59 * time.Minute))
+	keeper.CompleteMatureDelegationLocks(ctx)
+	require.Len(t, keeper.GetAllDelegationLocks(ctx), 2)
+
+	// a matured lock applies its multiplier until it is completed
+	ctx = ctx.WithBlockTime(blockTime.Add(time.Hour))
+	require.Equal(t, lock.RewardMultiplier, keeper.DelegationRewardMultiplier(ctx, addrDels[0], addrVals[0]))
+	require.Equal(t, extended.RewardMultiplier, keeper.DelegationRewardMultiplier(ctx, addrDels[0], addrVals[1]))
+
+	keeper.CompleteMatureDelegationLocks(ctx)
+	require.Equal(t, []types.DelegationLock{extended}, keeper.GetAllDelegationLocks(ctx))
+	require.Equal(t, sdk.OneDec(), keeper.DelegationRewardMultiplier(ctx, addrDels[0], addrVals[0]))
+
+	ctx = ctx.WithBlockTime(extended.EndTime)
+	keeper.CompleteMatureDelegationLocks(ctx)
+	require.Empty(t, keeper.GetAllDelegationLocks(ctx))
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"github.com/tendermint/tendermint/crypto"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -43,29 +45,38 @@ func (k Keeper) AfterValidatorBeginUnbonding(ctx sdk.Context, consAddr sdk.ConsA
 	}
 }
 
-// BeforeDelegationCreated - call hook if registered
+// BeforeDelegationCreated - record the delegation change and call hook if registered
 func (k Keeper) BeforeDelegationCreated(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	k.beforeDelegationChange(ctx, delAddr, valAddr)
+
 	if k.hooks != nil {
 		k.hooks.BeforeDelegationCreated(ctx, delAddr, valAddr)
 	}
 }
 
-// BeforeDelegationSharesModified - call hook if registered
+// BeforeDelegationSharesModified - record the delegation change and call hook if registered
 func (k Keeper) BeforeDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	k.beforeDelegationChange(ctx, delAddr, valAddr)
+
 	if k.hooks != nil {
 		k.hooks.BeforeDelegationSharesModified(ctx, delAddr, valAddr)
 	}
 }
 
-// BeforeDelegationRemoved - call hook if registered
+// BeforeDelegationRemoved - record the delegation change and call hook if registered
 func (k Keeper) BeforeDelegationRemoved(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	k.beforeDelegationChange(ctx, delAddr, valAddr)
+	k.afterDelegationChange(ctx, delAddr, valAddr, true)
+
 	if k.hooks != nil {
 		k.hooks.BeforeDelegationRemoved(ctx, delAddr, valAddr)
 	}
 }
 
-// AfterDelegationModified - call hook if registered
+// AfterDelegationModified - record the delegation change and call hook if registered
 func (k Keeper) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	k.afterDelegationChange(ctx, delAddr, valAddr, false)
+
 	if k.hooks != nil {
 		k.hooks.AfterDelegationModified(ctx, delAddr, valAddr)
 	}
@@ -77,3 +88,17 @@ func (k Keeper) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress,
 		k.hooks.BeforeValidatorSlashed(ctx, valAddr, fraction)
 	}
 }
+
+// AfterConsensusPubKeyRotated - call hook if registered
+func (k Keeper) AfterConsensusPubKeyRotated(ctx sdk.Context, oldPubKey, newPubKey crypto.PubKey, valAddr sdk.ValAddress) {
+	if k.hooks != nil {
+		k.hooks.AfterConsensusPubKeyRotated(ctx, oldPubKey, newPubKey, valAddr)
+	}
+}
+
+// AfterValidatorRetired - call hook if registered
+func (k Keeper) AfterValidatorRetired(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
+	if k.hooks != nil {
+		k.hooks.AfterValidatorRetired(ctx, consAddr, valAddr)
+	}
+}
@@ -24,6 +24,7 @@ var _ types.DelegationSet = Keeper{}
 type Keeper struct {
 	storeKey           sdk.StoreKey
 	cdc                codec.Marshaler
+	authKeeper         types.AccountKeeper
 	bankKeeper         types.BankKeeper
 	supplyKeeper       types.SupplyKeeper
 	hooks              types.StakingHooks
@@ -34,7 +35,8 @@ type Keeper struct {
 
 // NewKeeper creates a new staking Keeper instance
 func NewKeeper(
-	cdc codec.Marshaler, key sdk.StoreKey, bk types.BankKeeper, sk types.SupplyKeeper, ps params.Subspace,
+	cdc codec.Marshaler, key sdk.StoreKey, ak types.AccountKeeper, bk types.BankKeeper, sk types.SupplyKeeper,
+	ps params.Subspace,
 ) Keeper {
 
 	// ensure bonded and not bonded module accounts are set
@@ -49,6 +51,7 @@ func NewKeeper(
 	return Keeper{
 		storeKey:           key,
 		cdc:                cdc,
+		authKeeper:         ak,
 		bankKeeper:         bk,
 		supplyKeeper:       sk,
 		paramstore:         ps.WithKeyTable(ParamKeyTable()),
@@ -50,6 +50,48 @@ func (k Keeper) BondDenom(ctx sdk.Context) (res string) {
 	return
 }
 
+// KeyRotationFee - amount of bond denom tokens burned on a consensus key rotation
+func (k Keeper) KeyRotationFee(ctx sdk.Context) (res sdk.Int) {
+	k.paramstore.Get(ctx, types.KeyKeyRotationFee, &res)
+	return
+}
+
+// GlobalLiquidStakingCap - maximum fraction of the bonded tokens which can be tokenized
+func (k Keeper) GlobalLiquidStakingCap(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyGlobalLiquidStakingCap, &res)
+	return
+}
+
+// ValidatorLiquidStakingCap - maximum fraction of the tokens of a validator which can be tokenized
+func (k Keeper) ValidatorLiquidStakingCap(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyValidatorLiquidStakingCap, &res)
+	return
+}
+
+// MinCommissionRate - minimum commission rate of the validators
+func (k Keeper) MinCommissionRate(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyMinCommissionRate, &res)
+	return
+}
+
+// DelegationHistoryRetention - number of blocks the delegation changes are kept for
+func (k Keeper) DelegationHistoryRetention(ctx sdk.Context) (res uint32) {
+	k.paramstore.Get(ctx, types.KeyDelegationHistoryRetention, &res)
+	return
+}
+
+// LockTiers - durations delegations can be locked for and their reward multipliers
+func (k Keeper) LockTiers(ctx sdk.Context) (res []types.LockTier) {
+	k.paramstore.Get(ctx, types.KeyLockTiers, &res)
+	return
+}
+
+// MaxCommissionChangeRate - maximum daily change of the commission rate of the validators
+func (k Keeper) MaxCommissionChangeRate(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyMaxCommissionChangeRate, &res)
+	return
+}
+
 // Get all parameteras as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	return types.NewParams(
@@ -58,6 +100,13 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 		k.MaxEntries(ctx),
 		k.HistoricalEntries(ctx),
 		k.BondDenom(ctx),
+		k.KeyRotationFee(ctx),
+		k.GlobalLiquidStakingCap(ctx),
+		k.ValidatorLiquidStakingCap(ctx),
+		k.MinCommissionRate(ctx),
+		k.DelegationHistoryRetention(ctx),
+		k.LockTiers(ctx),
+		k.MaxCommissionChangeRate(ctx),
 	)
 }
 
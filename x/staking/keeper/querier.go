@@ -2,7 +2,6 @@ package keeper
 
 import (
 	"errors"
-	"strings"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 
@@ -13,6 +12,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
+// defaultQueryLimit is the page size of the paginated queries added along with
+// their pagination when no limit is given
+const defaultQueryLimit = 100
+
 // creates a querier for staking REST endpoints
 func NewQuerier(k Keeper) sdk.Querier {
 	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
@@ -53,6 +56,18 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case types.QueryHistoricalInfo:
 			return queryHistoricalInfo(ctx, req, k)
 
+		case types.QueryTokenizeShareRecords:
+			return queryTokenizeShareRecords(ctx, req, k)
+
+		case types.QueryDelegatorDelegationChanges:
+			return queryDelegatorDelegationChanges(ctx, req, k)
+
+		case types.QueryDelegatorDelegationsAtHeight:
+			return queryDelegatorDelegationsAtHeight(ctx, req, k)
+
+		case types.QueryDelegationLock:
+			return queryDelegationLock(ctx, req, k)
+
 		case types.QueryPool:
 			return queryPool(ctx, k)
 
@@ -73,11 +88,15 @@ func queryValidators(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte,
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
+	if err := types.ValidateValidatorFilter(params.Status); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
 	validators := k.GetAllValidators(ctx)
 	filteredVals := make([]types.Validator, 0, len(validators))
 
 	for _, val := range validators {
-		if strings.EqualFold(val.GetStatus().String(), params.Status) {
+		if types.MatchesValidatorFilter(val, params.Status, params.Jailed) {
 			filteredVals = append(filteredVals, val)
 		}
 	}
@@ -126,7 +145,8 @@ func queryValidatorDelegations(ctx sdk.Context, req abci.RequestQuery, k Keeper)
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
-	delegations := k.GetValidatorDelegations(ctx, params.ValidatorAddr)
+	delegations := k.GetValidatorDelegationsPaginated(ctx, params.ValidatorAddr, params.Page, params.Limit)
+
 	delegationResps, err := delegationsToDelegationResponses(ctx, k, delegations)
 	if err != nil {
 		return nil, err
@@ -153,8 +173,12 @@ func queryValidatorUnbondingDelegations(ctx sdk.Context, req abci.RequestQuery,
 	}
 
 	unbonds := k.GetUnbondingDelegationsFromValidator(ctx, params.ValidatorAddr)
-	if unbonds == nil {
+
+	start, end := paginate(len(unbonds), params.Page, params.Limit)
+	if start < 0 || end < 0 {
 		unbonds = types.UnbondingDelegations{}
+	} else {
+		unbonds = unbonds[start:end]
 	}
 
 	res, err := codec.MarshalJSONIndent(types.ModuleCdc, unbonds)
@@ -173,7 +197,24 @@ func queryDelegatorDelegations(ctx sdk.Context, req abci.RequestQuery, k Keeper)
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
-	delegations := k.GetAllDelegatorDelegations(ctx, params.DelegatorAddr)
+	if err := types.ValidateValidatorFilter(params.Status); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	var delegations []types.Delegation
+	for _, delegation := range k.GetAllDelegatorDelegations(ctx, params.DelegatorAddr) {
+		if delegatorValidatorMatches(ctx, k, delegation.ValidatorAddress, params) {
+			delegations = append(delegations, delegation)
+		}
+	}
+
+	start, end := paginate(len(delegations), params.Page, params.Limit)
+	if start < 0 || end < 0 {
+		delegations = []types.Delegation{}
+	} else {
+		delegations = delegations[start:end]
+	}
+
 	delegationResps, err := delegationsToDelegationResponses(ctx, k, delegations)
 	if err != nil {
 		return nil, err
@@ -199,9 +240,22 @@ func queryDelegatorUnbondingDelegations(ctx sdk.Context, req abci.RequestQuery,
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
-	unbondingDelegations := k.GetAllUnbondingDelegations(ctx, params.DelegatorAddr)
-	if unbondingDelegations == nil {
+	if err := types.ValidateValidatorFilter(params.Status); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	var unbondingDelegations types.UnbondingDelegations
+	for _, ubd := range k.GetAllUnbondingDelegations(ctx, params.DelegatorAddr) {
+		if delegatorValidatorMatches(ctx, k, ubd.ValidatorAddress, params) {
+			unbondingDelegations = append(unbondingDelegations, ubd)
+		}
+	}
+
+	start, end := paginate(len(unbondingDelegations), params.Page, params.Limit)
+	if start < 0 || end < 0 {
 		unbondingDelegations = types.UnbondingDelegations{}
+	} else {
+		unbondingDelegations = unbondingDelegations[start:end]
 	}
 
 	res, err := codec.MarshalJSONIndent(types.ModuleCdc, unbondingDelegations)
@@ -215,16 +269,28 @@ func queryDelegatorUnbondingDelegations(ctx sdk.Context, req abci.RequestQuery,
 func queryDelegatorValidators(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
 	var params types.QueryDelegatorParams
 
-	stakingParams := k.GetParams(ctx)
-
 	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
-	validators := k.GetDelegatorValidators(ctx, params.DelegatorAddr, stakingParams.MaxValidators)
-	if validators == nil {
+	if err := types.ValidateValidatorFilter(params.Status); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	var validators types.Validators
+	for _, delegation := range k.GetAllDelegatorDelegations(ctx, params.DelegatorAddr) {
+		validator, found := k.GetValidator(ctx, delegation.ValidatorAddress)
+		if found && types.MatchesValidatorFilter(validator, params.Status, params.Jailed) {
+			validators = append(validators, validator)
+		}
+	}
+
+	start, end := paginate(len(validators), params.Page, params.Limit)
+	if start < 0 || end < 0 {
 		validators = types.Validators{}
+	} else {
+		validators = validators[start:end]
 	}
 
 	res, err := codec.MarshalJSONIndent(types.ModuleCdc, validators)
@@ -327,6 +393,13 @@ func queryRedelegations(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byt
 		redels = k.GetAllRedelegations(ctx, params.DelegatorAddr, params.SrcValidatorAddr, params.DstValidatorAddr)
 	}
 
+	start, end := paginate(len(redels), params.Page, params.Limit)
+	if start < 0 || end < 0 {
+		redels = []types.Redelegation{}
+	} else {
+		redels = redels[start:end]
+	}
+
 	redelResponses, err := redelegationsToRedelegationResponses(ctx, k, redels)
 	if err != nil {
 		return nil, err
@@ -365,6 +438,105 @@ func queryHistoricalInfo(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]by
 	return res, nil
 }
 
+func queryTokenizeShareRecords(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryTokenizeShareRecordsParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	records := k.GetAllTokenizeShareRecords(ctx)
+
+	start, end := paginate(len(records), params.Page, params.Limit)
+	if start < 0 || end < 0 {
+		records = []types.TokenizeShareRecord{}
+	} else {
+		records = records[start:end]
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, records)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryDelegatorDelegationChanges(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryDelegatorDelegationChangesParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	changes := k.GetDelegatorDelegationChanges(ctx, params.DelegatorAddr)
+
+	start, end := client.Paginate(len(changes), params.Page, params.Limit, defaultQueryLimit)
+	if start < 0 || end < 0 {
+		changes = []types.DelegationChange{}
+	} else {
+		changes = changes[start:end]
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, changes)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryDelegatorDelegationsAtHeight(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryDelegatorDelegationsAtHeightParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	delegations, err := k.GetDelegatorDelegationsAtHeight(ctx, params.DelegatorAddr, params.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	start, end := client.Paginate(len(delegations), params.Page, params.Limit, defaultQueryLimit)
+	if start < 0 || end < 0 {
+		delegations = []types.Delegation{}
+	} else {
+		delegations = delegations[start:end]
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, delegations)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryDelegationLock(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryBondsParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	lock, found := k.GetDelegationLock(ctx, params.DelegatorAddr, params.ValidatorAddr)
+	if !found {
+		return nil, types.ErrNoDelegationLock
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, lock)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
 func queryPool(ctx sdk.Context, k Keeper) ([]byte, error) {
 	bondDenom := k.BondDenom(ctx)
 
@@ -415,6 +587,34 @@ func delegationToDelegationResponse(ctx sdk.Context, k Keeper, del types.Delegat
 	), nil
 }
 
+// delegatorValidatorMatches returns true if the validator of a bond of the
+// delegator passes the validator filters of the query. The bonds to removed
+// validators only pass when there are no filters.
+func delegatorValidatorMatches(
+	ctx sdk.Context, k Keeper, valAddr sdk.ValAddress, params types.QueryDelegatorParams,
+) bool {
+
+	if params.Status == "" && params.Jailed == nil {
+		return true
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	return found && types.MatchesValidatorFilter(validator, params.Status, params.Jailed)
+}
+
+// paginate returns the bounds of a page of the results of a query which
+// predates its pagination. A zero limit returns all the results, so that the
+// requests without pagination keep returning all of them.
+func paginate(numObjs, page, limit int) (start, end int) {
+	if limit == 0 {
+		return 0, numObjs
+	}
+	if page == 0 {
+		page = 1
+	}
+	return client.Paginate(numObjs, page, limit, limit)
+}
+
 func delegationsToDelegationResponses(
 	ctx sdk.Context, k Keeper, delegations types.Delegations,
 ) (types.DelegationResponses, error) {
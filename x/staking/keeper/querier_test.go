@@ -55,7 +55,7 @@ func TestNewQuerier(t *testing.T) {
 	_, err = querier(ctx, []string{"parameters"}, query)
 	require.NoError(t, err)
 
-	queryValParams := types.NewQueryValidatorParams(addrVal1)
+	queryValParams := types.NewQueryValidatorParams(addrVal1, 1, 0)
 	bz, errRes := cdc.MarshalJSON(queryValParams)
 	require.NoError(t, errRes)
 
@@ -71,7 +71,7 @@ func TestNewQuerier(t *testing.T) {
 	_, err = querier(ctx, []string{"validatorUnbondingDelegations"}, query)
 	require.NoError(t, err)
 
-	queryDelParams := types.NewQueryDelegatorParams(addrAcc2)
+	queryDelParams := types.NewQueryDelegatorParams(addrAcc2, 1, 0, "", nil)
 	bz, errRes = cdc.MarshalJSON(queryDelParams)
 	require.NoError(t, errRes)
 
@@ -87,7 +87,7 @@ func TestNewQuerier(t *testing.T) {
 	_, err = querier(ctx, []string{"delegatorValidators"}, query)
 	require.NoError(t, err)
 
-	bz, errRes = cdc.MarshalJSON(types.NewQueryRedelegationParams(nil, nil, nil))
+	bz, errRes = cdc.MarshalJSON(types.NewQueryRedelegationParams(nil, nil, nil, 1, 0))
 	require.NoError(t, errRes)
 	query.Data = bz
 
@@ -152,7 +152,7 @@ func TestQueryValidators(t *testing.T) {
 	queriedValidators := keeper.GetValidators(ctx, params.MaxValidators)
 
 	for i, s := range status {
-		queryValsParams := types.NewQueryValidatorsParams(1, int(params.MaxValidators), s.String())
+		queryValsParams := types.NewQueryValidatorsParams(1, int(params.MaxValidators), s.String(), nil)
 		bz, err := cdc.MarshalJSON(queryValsParams)
 		require.NoError(t, err)
 
@@ -174,7 +174,7 @@ func TestQueryValidators(t *testing.T) {
 	}
 
 	// Query each validator
-	queryParams := types.NewQueryValidatorParams(addrVal1)
+	queryParams := types.NewQueryValidatorParams(addrVal1, 1, 0)
 	bz, err := cdc.MarshalJSON(queryParams)
 	require.NoError(t, err)
 
@@ -213,7 +213,7 @@ func TestQueryDelegation(t *testing.T) {
 	keeper.ApplyAndReturnValidatorSetUpdates(ctx)
 
 	// Query Delegator bonded validators
-	queryParams := types.NewQueryDelegatorParams(addrAcc2)
+	queryParams := types.NewQueryDelegatorParams(addrAcc2, 1, 0, "", nil)
 	bz, errRes := cdc.MarshalJSON(queryParams)
 	require.NoError(t, errRes)
 
@@ -311,7 +311,7 @@ func TestQueryDelegation(t *testing.T) {
 
 	// Query validator delegations
 
-	bz, errRes = cdc.MarshalJSON(types.NewQueryValidatorParams(addrVal1))
+	bz, errRes = cdc.MarshalJSON(types.NewQueryValidatorParams(addrVal1, 1, 0))
 	require.NoError(t, errRes)
 
 	query = abci.RequestQuery{
@@ -391,7 +391,7 @@ func TestQueryDelegation(t *testing.T) {
 	redel, found := keeper.GetRedelegation(ctx, addrAcc2, val1.OperatorAddress, val2.OperatorAddress)
 	require.True(t, found)
 
-	bz, errRes = cdc.MarshalJSON(types.NewQueryRedelegationParams(addrAcc2, val1.OperatorAddress, val2.OperatorAddress))
+	bz, errRes = cdc.MarshalJSON(types.NewQueryRedelegationParams(addrAcc2, val1.OperatorAddress, val2.OperatorAddress, 1, 0))
 	require.NoError(t, errRes)
 
 	query = abci.RequestQuery{
@@ -434,7 +434,7 @@ func TestQueryRedelegations(t *testing.T) {
 	require.True(t, found)
 
 	// delegator redelegations
-	queryDelegatorParams := types.NewQueryDelegatorParams(addrAcc2)
+	queryDelegatorParams := types.NewQueryDelegatorParams(addrAcc2, 1, 0, "", nil)
 	bz, errRes := cdc.MarshalJSON(queryDelegatorParams)
 	require.NoError(t, errRes)
 
@@ -456,7 +456,7 @@ func TestQueryRedelegations(t *testing.T) {
 	require.Len(t, redel.Entries, len(redelRes[0].Entries))
 
 	// validator redelegations
-	queryValidatorParams := types.NewQueryValidatorParams(val1.GetOperator())
+	queryValidatorParams := types.NewQueryValidatorParams(val1.GetOperator(), 1, 0)
 	bz, errRes = cdc.MarshalJSON(queryValidatorParams)
 	require.NoError(t, errRes)
 
@@ -535,7 +535,7 @@ func TestQueryUnbondingDelegation(t *testing.T) {
 	//
 	// found: query unbonding delegation by delegator and validator
 	//
-	queryDelegatorParams := types.NewQueryDelegatorParams(addrAcc1)
+	queryDelegatorParams := types.NewQueryDelegatorParams(addrAcc1, 1, 0, "", nil)
 	bz, errRes = cdc.MarshalJSON(queryDelegatorParams)
 	require.NoError(t, errRes)
 	query = abci.RequestQuery{
@@ -554,7 +554,7 @@ func TestQueryUnbondingDelegation(t *testing.T) {
 	//
 	// not found: query unbonding delegation by delegator and validator
 	//
-	queryDelegatorParams = types.NewQueryDelegatorParams(addrAcc2)
+	queryDelegatorParams = types.NewQueryDelegatorParams(addrAcc2, 1, 0, "", nil)
 	bz, errRes = cdc.MarshalJSON(queryDelegatorParams)
 	require.NoError(t, errRes)
 	query = abci.RequestQuery{
@@ -609,3 +609,181 @@ func TestQueryHistoricalInfo(t *testing.T) {
 	require.NoError(t, cdc.UnmarshalJSON(res, &recv))
 	require.Equal(t, hi, recv, "HistoricalInfo query returned wrong result")
 }
+
+func TestQueryDelegationHistory(t *testing.T) {
+	cdc := codec.New()
+	ctx, keeper := setupDelegationHistory(t, 10)
+
+	bz, errRes := cdc.MarshalJSON(types.NewQueryDelegatorDelegationChangesParams(addrDels[0], 2, 3))
+	require.NoError(t, errRes)
+	query := abci.RequestQuery{
+		Path: "/custom/staking/delegatorDelegationChanges",
+		Data: bz,
+	}
+	res, err := queryDelegatorDelegationChanges(ctx, query, keeper)
+	require.NoError(t, err)
+
+	var changes []types.DelegationChange
+	require.NoError(t, cdc.UnmarshalJSON(res, &changes))
+	require.Equal(t, keeper.GetDelegatorDelegationChanges(ctx, addrDels[0])[3:], changes)
+
+	bz, errRes = cdc.MarshalJSON(types.NewQueryDelegatorDelegationsAtHeightParams(addrDels[0], 2, 1, 0))
+	require.NoError(t, errRes)
+	query = abci.RequestQuery{
+		Path: "/custom/staking/delegatorDelegationsAtHeight",
+		Data: bz,
+	}
+	res, err = queryDelegatorDelegationsAtHeight(ctx, query, keeper)
+	require.NoError(t, err)
+
+	var delegations types.Delegations
+	require.NoError(t, cdc.UnmarshalJSON(res, &delegations))
+	require.Equal(t, types.Delegations{
+		types.NewDelegation(addrDels[0], addrVals[0], sdk.NewDec(150)),
+		types.NewDelegation(addrDels[0], addrVals[1], sdk.NewDec(100)),
+	}, delegations)
+
+	bz, errRes = cdc.MarshalJSON(types.NewQueryDelegatorDelegationsAtHeightParams(addrDels[0], 5, 1, 0))
+	require.NoError(t, errRes)
+	query.Data = bz
+	_, err = queryDelegatorDelegationsAtHeight(ctx, query, keeper)
+	require.Error(t, err)
+}
+
+func TestQueryPaginationAndFilters(t *testing.T) {
+	cdc := codec.New()
+	ctx, _, _, keeper, _ := CreateTestInput(t, false, 1000)
+
+	// the first validator is bonded and the third one is jailed
+	for i := 0; i < 3; i++ {
+		validator := types.NewValidator(addrVals[i], PKs[i], types.Description{})
+		if i == 0 {
+			validator = validator.UpdateStatus(sdk.Bonded)
+		}
+		validator.Jailed = i == 2
+		keeper.SetValidator(ctx, validator)
+
+		_, err := keeper.Delegate(ctx, addrDels[0], sdk.NewInt(100), sdk.Unbonded, validator, true)
+		require.NoError(t, err)
+	}
+
+	jailed, unjailed := true, false
+	queryDelegations := func(page, limit int, status string, jailed *bool) []sdk.ValAddress {
+		bz, errRes := cdc.MarshalJSON(types.NewQueryDelegatorParams(addrDels[0], page, limit, status, jailed))
+		require.NoError(t, errRes)
+
+		res, err := queryDelegatorDelegations(ctx, abci.RequestQuery{Data: bz}, keeper)
+		require.NoError(t, err)
+
+		var delegations types.DelegationResponses
+		require.NoError(t, cdc.UnmarshalJSON(res, &delegations))
+
+		valAddrs := []sdk.ValAddress{}
+		for _, delegation := range delegations {
+			valAddrs = append(valAddrs, delegation.ValidatorAddress)
+		}
+		return valAddrs
+	}
+
+	require.Len(t, queryDelegations(0, 0, "", nil), 3)
+	require.Len(t, queryDelegations(1, 2, "", nil), 2)
+	require.Len(t, queryDelegations(2, 2, "", nil), 1)
+	require.Empty(t, queryDelegations(3, 2, "", nil))
+	require.Equal(t, []sdk.ValAddress{addrVals[0]}, queryDelegations(1, 0, sdk.BondStatusBonded, nil))
+	require.Equal(t, []sdk.ValAddress{addrVals[2]}, queryDelegations(1, 0, "", &jailed))
+	require.Equal(t, []sdk.ValAddress{addrVals[1]}, queryDelegations(1, 0, sdk.BondStatusUnbonded, &unjailed))
+
+	// query the validators of the delegator
+	bz, errRes := cdc.MarshalJSON(types.NewQueryDelegatorParams(addrDels[0], 1, 0, sdk.BondStatusUnbonded, nil))
+	require.NoError(t, errRes)
+	res, err := queryDelegatorValidators(ctx, abci.RequestQuery{Data: bz}, keeper)
+	require.NoError(t, err)
+
+	var validators types.Validators
+	require.NoError(t, cdc.UnmarshalJSON(res, &validators))
+	require.Len(t, validators, 2)
+	require.Equal(t, addrVals[1], validators[0].OperatorAddress)
+	require.Equal(t, addrVals[2], validators[1].OperatorAddress)
+
+	// query all the jailed validators
+	bz, errRes = cdc.MarshalJSON(types.NewQueryValidatorsParams(1, 0, "", &jailed))
+	require.NoError(t, errRes)
+	res, err = queryValidators(ctx, abci.RequestQuery{Data: bz}, keeper)
+	require.NoError(t, err)
+
+	require.NoError(t, cdc.UnmarshalJSON(res, &validators))
+	require.Len(t, validators, 1)
+	require.Equal(t, addrVals[2], validators[0].OperatorAddress)
+
+	// invalid status
+	bz, errRes = cdc.MarshalJSON(types.NewQueryDelegatorParams(addrDels[0], 1, 0, "active", nil))
+	require.NoError(t, errRes)
+	_, err = queryDelegatorDelegations(ctx, abci.RequestQuery{Data: bz}, keeper)
+	require.Error(t, err)
+}
+
+func TestQueryValidatorDelegationsPagination(t *testing.T) {
+	cdc := codec.New()
+	ctx, _, _, keeper, _ := CreateTestInput(t, false, 1000)
+
+	validator := types.NewValidator(addrVal1, pk1, types.Description{})
+	keeper.SetValidator(ctx, validator)
+
+	// more delegations than the default page size
+	numDels := 150
+	delAddrs := make([]sdk.AccAddress, numDels)
+	for i := range delAddrs {
+		delAddrs[i] = sdk.AccAddress(fmt.Sprintf("delegator%011d", i))
+		_, err := keeper.Delegate(ctx, delAddrs[i], sdk.NewInt(100), sdk.Unbonded, validator, false)
+		require.NoError(t, err)
+		validator = keeper.mustGetValidator(ctx, addrVal1)
+	}
+
+	// a delegation to another validator is not returned
+	otherValidator := types.NewValidator(addrVal2, pk2, types.Description{})
+	keeper.SetValidator(ctx, otherValidator)
+	_, err := keeper.Delegate(ctx, delAddrs[0], sdk.NewInt(100), sdk.Unbonded, otherValidator, false)
+	require.NoError(t, err)
+
+	queryDelegators := func(page, limit int) []sdk.AccAddress {
+		bz, errRes := cdc.MarshalJSON(types.NewQueryValidatorParams(addrVal1, page, limit))
+		require.NoError(t, errRes)
+
+		res, err := queryValidatorDelegations(ctx, abci.RequestQuery{Data: bz}, keeper)
+		require.NoError(t, err)
+
+		var delegations types.DelegationResponses
+		require.NoError(t, cdc.UnmarshalJSON(res, &delegations))
+
+		delAddrs := []sdk.AccAddress{}
+		for _, delegation := range delegations {
+			require.Equal(t, addrVal1, delegation.ValidatorAddress)
+			delAddrs = append(delAddrs, delegation.DelegatorAddress)
+		}
+		return delAddrs
+	}
+
+	// no limit returns all the delegations
+	require.Equal(t, delAddrs, queryDelegators(0, 0))
+	require.Equal(t, delAddrs, queryDelegators(1, 0))
+
+	require.Equal(t, delAddrs[:60], queryDelegators(1, 60))
+	require.Equal(t, delAddrs[60:120], queryDelegators(2, 60))
+	require.Equal(t, delAddrs[120:], queryDelegators(3, 60))
+	require.Empty(t, queryDelegators(4, 60))
+
+	// removed delegations are removed from the index
+	_, err = keeper.Undelegate(ctx, delAddrs[0], addrVal1, sdk.NewDec(100))
+	require.NoError(t, err)
+	require.Equal(t, delAddrs[1:61], queryDelegators(1, 60))
+
+	// the index can be rebuilt from the delegations
+	store := ctx.KVStore(keeper.storeKey)
+	for _, delAddr := range delAddrs[1:] {
+		store.Delete(types.GetDelegationByValIndexKey(delAddr, addrVal1))
+	}
+	require.Empty(t, queryDelegators(0, 0))
+
+	keeper.SetDelegationsByValIndex(ctx)
+	require.Equal(t, delAddrs[1:], queryDelegators(0, 0))
+}
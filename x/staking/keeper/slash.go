@@ -117,7 +117,9 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 
 	// Deduct from validator's bonded tokens and update the validator.
 	// Burn the slashed tokens from the pool account and decrease the total supply.
-	validator = k.RemoveValidatorTokens(ctx, validator, tokensToBurn)
+	slashedValidator := k.RemoveValidatorTokens(ctx, validator, tokensToBurn)
+	k.slashTokenizedTokens(ctx, validator, slashedValidator)
+	validator = slashedValidator
 
 	switch validator.GetStatus() {
 	case sdk.Bonded:
@@ -602,3 +602,109 @@ func TestSlashBoth(t *testing.T) {
 	// power not decreased, all stake was bonded since
 	require.Equal(t, int64(10), validator.GetConsensusPower())
 }
+
+// tests the slashing of a validator whose redelegated stake has been
+// redelegated again
+func TestSlashWithChainedRedelegation(t *testing.T) {
+	ctx, keeper, _ := setupHelper(t, 10)
+	consAddr := sdk.ConsAddress(PKs[0].Address())
+	fraction := sdk.NewDecWithPrec(5, 1)
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0))
+
+	// delegate to the first validator
+	delTokens := sdk.TokensFromConsensusPower(6)
+	validator, found := keeper.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+	_, err := keeper.Delegate(ctx, addrDels[0], delTokens, sdk.Unbonded, validator, true)
+	require.NoError(t, err)
+
+	// redelegate to the second validator, then half of it to the third one
+	ctx = ctx.WithBlockHeight(11)
+	_, err = keeper.BeginRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1], delTokens.ToDec())
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(12)
+	_, err = keeper.BeginRedelegation(ctx, addrDels[0], addrVals[1], addrVals[2], delTokens.QuoRaw(2).ToDec())
+	require.NoError(t, err)
+
+	rd, found := keeper.GetRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1])
+	require.True(t, found)
+	require.Equal(t, delTokens.QuoRaw(2), rd.Entries[0].InitialBalance)
+	rd, found = keeper.GetRedelegation(ctx, addrDels[0], addrVals[0], addrVals[2])
+	require.True(t, found)
+	require.Equal(t, int64(11), rd.Entries[0].CreationHeight)
+	require.Equal(t, delTokens.QuoRaw(2), rd.Entries[0].InitialBalance)
+
+	// slash the first validator for an infraction before the first redelegation
+	keeper.Slash(ctx, consAddr, 10, 10, fraction)
+
+	// both the second and the third delegations are slashed
+	for _, valAddr := range []sdk.ValAddress{addrVals[1], addrVals[2]} {
+		del, found := keeper.GetDelegation(ctx, addrDels[0], valAddr)
+		require.True(t, found)
+		require.Equal(t, delTokens.QuoRaw(4).ToDec(), del.Shares)
+	}
+}
+
+// tests the slashing of validators whose redelegated stake has been partially
+// redelegated again from a validator which received it from both of them
+func TestSlashWithParallelRedelegations(t *testing.T) {
+	ctx, keeper, _ := setupHelper(t, 10)
+	consAddr := sdk.ConsAddress(PKs[0].Address())
+	fraction := sdk.NewDecWithPrec(5, 1)
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0))
+
+	// add a fourth validator
+	amt := sdk.TokensFromConsensusPower(10)
+	bondedPool := keeper.GetBondedPool(ctx)
+	bondedPoolBalances := keeper.bankKeeper.GetAllBalances(ctx, bondedPool.GetAddress())
+	bondedCoins := sdk.NewCoins(sdk.NewCoin(keeper.BondDenom(ctx), amt))
+	require.NoError(t, keeper.bankKeeper.SetBalances(ctx, bondedPool.GetAddress(), bondedPoolBalances.Add(bondedCoins...)))
+	validator := types.NewValidator(addrVals[3], PKs[3], types.Description{})
+	validator, _ = validator.AddTokensFromDel(amt)
+	validator = TestingUpdateValidator(keeper, ctx, validator, true)
+	keeper.SetValidatorByConsAddr(ctx, validator)
+
+	// delegate to the first and the fourth validators
+	delTokens := sdk.TokensFromConsensusPower(2)
+	for _, valAddr := range []sdk.ValAddress{addrVals[0], addrVals[3]} {
+		validator, found := keeper.GetValidator(ctx, valAddr)
+		require.True(t, found)
+		_, err := keeper.Delegate(ctx, addrDels[0], delTokens, sdk.Unbonded, validator, true)
+		require.NoError(t, err)
+	}
+
+	// redelegate both to the second validator, then half of it to the third one
+	ctx = ctx.WithBlockHeight(11)
+	for _, valAddr := range []sdk.ValAddress{addrVals[0], addrVals[3]} {
+		_, err := keeper.BeginRedelegation(ctx, addrDels[0], valAddr, addrVals[1], delTokens.ToDec())
+		require.NoError(t, err)
+	}
+
+	ctx = ctx.WithBlockHeight(12)
+	_, err := keeper.BeginRedelegation(ctx, addrDels[0], addrVals[1], addrVals[2], delTokens.ToDec())
+	require.NoError(t, err)
+
+	// both received redelegations gave up half of their shares
+	for _, valSrcAddr := range []sdk.ValAddress{addrVals[0], addrVals[3]} {
+		for _, valDstAddr := range []sdk.ValAddress{addrVals[1], addrVals[2]} {
+			rd, found := keeper.GetRedelegation(ctx, addrDels[0], valSrcAddr, valDstAddr)
+			require.True(t, found)
+			require.Len(t, rd.Entries, 1)
+			require.Equal(t, int64(11), rd.Entries[0].CreationHeight)
+			require.Equal(t, delTokens.QuoRaw(2), rd.Entries[0].InitialBalance)
+			require.Equal(t, delTokens.QuoRaw(2).ToDec(), rd.Entries[0].SharesDst)
+		}
+	}
+
+	// slash the first validator for an infraction before the first redelegations
+	keeper.Slash(ctx, consAddr, 10, 10, fraction)
+
+	// both the second and the third delegations are slashed for the half of the
+	// stake they hold from the first validator
+	for _, valAddr := range []sdk.ValAddress{addrVals[1], addrVals[2]} {
+		del, found := keeper.GetDelegation(ctx, addrDels[0], valAddr)
+		require.True(t, found)
+		require.Equal(t, delTokens.Sub(delTokens.QuoRaw(4)).ToDec(), del.Shares)
+	}
+}
@@ -0,0 +1,407 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetTokenizeShareRecord gets a tokenize share record by id
+func (k Keeper) GetTokenizeShareRecord(ctx sdk.Context, id uint64) (record types.TokenizeShareRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	value := store.Get(types.GetTokenizeShareRecordKey(id))
+	if value == nil {
+		return record, false
+	}
+
+	record = types.MustUnmarshalTokenizeShareRecord(k.cdc, value)
+	return record, true
+}
+
+// GetTokenizeShareRecordByDenom gets the tokenize share record a share token
+// denomination was issued for
+func (k Keeper) GetTokenizeShareRecordByDenom(ctx sdk.Context, denom string) (record types.TokenizeShareRecord, found bool) {
+	id, err := types.ParseShareTokenDenom(denom)
+	if err != nil {
+		return record, false
+	}
+
+	return k.GetTokenizeShareRecord(ctx, id)
+}
+
+// GetTokenizeShareRecordsByValidator returns the tokenize share records of a
+// validator
+func (k Keeper) GetTokenizeShareRecordsByValidator(
+	ctx sdk.Context, valAddr sdk.ValAddress,
+) (records []types.TokenizeShareRecord) {
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetTokenizeShareRecordsByValKey(valAddr))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		record, found := k.GetTokenizeShareRecord(ctx, types.SplitTokenizeShareRecordByValKey(iterator.Key()))
+		if !found {
+			panic("tokenize share record index without record")
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+// SetTokenizeShareRecord sets a tokenize share record along with its index by
+// validator
+func (k Keeper) SetTokenizeShareRecord(ctx sdk.Context, record types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+	bz := types.MustMarshalTokenizeShareRecord(k.cdc, record)
+	store.Set(types.GetTokenizeShareRecordKey(record.Id), bz)
+	store.Set(types.GetTokenizeShareRecordByValKey(record.ValidatorAddress, record.Id), []byte{})
+}
+
+// DeleteTokenizeShareRecord deletes a tokenize share record along with its
+// index by validator
+func (k Keeper) DeleteTokenizeShareRecord(ctx sdk.Context, record types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTokenizeShareRecordKey(record.Id))
+	store.Delete(types.GetTokenizeShareRecordByValKey(record.ValidatorAddress, record.Id))
+}
+
+// IterateTokenizeShareRecords iterates through all the tokenize share records
+func (k Keeper) IterateTokenizeShareRecords(ctx sdk.Context, fn func(record types.TokenizeShareRecord) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.TokenizeShareRecordKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.MustUnmarshalTokenizeShareRecord(k.cdc, iterator.Value())
+		if fn(record) {
+			break
+		}
+	}
+}
+
+// GetAllTokenizeShareRecords returns all the tokenize share records
+func (k Keeper) GetAllTokenizeShareRecords(ctx sdk.Context) (records []types.TokenizeShareRecord) {
+	k.IterateTokenizeShareRecords(ctx, func(record types.TokenizeShareRecord) bool {
+		records = append(records, record)
+		return false
+	})
+	return records
+}
+
+// GetLastTokenizeShareRecordID returns the id of the last tokenize share record
+func (k Keeper) GetLastTokenizeShareRecordID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastTokenizeShareRecordIDKey)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetLastTokenizeShareRecordID sets the id of the last tokenize share record
+func (k Keeper) SetLastTokenizeShareRecordID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	store.Set(types.LastTokenizeShareRecordIDKey, bz)
+}
+
+// GetTokenizedTokens returns the amount of tokens of the delegation held by a
+// tokenize share record
+func (k Keeper) GetTokenizedTokens(ctx sdk.Context, record types.TokenizeShareRecord) sdk.Dec {
+	delegation, found := k.GetDelegation(ctx, record.ModuleAccount, record.ValidatorAddress)
+	if !found {
+		return sdk.ZeroDec()
+	}
+
+	validator, found := k.GetValidator(ctx, record.ValidatorAddress)
+	if !found {
+		return sdk.ZeroDec()
+	}
+
+	return validator.TokensFromShares(delegation.Shares)
+}
+
+// GetTotalLiquidStakedTokens returns the amount of tokens of all the tokenized
+// delegations
+func (k Keeper) GetTotalLiquidStakedTokens(ctx sdk.Context) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.TotalLiquidStakedTokensKey)
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+
+	dp := sdk.DecProto{}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &dp)
+	return dp.Dec
+}
+
+// SetTotalLiquidStakedTokens sets the amount of tokens of all the tokenized
+// delegations
+func (k Keeper) SetTotalLiquidStakedTokens(ctx sdk.Context, tokens sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	if tokens.IsZero() {
+		store.Delete(types.TotalLiquidStakedTokensKey)
+		return
+	}
+
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(&sdk.DecProto{Dec: tokens})
+	store.Set(types.TotalLiquidStakedTokensKey, bz)
+}
+
+// GetValidatorTokenizedShares returns the delegation shares of a validator
+// held by tokenize share records
+func (k Keeper) GetValidatorTokenizedShares(ctx sdk.Context, valAddr sdk.ValAddress) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetValidatorTokenizedSharesKey(valAddr))
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+
+	dp := sdk.DecProto{}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &dp)
+	return dp.Dec
+}
+
+// SetValidatorTokenizedShares sets the delegation shares of a validator held
+// by tokenize share records
+func (k Keeper) SetValidatorTokenizedShares(ctx sdk.Context, valAddr sdk.ValAddress, shares sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	if shares.IsZero() {
+		store.Delete(types.GetValidatorTokenizedSharesKey(valAddr))
+		return
+	}
+
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(&sdk.DecProto{Dec: shares})
+	store.Set(types.GetValidatorTokenizedSharesKey(valAddr), bz)
+}
+
+// GetValidatorTokenizedTokens returns the amount of tokens of the tokenized
+// delegations of a validator
+func (k Keeper) GetValidatorTokenizedTokens(ctx sdk.Context, validator types.Validator) sdk.Dec {
+	return validator.TokensFromShares(k.GetValidatorTokenizedShares(ctx, validator.OperatorAddress))
+}
+
+// AddTokenizedShares adds delegation shares of a validator moved to a tokenize
+// share record to the running totals of the tokenized delegations.
+func (k Keeper) AddTokenizedShares(ctx sdk.Context, validator types.Validator, shares sdk.Dec) {
+	valShares := k.GetValidatorTokenizedShares(ctx, validator.OperatorAddress)
+	k.SetValidatorTokenizedShares(ctx, validator.OperatorAddress, valShares.Add(shares))
+
+	total := k.GetTotalLiquidStakedTokens(ctx)
+	k.SetTotalLiquidStakedTokens(ctx, total.Add(validator.TokensFromShares(shares)))
+}
+
+// RemoveTokenizedShares removes delegation shares of a validator redeemed from
+// a tokenize share record from the running totals of the tokenized
+// delegations.
+func (k Keeper) RemoveTokenizedShares(ctx sdk.Context, validator types.Validator, shares sdk.Dec) {
+	valShares := k.GetValidatorTokenizedShares(ctx, validator.OperatorAddress)
+	k.SetValidatorTokenizedShares(ctx, validator.OperatorAddress, valShares.Sub(shares))
+
+	k.decreaseTotalLiquidStakedTokens(ctx, validator.TokensFromShares(shares))
+}
+
+// slashTokenizedTokens removes the tokens slashed from the tokenized
+// delegations of a validator from the total liquid staked tokens, given the
+// validator before and after the slash.
+func (k Keeper) slashTokenizedTokens(ctx sdk.Context, validator, slashedValidator types.Validator) {
+	shares := k.GetValidatorTokenizedShares(ctx, validator.OperatorAddress)
+	if shares.IsZero() {
+		return
+	}
+
+	slashedTokens := validator.TokensFromShares(shares).Sub(slashedValidator.TokensFromShares(shares))
+	k.decreaseTotalLiquidStakedTokens(ctx, slashedTokens)
+}
+
+// decreaseTotalLiquidStakedTokens decreases the total liquid staked tokens.
+// The total is kept from going negative, as the tokens are converted from
+// shares separately for each change and may round differently.
+func (k Keeper) decreaseTotalLiquidStakedTokens(ctx sdk.Context, tokens sdk.Dec) {
+	total := k.GetTotalLiquidStakedTokens(ctx).Sub(tokens)
+	if total.IsNegative() {
+		total = sdk.ZeroDec()
+	}
+	k.SetTotalLiquidStakedTokens(ctx, total)
+}
+
+// TokenizeShares moves the given delegation shares of a delegator to a new
+// tokenize share record and mints the matching amount of share tokens to the
+// delegator. Share tokens are minted one to one with the delegation shares, so
+// only whole shares are tokenized. Every tokenization gets its own record and
+// share token denomination, so that all the share tokens of a record are
+// entitled to the same rewards.
+func (k Keeper) TokenizeShares(
+	ctx sdk.Context, delAddr sdk.AccAddress, validator types.Validator, shares sdk.Dec,
+) (sdk.Coin, error) {
+
+	if validator.Retired {
+		return sdk.Coin{}, types.ErrValidatorRetired
+	}
+
+	if delAddr.Equals(validator.OperatorAddress) {
+		return sdk.Coin{}, types.ErrTokenizeSelfDelegation
+	}
+
+	if k.IsDelegationLocked(ctx, delAddr, validator.OperatorAddress) {
+		return sdk.Coin{}, types.ErrDelegationLocked
+	}
+
+	// shares received through a redelegation are slashable for its source
+	// validator as long as the redelegation is in progress, which the shares of
+	// the record account would not be
+	if k.HasReceivingRedelegation(ctx, delAddr, validator.OperatorAddress) {
+		return sdk.Coin{}, types.ErrRedelegationInProgress
+	}
+
+	shareTokens := shares.TruncateInt()
+	if !shareTokens.IsPositive() {
+		return sdk.Coin{}, types.ErrBadSharesAmount
+	}
+
+	shares = shareTokens.ToDec()
+	tokens := validator.TokensFromShares(shares)
+
+	// share tokens are freely transferable, so vesting accounts cannot tokenize
+	// the delegation of their vesting coins
+	if acc, ok := k.authKeeper.GetAccount(ctx, delAddr).(vestexported.VestingAccount); ok {
+		if acc.GetDelegatedFree().AmountOf(k.BondDenom(ctx)).ToDec().LT(tokens) {
+			return sdk.Coin{}, types.ErrExceedingFreeVestingDelegations
+		}
+	}
+
+	globalCap := k.GlobalLiquidStakingCap(ctx).MulInt(k.TotalBondedTokens(ctx))
+	if k.GetTotalLiquidStakedTokens(ctx).Add(tokens).GT(globalCap) {
+		return sdk.Coin{}, types.ErrGlobalLiquidStakingCapExceeded
+	}
+
+	validatorCap := k.ValidatorLiquidStakingCap(ctx).MulInt(validator.Tokens)
+	if k.GetValidatorTokenizedTokens(ctx, validator).Add(tokens).GT(validatorCap) {
+		return sdk.Coin{}, types.ErrValidatorLiquidStakingCapExceeded
+	}
+
+	id := k.GetLastTokenizeShareRecordID(ctx) + 1
+	record := types.NewTokenizeShareRecord(id, validator.OperatorAddress)
+	k.SetTokenizeShareRecord(ctx, record)
+	k.SetLastTokenizeShareRecordID(ctx, id)
+
+	if err := k.transferDelegationShares(ctx, delAddr, record.ModuleAccount, validator.OperatorAddress, shares); err != nil {
+		return sdk.Coin{}, err
+	}
+	k.AddTokenizedShares(ctx, validator, shares)
+
+	coin := sdk.NewCoin(record.GetShareTokenDenom(), shareTokens)
+	if err := k.supplyKeeper.MintCoins(ctx, types.TokenizeSharesPoolName, sdk.NewCoins(coin)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.TokenizeSharesPoolName, delAddr, sdk.NewCoins(coin))
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return coin, nil
+}
+
+// RedeemTokensForShares burns share tokens of a delegator and moves the
+// matching delegation shares from the tokenize share record back to the
+// delegator. The delegator is paid its share of the rewards accrued by the
+// record since the share tokens were issued. The record is deleted once all
+// its share tokens are redeemed.
+func (k Keeper) RedeemTokensForShares(
+	ctx sdk.Context, delAddr sdk.AccAddress, shareTokens sdk.Coin,
+) (shares sdk.Dec, rewards sdk.Coins, err error) {
+
+	record, found := k.GetTokenizeShareRecordByDenom(ctx, shareTokens.Denom)
+	if !found {
+		return shares, rewards, types.ErrNoTokenizeShareRecord
+	}
+
+	balance := k.bankKeeper.GetBalance(ctx, delAddr, shareTokens.Denom)
+	if balance.Amount.LT(shareTokens.Amount) {
+		return shares, rewards, sdkerrors.Wrap(types.ErrNotEnoughShareTokens, balance.String())
+	}
+
+	totalShareTokens := k.supplyKeeper.GetSupplyOf(ctx, shareTokens.Denom)
+
+	// moving the shares withdraws the rewards of the record delegation to the
+	// record account
+	shares = shareTokens.Amount.ToDec()
+	if err := k.transferDelegationShares(ctx, record.ModuleAccount, delAddr, record.ValidatorAddress, shares); err != nil {
+		return shares, rewards, err
+	}
+	k.RemoveTokenizedShares(ctx, k.mustGetValidator(ctx, record.ValidatorAddress), shares)
+
+	for _, coin := range k.bankKeeper.GetAllBalances(ctx, record.ModuleAccount) {
+		amount := coin.Amount.Mul(shareTokens.Amount).Quo(totalShareTokens)
+		if amount.IsPositive() {
+			rewards = rewards.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+
+	if !rewards.IsZero() {
+		err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, record.ModuleAccount, types.TokenizeSharesPoolName, rewards)
+		if err != nil {
+			return shares, rewards, err
+		}
+
+		err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.TokenizeSharesPoolName, delAddr, rewards)
+		if err != nil {
+			return shares, rewards, err
+		}
+	}
+
+	if err := k.supplyKeeper.BurnCoinsFromAccount(ctx, delAddr, sdk.NewCoins(shareTokens)); err != nil {
+		return shares, rewards, err
+	}
+
+	if shareTokens.Amount.Equal(totalShareTokens) {
+		k.DeleteTokenizeShareRecord(ctx, record)
+	}
+
+	return shares, rewards, nil
+}
+
+// transferDelegationShares moves delegation shares from one delegator to
+// another without changing the tokens bonded to the validator
+func (k Keeper) transferDelegationShares(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.Dec,
+) error {
+
+	from, found := k.GetDelegation(ctx, fromAddr, valAddr)
+	if !found {
+		return types.ErrNoDelegation
+	}
+	if from.Shares.LT(shares) {
+		return sdkerrors.Wrap(types.ErrNotEnoughDelegationShares, from.Shares.String())
+	}
+
+	k.BeforeDelegationSharesModified(ctx, fromAddr, valAddr)
+
+	from.Shares = from.Shares.Sub(shares)
+	if from.Shares.IsZero() {
+		k.RemoveDelegation(ctx, from)
+	} else {
+		k.SetDelegation(ctx, from)
+		k.AfterDelegationModified(ctx, fromAddr, valAddr)
+	}
+
+	to, found := k.GetDelegation(ctx, toAddr, valAddr)
+	if found {
+		k.BeforeDelegationSharesModified(ctx, toAddr, valAddr)
+	} else {
+		to = types.NewDelegation(toAddr, valAddr, sdk.ZeroDec())
+		k.BeforeDelegationCreated(ctx, toAddr, valAddr)
+	}
+
+	to.Shares = to.Shares.Add(shares)
+	k.SetDelegation(ctx, to)
+	k.AfterDelegationModified(ctx, toAddr, valAddr)
+
+	return nil
+}
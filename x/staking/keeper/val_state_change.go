@@ -7,6 +7,7 @@ import (
 
 	gogotypes "github.com/gogo/protobuf/types"
 	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -29,6 +30,15 @@ func (k Keeper) BlockValidatorUpdates(ctx sdk.Context) []abci.ValidatorUpdate {
 	// Unbond all mature validators from the unbonding queue.
 	k.UnbondAllMatureValidatorQueue(ctx)
 
+	// Remove the old consensus addresses of all mature key rotations.
+	k.CompleteMatureConsPubKeyRotations(ctx)
+
+	// Remove all mature delegation locks.
+	k.CompleteMatureDelegationLocks(ctx)
+
+	// Unbond the remaining delegations of the retired validators.
+	k.UnbondRetiredValidatorDelegations(ctx)
+
 	// Remove all mature unbonding delegations from the ubd queue.
 	matureUnbonds := k.DequeueAllMatureUBDQueue(ctx, ctx.BlockHeader().Time)
 	for _, dvPair := range matureUnbonds {
@@ -97,6 +107,10 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 	// (see LastValidatorPowerKey).
 	last := k.getLastValidatorsByAddr(ctx)
 
+	// Retrieve the consensus pubkeys replaced since the last updates, which
+	// Tendermint still knows the rotated validators by.
+	rotated := k.getConsPubKeyRotationUpdates(ctx)
+
 	// Iterate over validators, highest power to lowest.
 	iterator := k.ValidatorsPowerStoreIterator(ctx)
 	defer iterator.Close()
@@ -140,8 +154,18 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		newPower := validator.ConsensusPower()
 		newPowerBytes := k.cdc.MustMarshalBinaryLengthPrefixed(&gogotypes.Int64Value{Value: newPower})
 
-		// update the validator set if power has changed
-		if !found || !bytes.Equal(oldPowerBytes, newPowerBytes) {
+		// a bonded validator whose consensus pubkey was rotated is replaced
+		// by its new key in the validator set
+		oldPubKey, isRotated := rotated[valAddrBytes]
+		if found && isRotated {
+			updates = append(updates, abci.ValidatorUpdate{
+				PubKey: tmtypes.TM2PB.PubKey(oldPubKey),
+				Power:  0,
+			})
+		}
+
+		// update the validator set if power or consensus pubkey has changed
+		if !found || isRotated || !bytes.Equal(oldPowerBytes, newPowerBytes) {
 			updates = append(updates, validator.ABCIValidatorUpdate())
 			k.SetLastValidatorPower(ctx, valAddr, newPower)
 		}
@@ -159,6 +183,18 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		validator = k.bondedToUnbonding(ctx, validator)
 		amtFromBondedToNotBonded = amtFromBondedToNotBonded.Add(validator.GetTokens())
 		k.DeleteLastValidatorPower(ctx, validator.GetOperator())
+
+		// a rotated validator is removed by the key Tendermint knows it by
+		var addr [sdk.AddrLen]byte
+		copy(addr[:], valAddrBytes)
+		if oldPubKey, isRotated := rotated[addr]; isRotated {
+			updates = append(updates, abci.ValidatorUpdate{
+				PubKey: tmtypes.TM2PB.PubKey(oldPubKey),
+				Power:  0,
+			})
+			continue
+		}
+
 		updates = append(updates, validator.ABCIValidatorUpdateZero())
 	}
 
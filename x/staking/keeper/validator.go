@@ -8,6 +8,7 @@ import (
 	gogotypes "github.com/gogo/protobuf/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -166,12 +167,192 @@ func (k Keeper) UpdateValidatorCommission(ctx sdk.Context,
 		return commission, err
 	}
 
+	if minRate := k.MinCommissionRate(ctx); newRate.LT(minRate) {
+		return commission, sdkerrors.Wrapf(types.ErrCommissionLTMinRate, "minimum: %s", minRate)
+	}
+
+	if maxChangeRate := k.MaxCommissionChangeRate(ctx); newRate.Sub(commission.Rate).Abs().GT(maxChangeRate) {
+		return commission, sdkerrors.Wrapf(types.ErrCommissionGTMaxChangeRate, "maximum: %s", maxChangeRate)
+	}
+
 	commission.Rate = newRate
 	commission.UpdateTime = blockTime
 
 	return commission, nil
 }
 
+// MigrateMinCommissionRate sets the minimum commission rate and raises the
+// commission rate of all the validators below it to the minimum, along with
+// their max rate if needed. It is meant to be called from the upgrade handler
+// introducing or increasing the minimum commission rate.
+func (k Keeper) MigrateMinCommissionRate(ctx sdk.Context, minRate sdk.Dec) error {
+	switch {
+	case minRate.IsNegative():
+		return types.ErrCommissionNegative
+	case minRate.GT(sdk.OneDec()):
+		return types.ErrCommissionHuge
+	}
+
+	k.paramstore.Set(ctx, types.KeyMinCommissionRate, minRate)
+
+	for _, validator := range k.GetAllValidators(ctx) {
+		commission := validator.Commission
+		if commission.Rate.GTE(minRate) {
+			continue
+		}
+
+		if commission.MaxRate.LT(minRate) {
+			commission.MaxRate = minRate
+		}
+		commission.Rate = minRate
+		commission.UpdateTime = ctx.BlockHeader().Time
+
+		k.BeforeValidatorModified(ctx, validator.OperatorAddress)
+
+		validator.Commission = commission
+		k.SetValidator(ctx, validator)
+	}
+
+	return nil
+}
+
+// MigrateMaxCommissionChangeRate sets the maximum commission change rate and
+// lowers the max change rate of all the validators above it to the maximum. It
+// is meant to be called from the upgrade handler introducing or decreasing the
+// maximum commission change rate.
+func (k Keeper) MigrateMaxCommissionChangeRate(ctx sdk.Context, maxChangeRate sdk.Dec) error {
+	switch {
+	case maxChangeRate.IsNegative():
+		return types.ErrCommissionNegative
+	case maxChangeRate.GT(sdk.OneDec()):
+		return types.ErrCommissionHuge
+	}
+
+	k.paramstore.Set(ctx, types.KeyMaxCommissionChangeRate, maxChangeRate)
+
+	for _, validator := range k.GetAllValidators(ctx) {
+		if validator.Commission.MaxChangeRate.LTE(maxChangeRate) {
+			continue
+		}
+
+		k.BeforeValidatorModified(ctx, validator.OperatorAddress)
+
+		validator.Commission.MaxChangeRate = maxChangeRate
+		k.SetValidator(ctx, validator)
+	}
+
+	return nil
+}
+
+// RetireValidator moves a validator to the terminal retired state. The
+// validator is jailed, which removes it from the power index, and all of its
+// delegations are unbonded, regardless of the maximum number of unbonding
+// entries. At most MaxRetirementUnbondingsPerBlock delegations are unbonded
+// right away, the remaining ones are unbonded in batches at the end of the
+// following blocks. The validator is removed once it is unbonded and its
+// operator address can then be used by a new validator. Validators whose
+// delegation shares are tokenized cannot be retired, as the tokenized shares
+// could not be redeemed anymore. It returns the completion time of the
+// unbonding delegations created right away.
+func (k Keeper) RetireValidator(ctx sdk.Context, validator types.Validator) (time.Time, error) {
+	if validator.Retired {
+		return time.Time{}, types.ErrValidatorRetired
+	}
+
+	if len(k.GetTokenizeShareRecordsByValidator(ctx, validator.OperatorAddress)) > 0 {
+		return time.Time{}, types.ErrValidatorSharesTokenized
+	}
+
+	if !validator.Jailed {
+		k.jailValidator(ctx, validator)
+		validator = k.mustGetValidator(ctx, validator.OperatorAddress)
+	}
+
+	validator.Retired = true
+	k.SetValidator(ctx, validator)
+
+	k.AfterValidatorRetired(ctx, validator.GetConsAddr(), validator.OperatorAddress)
+
+	completionTime := ctx.BlockHeader().Time.Add(k.UnbondingTime(ctx))
+	unbonded, err := k.unbondRetiredDelegations(ctx, validator, types.MaxRetirementUnbondingsPerBlock)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if unbonded == types.MaxRetirementUnbondingsPerBlock {
+		k.InsertRetirementQueue(ctx, validator.OperatorAddress)
+	}
+
+	return completionTime, nil
+}
+
+// InsertRetirementQueue inserts a retired validator whose delegations are not
+// all unbonded into the retirement queue
+func (k Keeper) InsertRetirementQueue(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetRetirementQueueKey(valAddr), []byte{})
+}
+
+// DeleteRetirementQueue removes a retired validator from the retirement queue
+func (k Keeper) DeleteRetirementQueue(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetRetirementQueueKey(valAddr))
+}
+
+// GetRetirementQueue returns the retired validators whose delegations are not
+// all unbonded
+func (k Keeper) GetRetirementQueue(ctx sdk.Context) (valAddrs []sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.RetirementQueueKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		valAddrs = append(valAddrs, sdk.ValAddress(iterator.Key()[1:]))
+	}
+	return valAddrs
+}
+
+// UnbondRetiredValidatorDelegations unbonds the remaining delegations of the
+// validators in the retirement queue, at most MaxRetirementUnbondingsPerBlock
+// of them. Validators are removed from the queue once all their delegations
+// are unbonded.
+func (k Keeper) UnbondRetiredValidatorDelegations(ctx sdk.Context) {
+	limit := types.MaxRetirementUnbondingsPerBlock
+	for _, valAddr := range k.GetRetirementQueue(ctx) {
+		if limit == 0 {
+			return
+		}
+
+		validator, found := k.GetValidator(ctx, valAddr)
+		if !found {
+			k.DeleteRetirementQueue(ctx, valAddr)
+			continue
+		}
+
+		unbonded, err := k.unbondRetiredDelegations(ctx, validator, limit)
+		if err != nil {
+			panic(err)
+		}
+
+		if unbonded < limit {
+			k.DeleteRetirementQueue(ctx, valAddr)
+		}
+		limit -= unbonded
+	}
+}
+
+// unbondRetiredDelegations unbonds at most limit delegations to a retired
+// validator and returns the number of delegations unbonded
+func (k Keeper) unbondRetiredDelegations(ctx sdk.Context, validator types.Validator, limit int) (int, error) {
+	delegations := k.GetValidatorDelegationsPaginated(ctx, validator.OperatorAddress, 1, limit)
+	for _, delegation := range delegations {
+		if _, err := k.undelegate(ctx, delegation.DelegatorAddress, validator, delegation.Shares); err != nil {
+			return 0, err
+		}
+	}
+	return len(delegations), nil
+}
+
 // remove the validator record and associated indexes
 // except for the bonded validator index which is only handled in ApplyAndReturnTendermintUpdates
 func (k Keeper) RemoveValidator(ctx sdk.Context, address sdk.ValAddress) {
@@ -200,6 +381,12 @@ func (k Keeper) RemoveValidator(ctx sdk.Context, address sdk.ValAddress) {
 	store.Delete(types.GetValidatorByConsAddrKey(valConsAddr))
 	store.Delete(types.GetValidatorsByPowerIndexKey(validator))
 
+	// remove a pending consensus pubkey rotation along with its old index
+	if rotation, found := k.GetConsPubKeyRotation(ctx, address); found {
+		k.removeRotatedConsAddr(ctx, rotation)
+		k.DeleteConsPubKeyRotation(ctx, address)
+	}
+
 	// call hooks
 	k.AfterValidatorRemoved(ctx, valConsAddr, validator.OperatorAddress)
 }
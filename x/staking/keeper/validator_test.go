@@ -1054,6 +1054,10 @@ func TestUpdateValidatorCommission(t *testing.T) {
 	keeper.SetValidator(ctx, val1)
 	keeper.SetValidator(ctx, val2)
 
+	params := keeper.GetParams(ctx)
+	params.MinCommissionRate = sdk.NewDecWithPrec(15, 2)
+	keeper.SetParams(ctx, params)
+
 	testCases := []struct {
 		validator   types.Validator
 		newRate     sdk.Dec
@@ -1063,6 +1067,7 @@ func TestUpdateValidatorCommission(t *testing.T) {
 		{val2, sdk.NewDecWithPrec(-1, 1), true},
 		{val2, sdk.NewDecWithPrec(4, 1), true},
 		{val2, sdk.NewDecWithPrec(3, 1), true},
+		{val2, sdk.NewDecWithPrec(12, 2), true},
 		{val2, sdk.NewDecWithPrec(2, 1), false},
 	}
 
@@ -1091,3 +1096,117 @@ func TestUpdateValidatorCommission(t *testing.T) {
 		}
 	}
 }
+
+func TestMigrateMinCommissionRate(t *testing.T) {
+	ctx, _, _, keeper, _ := CreateTestInput(t, false, 1000)
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Now().UTC()})
+
+	commissions := []types.Commission{
+		types.NewCommission(sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(3, 2), sdk.NewDecWithPrec(1, 2)),
+		types.NewCommission(sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(3, 1), sdk.NewDecWithPrec(1, 2)),
+		types.NewCommission(sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(3, 1), sdk.NewDecWithPrec(1, 2)),
+	}
+	for i, commission := range commissions {
+		validator, err := types.NewValidator(addrVals[i], PKs[i], types.Description{}).SetInitialCommission(commission)
+		require.NoError(t, err)
+		keeper.SetValidator(ctx, validator)
+	}
+
+	require.Error(t, keeper.MigrateMinCommissionRate(ctx, sdk.NewDecWithPrec(-1, 1)))
+	require.Error(t, keeper.MigrateMinCommissionRate(ctx, sdk.NewDecWithPrec(11, 1)))
+
+	minRate := sdk.NewDecWithPrec(5, 2)
+	require.NoError(t, keeper.MigrateMinCommissionRate(ctx, minRate))
+	require.Equal(t, minRate, keeper.MinCommissionRate(ctx))
+
+	// the rate and, if needed, the max rate are raised to the minimum
+	validator, found := keeper.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+	require.Equal(t, minRate, validator.Commission.Rate)
+	require.Equal(t, minRate, validator.Commission.MaxRate)
+	require.Equal(t, ctx.BlockHeader().Time, validator.Commission.UpdateTime)
+
+	validator, found = keeper.GetValidator(ctx, addrVals[1])
+	require.True(t, found)
+	require.Equal(t, minRate, validator.Commission.Rate)
+	require.Equal(t, sdk.NewDecWithPrec(3, 1), validator.Commission.MaxRate)
+
+	// validators above the minimum are unchanged
+	validator, found = keeper.GetValidator(ctx, addrVals[2])
+	require.True(t, found)
+	require.Equal(t, commissions[2], validator.Commission)
+}
+
+func TestMigrateMaxCommissionChangeRate(t *testing.T) {
+	ctx, _, _, keeper, _ := CreateTestInput(t, false, 1000)
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Now().UTC()})
+
+	commissions := []types.Commission{
+		types.NewCommission(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(3, 1), sdk.NewDecWithPrec(1, 1)),
+		types.NewCommission(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(3, 1), sdk.NewDecWithPrec(1, 2)),
+	}
+	for i, commission := range commissions {
+		validator, err := types.NewValidator(addrVals[i], PKs[i], types.Description{}).SetInitialCommission(commission)
+		require.NoError(t, err)
+		keeper.SetValidator(ctx, validator)
+	}
+
+	require.Error(t, keeper.MigrateMaxCommissionChangeRate(ctx, sdk.NewDecWithPrec(-1, 1)))
+	require.Error(t, keeper.MigrateMaxCommissionChangeRate(ctx, sdk.NewDecWithPrec(11, 1)))
+
+	maxChangeRate := sdk.NewDecWithPrec(5, 2)
+	require.NoError(t, keeper.MigrateMaxCommissionChangeRate(ctx, maxChangeRate))
+	require.Equal(t, maxChangeRate, keeper.MaxCommissionChangeRate(ctx))
+
+	// the max change rate is lowered to the maximum
+	validator, found := keeper.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+	require.Equal(t, maxChangeRate, validator.Commission.MaxChangeRate)
+	require.Equal(t, commissions[0].Rate, validator.Commission.Rate)
+
+	// validators below the maximum are unchanged
+	validator, found = keeper.GetValidator(ctx, addrVals[1])
+	require.True(t, found)
+	require.Equal(t, commissions[1], validator.Commission)
+}
+
+func TestRetireValidatorInBatches(t *testing.T) {
+	ctx, _, bk, keeper, _ := CreateTestInput(t, false, 10)
+
+	// more delegations than can be unbonded in a block
+	numDels := types.MaxRetirementUnbondingsPerBlock + 50
+	delTokens := sdk.TokensFromConsensusPower(1)
+	valTokens := delTokens.MulRaw(int64(numDels))
+
+	bondedPool := keeper.GetBondedPool(ctx)
+	bondedCoins := sdk.NewCoins(sdk.NewCoin(keeper.BondDenom(ctx), valTokens.Add(delTokens)))
+	require.NoError(t, bk.SetBalances(ctx, bondedPool.GetAddress(), bondedCoins))
+	keeper.supplyKeeper.SetModuleAccount(ctx, bondedPool)
+
+	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
+	validator, _ = validator.AddTokensFromDel(delTokens)
+	validator = TestingUpdateValidator(keeper, ctx, validator, true)
+	for i := 0; i < numDels; i++ {
+		delAddr := sdk.AccAddress(fmt.Sprintf("delegator%011d", i))
+		_, err := keeper.Delegate(ctx, delAddr, delTokens, sdk.Bonded, validator, false)
+		require.NoError(t, err)
+		validator = keeper.mustGetValidator(ctx, addrVals[0])
+	}
+
+	// the first batch of delegations is unbonded right away
+	_, err := keeper.RetireValidator(ctx, validator)
+	require.NoError(t, err)
+	require.Len(t, keeper.GetValidatorDelegations(ctx, addrVals[0]), numDels-types.MaxRetirementUnbondingsPerBlock)
+	require.Len(t, keeper.GetAllUnbondingDelegations(ctx, sdk.AccAddress(fmt.Sprintf("delegator%011d", 0))), 1)
+	require.Equal(t, []sdk.ValAddress{addrVals[0]}, keeper.GetRetirementQueue(ctx))
+
+	// the remaining ones are unbonded at the end of the block
+	keeper.BlockValidatorUpdates(ctx)
+	require.Empty(t, keeper.GetValidatorDelegations(ctx, addrVals[0]))
+	require.Len(t, keeper.GetAllUnbondingDelegations(ctx, sdk.AccAddress(fmt.Sprintf("delegator%011d", numDels-1))), 1)
+	require.Empty(t, keeper.GetRetirementQueue(ctx))
+
+	validator = keeper.mustGetValidator(ctx, addrVals[0])
+	require.Equal(t, delTokens, validator.Tokens)
+	require.Equal(t, delTokens.ToDec(), validator.DelegatorShares)
+}
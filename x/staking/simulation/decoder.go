@@ -51,6 +51,36 @@ func DecodeStore(cdc *codec.Codec, kvA, kvB tmkv.Pair) string {
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &redB)
 		return fmt.Sprintf("%v\n%v", redA, redB)
 
+	case bytes.Equal(kvA.Key[:1], types.ConsPubKeyRotationKey):
+		var rotationA, rotationB types.ConsPubKeyRotation
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &rotationA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &rotationB)
+		return fmt.Sprintf("%v\n%v", rotationA, rotationB)
+
+	case bytes.Equal(kvA.Key[:1], types.TokenizeShareRecordKey):
+		var recordA, recordB types.TokenizeShareRecord
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &recordA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &recordB)
+		return fmt.Sprintf("%v\n%v", recordA, recordB)
+
+	case bytes.Equal(kvA.Key[:1], types.DelegationChangeKey):
+		var changeA, changeB types.DelegationChange
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &changeA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &changeB)
+		return fmt.Sprintf("%v\n%v", changeA, changeB)
+
+	case bytes.Equal(kvA.Key[:1], types.DelegationLockKey):
+		var lockA, lockB types.DelegationLock
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &lockA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &lockB)
+		return fmt.Sprintf("%v\n%v", lockA, lockB)
+
+	case bytes.Equal(kvA.Key[:1], types.DelegationLockQueueKey):
+		var pairsA, pairsB types.DVPairs
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &pairsA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &pairsB)
+		return fmt.Sprintf("%v\n%v", pairsA, pairsB)
+
 	default:
 		panic(fmt.Sprintf("invalid staking key prefix %X", kvA.Key[:1]))
 	}
@@ -17,8 +17,14 @@ import (
 
 // Simulation parameter constants
 const (
-	UnbondingTime = "unbonding_time"
-	MaxValidators = "max_validators"
+	UnbondingTime              = "unbonding_time"
+	MaxValidators              = "max_validators"
+	GlobalLiquidStakingCap     = "global_liquid_staking_cap"
+	ValidatorLiquidStakingCap  = "validator_liquid_staking_cap"
+	MinCommissionRate          = "min_commission_rate"
+	DelegationHistoryRetention = "delegation_history_retention"
+	LockTiers                  = "lock_tiers"
+	MaxCommissionChangeRate    = "max_commission_change_rate"
 )
 
 // GenUnbondingTime randomized UnbondingTime
@@ -31,6 +37,44 @@ func GenMaxValidators(r *rand.Rand) (maxValidators uint32) {
 	return uint32(r.Intn(250) + 1)
 }
 
+// GenLiquidStakingCap randomized GlobalLiquidStakingCap and ValidatorLiquidStakingCap
+func GenLiquidStakingCap(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 1, 101)), 2)
+}
+
+// GenMinCommissionRate randomized MinCommissionRate
+func GenMinCommissionRate(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 0, 11)), 2)
+}
+
+// GenDelegationHistoryRetention randomized DelegationHistoryRetention
+func GenDelegationHistoryRetention(r *rand.Rand) uint32 {
+	return uint32(r.Intn(20))
+}
+
+// GenLockTiers randomized LockTiers
+func GenLockTiers(r *rand.Rand) []types.LockTier {
+	n := r.Intn(4)
+	if n == 0 {
+		return nil
+	}
+
+	baseDuration := time.Duration(simulation.RandIntBetween(r, 60, 60*60*24)) * time.Second
+	tiers := make([]types.LockTier, n)
+	for i := range tiers {
+		tiers[i] = types.NewLockTier(
+			time.Duration(i+1)*baseDuration, sdk.NewDecWithPrec(int64(100+(i+1)*simulation.RandIntBetween(r, 0, 26)), 2),
+		)
+	}
+
+	return tiers
+}
+
+// GenMaxCommissionChangeRate randomized MaxCommissionChangeRate
+func GenMaxCommissionChangeRate(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 1, 101)), 2)
+}
+
 // RandomizedGenState generates a random GenesisState for staking
 func RandomizedGenState(simState *module.SimulationState) {
 	// params
@@ -46,11 +90,51 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { maxValidators = GenMaxValidators(r) },
 	)
 
+	var globalLiquidStakingCap sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, GlobalLiquidStakingCap, &globalLiquidStakingCap, simState.Rand,
+		func(r *rand.Rand) { globalLiquidStakingCap = GenLiquidStakingCap(r) },
+	)
+
+	var validatorLiquidStakingCap sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, ValidatorLiquidStakingCap, &validatorLiquidStakingCap, simState.Rand,
+		func(r *rand.Rand) { validatorLiquidStakingCap = GenLiquidStakingCap(r) },
+	)
+
+	var minCommissionRate sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MinCommissionRate, &minCommissionRate, simState.Rand,
+		func(r *rand.Rand) { minCommissionRate = GenMinCommissionRate(r) },
+	)
+
+	var delegationHistoryRetention uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DelegationHistoryRetention, &delegationHistoryRetention, simState.Rand,
+		func(r *rand.Rand) { delegationHistoryRetention = GenDelegationHistoryRetention(r) },
+	)
+
+	var lockTiers []types.LockTier
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, LockTiers, &lockTiers, simState.Rand,
+		func(r *rand.Rand) { lockTiers = GenLockTiers(r) },
+	)
+
+	var maxCommissionChangeRate sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxCommissionChangeRate, &maxCommissionChangeRate, simState.Rand,
+		func(r *rand.Rand) { maxCommissionChangeRate = GenMaxCommissionChangeRate(r) },
+	)
+
 	// NOTE: the slashing module need to be defined after the staking module on the
 	// NewSimulationManager constructor for this to work
 	simState.UnbondTime = unbondTime
 
-	params := types.NewParams(simState.UnbondTime, maxValidators, 7, 3, sdk.DefaultBondDenom)
+	params := types.NewParams(
+		simState.UnbondTime, maxValidators, 7, 3, sdk.DefaultBondDenom, types.DefaultKeyRotationFee,
+		globalLiquidStakingCap, validatorLiquidStakingCap, minCommissionRate, delegationHistoryRetention, lockTiers,
+		maxCommissionChangeRate,
+	)
 
 	// validators & delegations
 	var (
@@ -63,11 +147,13 @@ func RandomizedGenState(simState *module.SimulationState) {
 		valAddr := sdk.ValAddress(simState.Accounts[i].Address)
 		valAddrs[i] = valAddr
 
-		maxCommission := sdk.NewDecWithPrec(int64(simulation.RandIntBetween(simState.Rand, 1, 100)), 2)
+		maxCommission := sdk.MaxDec(
+			sdk.NewDecWithPrec(int64(simulation.RandIntBetween(simState.Rand, 1, 100)), 2), minCommissionRate,
+		)
 		commission := types.NewCommission(
-			simulation.RandomDecAmount(simState.Rand, maxCommission),
+			sdk.MaxDec(simulation.RandomDecAmount(simState.Rand, maxCommission), minCommissionRate),
 			maxCommission,
-			simulation.RandomDecAmount(simState.Rand, maxCommission),
+			sdk.MinDec(simulation.RandomDecAmount(simState.Rand, maxCommission), maxCommissionChangeRate),
 		)
 
 		validator := types.NewValidator(valAddr, simState.Accounts[i].PubKey, types.Description{})
@@ -21,6 +21,9 @@ const (
 	OpWeightMsgDelegate        = "op_weight_msg_delegate"
 	OpWeightMsgUndelegate      = "op_weight_msg_undelegate"
 	OpWeightMsgBeginRedelegate = "op_weight_msg_begin_redelegate"
+
+	OpWeightMsgCancelUnbondingDelegation = "op_weight_msg_cancel_unbonding_delegation"
+	OpWeightMsgLockDelegation            = "op_weight_msg_lock_delegation"
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights
@@ -35,6 +38,9 @@ func WeightedOperations(
 		weightMsgDelegate        int
 		weightMsgUndelegate      int
 		weightMsgBeginRedelegate int
+
+		weightMsgCancelUnbondingDelegation int
+		weightMsgLockDelegation            int
 	)
 
 	appParams.GetOrGenerate(cdc, OpWeightMsgCreateValidator, &weightMsgCreateValidator, nil,
@@ -67,6 +73,18 @@ func WeightedOperations(
 		},
 	)
 
+	appParams.GetOrGenerate(cdc, OpWeightMsgCancelUnbondingDelegation, &weightMsgCancelUnbondingDelegation, nil,
+		func(_ *rand.Rand) {
+			weightMsgCancelUnbondingDelegation = simappparams.DefaultWeightMsgCancelUnbondingDelegation
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgLockDelegation, &weightMsgLockDelegation, nil,
+		func(_ *rand.Rand) {
+			weightMsgLockDelegation = simappparams.DefaultWeightMsgLockDelegation
+		},
+	)
+
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgCreateValidator,
@@ -88,6 +106,14 @@ func WeightedOperations(
 			weightMsgBeginRedelegate,
 			SimulateMsgBeginRedelegate(ak, bk, k),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgCancelUnbondingDelegation,
+			SimulateMsgCancelUnbondingDelegation(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgLockDelegation,
+			SimulateMsgLockDelegation(ak, bk, k),
+		),
 	}
 }
 
@@ -141,11 +167,14 @@ func SimulateMsgCreateValidator(ak types.AccountKeeper, bk types.BankKeeper, k k
 			simulation.RandStringOfLength(r, 10),
 		)
 
-		maxCommission := sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 0, 100)), 2)
+		minCommissionRate := k.MinCommissionRate(ctx)
+		maxCommission := sdk.MaxDec(
+			sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 0, 100)), 2), minCommissionRate,
+		)
 		commission := types.NewCommissionRates(
-			simulation.RandomDecAmount(r, maxCommission),
+			sdk.MaxDec(simulation.RandomDecAmount(r, maxCommission), minCommissionRate),
 			maxCommission,
-			simulation.RandomDecAmount(r, maxCommission),
+			sdk.MinDec(simulation.RandomDecAmount(r, maxCommission), k.MaxCommissionChangeRate(ctx)),
 		)
 
 		msg := types.NewMsgCreateValidator(address, simAccount.PubKey,
@@ -195,6 +224,16 @@ func SimulateMsgEditValidator(ak types.AccountKeeper, bk types.BankKeeper, k kee
 			return simulation.NoOpMsg(types.ModuleName), nil, nil
 		}
 
+		if newCommissionRate.LT(k.MinCommissionRate(ctx)) {
+			// skip as the commission is below the minimum
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		if newCommissionRate.Sub(val.Commission.Rate).Abs().GT(k.MaxCommissionChangeRate(ctx)) {
+			// skip as the commission changes more than the maximum
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
 		simAccount, found := simulation.FindAccount(accs, sdk.AccAddress(val.GetOperator()))
 		if !found {
 			return simulation.NoOpMsg(types.ModuleName), nil, fmt.Errorf("validator %s not found", val.GetOperator())
@@ -324,7 +363,8 @@ func SimulateMsgUndelegate(ak types.AccountKeeper, bk types.BankKeeper, k keeper
 		delegation := delegations[r.Intn(len(delegations))]
 		delAddr := delegation.GetDelegatorAddr()
 
-		if k.HasMaxUnbondingDelegationEntries(ctx, delAddr, valAddr) {
+		if k.HasMaxUnbondingDelegationEntries(ctx, delAddr, valAddr) ||
+			k.IsDelegationLocked(ctx, delAddr, valAddr) {
 			return simulation.NoOpMsg(types.ModuleName), nil, nil
 		}
 
@@ -406,7 +446,7 @@ func SimulateMsgBeginRedelegate(ak types.AccountKeeper, bk types.BankKeeper, k k
 		delegation := delegations[r.Intn(len(delegations))]
 		delAddr := delegation.GetDelegatorAddr()
 
-		if k.HasReceivingRedelegation(ctx, delAddr, srcAddr) {
+		if k.IsDelegationLocked(ctx, delAddr, srcAddr) {
 			return simulation.NoOpMsg(types.ModuleName), nil, nil // skip
 		}
 
@@ -493,3 +533,171 @@ func SimulateMsgBeginRedelegate(ak types.AccountKeeper, bk types.BankKeeper, k k
 		return simulation.NewOperationMsg(msg, true, ""), nil, nil
 	}
 }
+
+// SimulateMsgCancelUnbondingDelegation generates a MsgCancelUnbondingDelegation
+// with random values
+// nolint: interfacer
+func SimulateMsgCancelUnbondingDelegation(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		// get random validator
+		validator, ok := keeper.RandomValidator(r, k, ctx)
+		if !ok {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		if validator.InvalidExRate() {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		ubds := k.GetUnbondingDelegationsFromValidator(ctx, validator.OperatorAddress)
+		if len(ubds) == 0 {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		// get random unbonding delegation entry that has not matured yet
+		ubd := ubds[r.Intn(len(ubds))]
+		entry := ubd.Entries[r.Intn(len(ubd.Entries))]
+		if entry.IsMature(ctx.BlockHeader().Time) {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		// entries are looked up by creation height, so use the first immature
+		// entry created at the same height
+		for _, e := range ubd.Entries {
+			if e.CreationHeight == entry.CreationHeight && !e.IsMature(ctx.BlockHeader().Time) {
+				entry = e
+				break
+			}
+		}
+		if !entry.Balance.IsPositive() {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		cancelAmt, err := simulation.RandPositiveInt(r, entry.Balance)
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		msg := types.NewMsgCancelUnbondingDelegation(
+			ubd.DelegatorAddress, ubd.ValidatorAddress, entry.CreationHeight, sdk.NewCoin(k.BondDenom(ctx), cancelAmt),
+		)
+
+		// need to retrieve the simulation account associated with the unbonding delegation to retrieve PrivKey
+		var simAccount simulation.Account
+		for _, simAcc := range accs {
+			if simAcc.Address.Equals(ubd.DelegatorAddress) {
+				simAccount = simAcc
+				break
+			}
+		}
+		// if simaccount.PrivKey == nil, delegation address does not exist in accs. Return error
+		if simAccount.PrivKey == nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, fmt.Errorf("delegation addr: %s does not exist in simulation accounts", ubd.DelegatorAddress)
+		}
+
+		account := ak.GetAccount(ctx, ubd.DelegatorAddress)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		fees, err := simulation.RandomFees(r, ctx, spendable)
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgLockDelegation generates a MsgLockDelegation with random values
+// nolint: interfacer
+func SimulateMsgLockDelegation(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		lockTiers := k.LockTiers(ctx)
+		if len(lockTiers) == 0 {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		// get random validator
+		validator, ok := keeper.RandomValidator(r, k, ctx)
+		if !ok {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+		valAddr := validator.GetOperator()
+
+		delegations := k.GetValidatorDelegations(ctx, valAddr)
+		if len(delegations) == 0 {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		// get random delegator from validator
+		delegation := delegations[r.Intn(len(delegations))]
+		delAddr := delegation.GetDelegatorAddr()
+
+		// skip tiers which would shorten an existing lock
+		tier := lockTiers[r.Intn(len(lockTiers))]
+		lock, found := k.GetDelegationLock(ctx, delAddr, valAddr)
+		if found && lock.EndTime.After(ctx.BlockHeader().Time.Add(tier.Duration)) {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		msg := types.NewMsgLockDelegation(delAddr, valAddr, tier.Duration)
+
+		// need to retrieve the simulation account associated with delegation to retrieve PrivKey
+		var simAccount simulation.Account
+		for _, simAcc := range accs {
+			if simAcc.Address.Equals(delAddr) {
+				simAccount = simAcc
+				break
+			}
+		}
+		// if simaccount.PrivKey == nil, delegation address does not exist in accs. Return error
+		if simAccount.PrivKey == nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, fmt.Errorf("delegation addr: %s does not exist in simulation accounts", delAddr)
+		}
+
+		account := ak.GetAccount(ctx, delAddr)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		fees, err := simulation.RandomFees(r, ctx, spendable)
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
@@ -26,6 +26,12 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgDelegate{}, "cosmos-sdk/MsgDelegate", nil)
 	cdc.RegisterConcrete(MsgUndelegate{}, "cosmos-sdk/MsgUndelegate", nil)
 	cdc.RegisterConcrete(MsgBeginRedelegate{}, "cosmos-sdk/MsgBeginRedelegate", nil)
+	cdc.RegisterConcrete(MsgCancelUnbondingDelegation{}, "cosmos-sdk/MsgCancelUnbondingDelegation", nil)
+	cdc.RegisterConcrete(MsgRotateConsPubKey{}, "cosmos-sdk/MsgRotateConsPubKey", nil)
+	cdc.RegisterConcrete(MsgRetireValidator{}, "cosmos-sdk/MsgRetireValidator", nil)
+	cdc.RegisterConcrete(MsgTokenizeShares{}, "cosmos-sdk/MsgTokenizeShares", nil)
+	cdc.RegisterConcrete(MsgRedeemTokensForShares{}, "cosmos-sdk/MsgRedeemTokensForShares", nil)
+	cdc.RegisterConcrete(MsgLockDelegation{}, "cosmos-sdk/MsgLockDelegation", nil)
 }
 
 // ModuleCdc defines a staking module global Amino codec.
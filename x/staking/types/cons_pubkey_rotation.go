@@ -0,0 +1,68 @@
+package types
+
+import (
+	"time"
+
+	"github.com/tendermint/tendermint/crypto"
+	yaml "gopkg.in/yaml.v2"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewConsPubKeyRotation creates a new ConsPubKeyRotation instance.
+func NewConsPubKeyRotation(
+	operator sdk.ValAddress, oldPubKey, newPubKey crypto.PubKey, height int64, completionTime time.Time,
+) ConsPubKeyRotation {
+
+	return ConsPubKeyRotation{
+		OperatorAddress:    operator,
+		OldConsensusPubkey: sdk.MustBech32ifyPubKey(sdk.Bech32PubKeyTypeConsPub, oldPubKey),
+		NewConsensusPubkey: sdk.MustBech32ifyPubKey(sdk.Bech32PubKeyTypeConsPub, newPubKey),
+		Height:             height,
+		CompletionTime:     completionTime,
+	}
+}
+
+// GetOldConsPubKey returns the consensus public key replaced by the rotation.
+func (r ConsPubKeyRotation) GetOldConsPubKey() crypto.PubKey {
+	return sdk.MustGetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, r.OldConsensusPubkey)
+}
+
+// GetNewConsPubKey returns the consensus public key set by the rotation.
+func (r ConsPubKeyRotation) GetNewConsPubKey() crypto.PubKey {
+	return sdk.MustGetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, r.NewConsensusPubkey)
+}
+
+// IsMature returns true if the old consensus key no longer needs to resolve to
+// the validator.
+func (r ConsPubKeyRotation) IsMature(currentTime time.Time) bool {
+	return !r.CompletionTime.After(currentTime)
+}
+
+// String implements the Stringer interface for a ConsPubKeyRotation object.
+func (r ConsPubKeyRotation) String() string {
+	out, _ := yaml.Marshal(r)
+	return string(out)
+}
+
+// MustMarshalConsPubKeyRotation returns the rotation bytes. Panics if fails.
+func MustMarshalConsPubKeyRotation(cdc codec.Marshaler, rotation ConsPubKeyRotation) []byte {
+	return cdc.MustMarshalBinaryLengthPrefixed(&rotation)
+}
+
+// MustUnmarshalConsPubKeyRotation returns the unmarshaled rotation from bytes.
+// Panics if fails.
+func MustUnmarshalConsPubKeyRotation(cdc codec.Marshaler, value []byte) ConsPubKeyRotation {
+	rotation, err := UnmarshalConsPubKeyRotation(cdc, value)
+	if err != nil {
+		panic(err)
+	}
+	return rotation
+}
+
+// UnmarshalConsPubKeyRotation returns the rotation from bytes.
+func UnmarshalConsPubKeyRotation(cdc codec.Marshaler, value []byte) (rotation ConsPubKeyRotation, err error) {
+	err = cdc.UnmarshalBinaryLengthPrefixed(value, &rotation)
+	return rotation, err
+}
@@ -0,0 +1,105 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	yaml "gopkg.in/yaml.v2"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DelegationChangeType is the kind of change of a delegation within a block
+type DelegationChangeType int32
+
+// the kinds of changes of a delegation
+const (
+	DelegationChangeCreated  DelegationChangeType = 0x1
+	DelegationChangeModified DelegationChangeType = 0x2
+	DelegationChangeRemoved  DelegationChangeType = 0x3
+)
+
+var delegationChangeTypeNames = map[DelegationChangeType]string{
+	DelegationChangeCreated:  "created",
+	DelegationChangeModified: "modified",
+	DelegationChangeRemoved:  "removed",
+}
+
+// String implements the Stringer interface for a DelegationChangeType.
+func (t DelegationChangeType) String() string {
+	name, ok := delegationChangeTypeNames[t]
+	if !ok {
+		return fmt.Sprintf("unknown(%d)", int32(t))
+	}
+	return name
+}
+
+// MarshalJSON marshals the change type to its name.
+func (t DelegationChangeType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+// UnmarshalJSON unmarshals the change type from its name.
+func (t *DelegationChangeType) UnmarshalJSON(bz []byte) error {
+	var name string
+	if err := json.Unmarshal(bz, &name); err != nil {
+		return err
+	}
+
+	for changeType, changeName := range delegationChangeTypeNames {
+		if changeName == name {
+			*t = changeType
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid delegation change type: %s", name)
+}
+
+// MarshalYAML marshals the change type to its name.
+func (t DelegationChangeType) MarshalYAML() (interface{}, error) {
+	return t.String(), nil
+}
+
+// NewDelegationChange creates a new DelegationChange instance.
+func NewDelegationChange(
+	delAddr sdk.AccAddress, valAddr sdk.ValAddress, height int64, changeType DelegationChangeType,
+	sharesBefore, sharesAfter sdk.Dec,
+) DelegationChange {
+
+	return DelegationChange{
+		DelegatorAddress: delAddr,
+		ValidatorAddress: valAddr,
+		Height:           height,
+		Type:             changeType,
+		SharesBefore:     sharesBefore,
+		SharesAfter:      sharesAfter,
+	}
+}
+
+// String implements the Stringer interface for a DelegationChange object.
+func (c DelegationChange) String() string {
+	out, _ := yaml.Marshal(c)
+	return string(out)
+}
+
+// MustMarshalDelegationChange returns the change bytes. Panics if fails.
+func MustMarshalDelegationChange(cdc codec.Marshaler, change DelegationChange) []byte {
+	return cdc.MustMarshalBinaryLengthPrefixed(&change)
+}
+
+// MustUnmarshalDelegationChange returns the unmarshaled change from bytes.
+// Panics if fails.
+func MustUnmarshalDelegationChange(cdc codec.Marshaler, value []byte) DelegationChange {
+	change, err := UnmarshalDelegationChange(cdc, value)
+	if err != nil {
+		panic(err)
+	}
+	return change
+}
+
+// UnmarshalDelegationChange returns the change from bytes.
+func UnmarshalDelegationChange(cdc codec.Marshaler, value []byte) (change DelegationChange, err error) {
+	err = cdc.UnmarshalBinaryLengthPrefixed(value, &change)
+	return change, err
+}
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"time"
+
+	yaml "gopkg.in/yaml.v2"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewLockTier creates a new LockTier instance.
+func NewLockTier(duration time.Duration, rewardMultiplier sdk.Dec) LockTier {
+	return LockTier{
+		Duration:         duration,
+		RewardMultiplier: rewardMultiplier,
+	}
+}
+
+// String implements the Stringer interface for a LockTier object.
+func (t LockTier) String() string {
+	out, _ := yaml.Marshal(t)
+	return string(out)
+}
+
+// NewDelegationLock creates a new DelegationLock instance.
+func NewDelegationLock(
+	delAddr sdk.AccAddress, valAddr sdk.ValAddress, endTime time.Time, rewardMultiplier sdk.Dec,
+) DelegationLock {
+
+	return DelegationLock{
+		DelegatorAddress: delAddr,
+		ValidatorAddress: valAddr,
+		EndTime:          endTime,
+		RewardMultiplier: rewardMultiplier,
+	}
+}
+
+// IsMature returns true if the lock has ended at the given time.
+func (l DelegationLock) IsMature(currentTime time.Time) bool {
+	return !l.EndTime.After(currentTime)
+}
+
+// String implements the Stringer interface for a DelegationLock object.
+func (l DelegationLock) String() string {
+	out, _ := yaml.Marshal(l)
+	return string(out)
+}
+
+// MustMarshalDelegationLock returns the lock bytes. Panics if fails.
+func MustMarshalDelegationLock(cdc codec.Marshaler, lock DelegationLock) []byte {
+	return cdc.MustMarshalBinaryLengthPrefixed(&lock)
+}
+
+// MustUnmarshalDelegationLock returns the unmarshaled lock from bytes.
+// Panics if fails.
+func MustUnmarshalDelegationLock(cdc codec.Marshaler, value []byte) DelegationLock {
+	lock, err := UnmarshalDelegationLock(cdc, value)
+	if err != nil {
+		panic(err)
+	}
+	return lock
+}
+
+// UnmarshalDelegationLock returns the lock from bytes.
+func UnmarshalDelegationLock(cdc codec.Marshaler, value []byte) (lock DelegationLock, err error) {
+	err = cdc.UnmarshalBinaryLengthPrefixed(value, &lock)
+	return lock, err
+}
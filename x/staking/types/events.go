@@ -10,6 +10,14 @@ const (
 	EventTypeUnbond               = "unbond"
 	EventTypeRedelegate           = "redelegate"
 
+	EventTypeCancelUnbondingDelegation = "cancel_unbonding_delegation"
+	EventTypeRotateConsPubKey          = "rotate_cons_pubkey"
+	EventTypeRetireValidator           = "retire_validator"
+	EventTypeTokenizeShares            = "tokenize_shares"
+	EventTypeRedeemShares              = "redeem_tokens_for_shares"
+	EventTypeLockDelegation            = "lock_delegation"
+	EventTypeCompleteDelegationLock    = "complete_delegation_lock"
+
 	AttributeKeyValidator         = "validator"
 	AttributeKeyCommissionRate    = "commission_rate"
 	AttributeKeyMinSelfDelegation = "min_self_delegation"
@@ -17,5 +25,13 @@ const (
 	AttributeKeyDstValidator      = "destination_validator"
 	AttributeKeyDelegator         = "delegator"
 	AttributeKeyCompletionTime    = "completion_time"
+	AttributeKeyCreationHeight    = "creation_height"
+	AttributeKeyOldConsPubKey     = "old_consensus_pubkey"
+	AttributeKeyNewConsPubKey     = "new_consensus_pubkey"
+	AttributeKeyShareTokens       = "share_tokens"
+	AttributeKeyShares            = "shares"
+	AttributeKeyRewards           = "rewards"
+	AttributeKeyLockEndTime       = "lock_end_time"
+	AttributeKeyRewardMultiplier  = "reward_multiplier"
 	AttributeValueCategory        = ModuleName
 )
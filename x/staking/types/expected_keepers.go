@@ -1,6 +1,8 @@
 package types
 
 import (
+	"github.com/tendermint/tendermint/crypto"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
 	stakingexported "github.com/cosmos/cosmos-sdk/x/staking/exported"
@@ -16,7 +18,7 @@ type DistributionKeeper interface {
 // AccountKeeper defines the expected account keeper (noalias)
 type AccountKeeper interface {
 	IterateAccounts(ctx sdk.Context, process func(authexported.Account) (stop bool))
-	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authexported.Account // only used for simulation
+	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authexported.Account
 }
 
 // BankKeeper defines the expected interface needed to retrieve account balances.
@@ -31,6 +33,7 @@ type BankKeeper interface {
 // SupplyKeeper defines the expected supply Keeper (noalias)
 type SupplyKeeper interface {
 	GetSupply(ctx sdk.Context) supplyexported.SupplyI
+	GetSupplyOf(ctx sdk.Context, denom string) sdk.Int
 
 	GetModuleAddress(name string) sdk.AccAddress
 	GetModuleAccount(ctx sdk.Context, moduleName string) supplyexported.ModuleAccountI
@@ -41,8 +44,12 @@ type SupplyKeeper interface {
 	SendCoinsFromModuleToModule(ctx sdk.Context, senderPool, recipientPool string, amt sdk.Coins) error
 	UndelegateCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	DelegateCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 
+	MintCoins(ctx sdk.Context, name string, amt sdk.Coins) error
 	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) error
+	BurnCoinsFromAccount(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) error
 }
 
 // ValidatorSet expected properties for the set of all validators (noalias)
@@ -108,4 +115,6 @@ type StakingHooks interface {
 	BeforeDelegationRemoved(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress)        // Must be called when a delegation is removed
 	AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress)
 	BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec)
+	AfterConsensusPubKeyRotated(ctx sdk.Context, oldPubKey, newPubKey crypto.PubKey, valAddr sdk.ValAddress) // Must be called when a validator's consensus pubkey is rotated
+	AfterValidatorRetired(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress)                 // Must be called when a validator is retired
 }
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"github.com/tendermint/tendermint/crypto"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -62,3 +64,13 @@ func (h MultiStakingHooks) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.V
 		h[i].BeforeValidatorSlashed(ctx, valAddr, fraction)
 	}
 }
+func (h MultiStakingHooks) AfterConsensusPubKeyRotated(ctx sdk.Context, oldPubKey, newPubKey crypto.PubKey, valAddr sdk.ValAddress) {
+	for i := range h {
+		h[i].AfterConsensusPubKeyRotated(ctx, oldPubKey, newPubKey, valAddr)
+	}
+}
+func (h MultiStakingHooks) AfterValidatorRetired(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
+	for i := range h {
+		h[i].AfterValidatorRetired(ctx, consAddr, valAddr)
+	}
+}
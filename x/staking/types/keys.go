@@ -42,12 +42,29 @@ var (
 	RedelegationKey                  = []byte{0x34} // key for a redelegation
 	RedelegationByValSrcIndexKey     = []byte{0x35} // prefix for each key for an redelegation, by source validator operator
 	RedelegationByValDstIndexKey     = []byte{0x36} // prefix for each key for an redelegation, by destination validator operator
+	DelegationByValIndexKey          = []byte{0x37} // prefix for each key for a delegation, by validator operator
 
 	UnbondingQueueKey    = []byte{0x41} // prefix for the timestamps in unbonding queue
 	RedelegationQueueKey = []byte{0x42} // prefix for the timestamps in redelegations queue
 	ValidatorQueueKey    = []byte{0x43} // prefix for the timestamps in validator queue
+	RetirementQueueKey   = []byte{0x44} // prefix for the retired validators whose delegations are being unbonded
 
 	HistoricalInfoKey = []byte{0x50} // prefix for the historical info
+
+	ConsPubKeyRotationKey        = []byte{0x60} // prefix for each key to a consensus pubkey rotation, by validator operator
+	ConsPubKeyRotationUpdatesKey = []byte{0x61} // prefix for the rotations whose validator set updates are pending
+
+	TokenizeShareRecordKey       = []byte{0x70} // prefix for each key to a tokenize share record, by id
+	TokenizeShareRecordByValKey  = []byte{0x71} // prefix for each key to a tokenize share record index, by validator operator and id
+	LastTokenizeShareRecordIDKey = []byte{0x72} // key for the id of the last tokenize share record
+	TotalLiquidStakedTokensKey   = []byte{0x73} // key for the total amount of tokens of the tokenized delegations
+	ValidatorTokenizedSharesKey  = []byte{0x74} // prefix for each key to the tokenized delegation shares of a validator
+
+	DelegationChangeKey         = []byte{0x80} // prefix for each key to a delegation change, by delegator and height
+	DelegationChangeByHeightKey = []byte{0x81} // prefix for each key to a delegation change index, by height
+
+	DelegationLockKey      = []byte{0x90} // prefix for each key to a delegation lock, by delegator and validator
+	DelegationLockQueueKey = []byte{0x91} // prefix for the timestamps in delegation lock queue
 )
 
 // gets the key for the validator with address
@@ -125,6 +142,12 @@ func GetValidatorQueueTimeKey(timestamp time.Time) []byte {
 	return append(ValidatorQueueKey, bz...)
 }
 
+// gets the key for a retired validator whose delegations are being unbonded
+// VALUE: none
+func GetRetirementQueueKey(operatorAddr sdk.ValAddress) []byte {
+	return append(RetirementQueueKey, operatorAddr.Bytes()...)
+}
+
 //______________________________________________________________________________
 
 // gets the key for delegator bond with validator
@@ -138,6 +161,28 @@ func GetDelegationsKey(delAddr sdk.AccAddress) []byte {
 	return append(DelegationKey, delAddr.Bytes()...)
 }
 
+// gets the index-key for a delegation, stored by validator-index
+// VALUE: none (key rearrangement used)
+func GetDelegationByValIndexKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
+	return append(GetDelegationsByValIndexKey(valAddr), delAddr.Bytes()...)
+}
+
+// gets the prefix keyspace for the indexes of delegations to a validator
+func GetDelegationsByValIndexKey(valAddr sdk.ValAddress) []byte {
+	return append(DelegationByValIndexKey, valAddr.Bytes()...)
+}
+
+// rearranges the ValIndexKey to get the DelegationKey
+func GetDelegationKeyFromValIndexKey(indexKey []byte) []byte {
+	addrs := indexKey[1:] // remove prefix bytes
+	if len(addrs) != 2*sdk.AddrLen {
+		panic("unexpected key length")
+	}
+	valAddr := addrs[:sdk.AddrLen]
+	delAddr := addrs[sdk.AddrLen:]
+	return GetDelegationKey(delAddr, valAddr)
+}
+
 //______________________________________________________________________________
 
 // gets the key for an unbonding delegation by delegator and validator addr
@@ -288,3 +333,128 @@ func GetREDsByDelToValDstIndexKey(delAddr sdk.AccAddress, valDstAddr sdk.ValAddr
 func GetHistoricalInfoKey(height int64) []byte {
 	return append(HistoricalInfoKey, []byte(strconv.FormatInt(height, 10))...)
 }
+
+//________________________________________________________________________________
+
+// GetConsPubKeyRotationKey gets the key for the consensus pubkey rotation of a
+// validator
+// VALUE: staking/ConsPubKeyRotation
+func GetConsPubKeyRotationKey(operatorAddr sdk.ValAddress) []byte {
+	return append(ConsPubKeyRotationKey, operatorAddr.Bytes()...)
+}
+
+// GetConsPubKeyRotationUpdateKey gets the key marking a consensus pubkey
+// rotation whose validator set updates have not been returned to Tendermint
+// VALUE: none
+func GetConsPubKeyRotationUpdateKey(operatorAddr sdk.ValAddress) []byte {
+	return append(ConsPubKeyRotationUpdatesKey, operatorAddr.Bytes()...)
+}
+
+// GetTokenizeShareRecordKey gets the key for a tokenize share record by id
+// VALUE: staking/TokenizeShareRecord
+func GetTokenizeShareRecordKey(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return append(TokenizeShareRecordKey, bz...)
+}
+
+// GetTokenizeShareRecordByValKey gets the key for the index of a
+// tokenize share record by validator operator
+// VALUE: none
+func GetTokenizeShareRecordByValKey(valAddr sdk.ValAddress, id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return append(GetTokenizeShareRecordsByValKey(valAddr), bz...)
+}
+
+// GetTokenizeShareRecordsByValKey gets the prefix for the index of the
+// tokenize share records of a validator
+func GetTokenizeShareRecordsByValKey(valAddr sdk.ValAddress) []byte {
+	return append(TokenizeShareRecordByValKey, valAddr.Bytes()...)
+}
+
+// SplitTokenizeShareRecordByValKey returns the id of the tokenize share
+// record from its index key by validator operator
+func SplitTokenizeShareRecordByValKey(key []byte) uint64 {
+	if len(key) != 1+sdk.AddrLen+8 {
+		panic("unexpected key length")
+	}
+	return binary.BigEndian.Uint64(key[1+sdk.AddrLen:])
+}
+
+// GetValidatorTokenizedSharesKey gets the key for the tokenized delegation
+// shares of a validator
+// VALUE: sdk.Dec
+func GetValidatorTokenizedSharesKey(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorTokenizedSharesKey, valAddr.Bytes()...)
+}
+
+//________________________________________________________________________________
+
+// GetDelegationChangeKey gets the key for the change of the delegation of a
+// delegator to a validator at a height
+// VALUE: staking/DelegationChange
+func GetDelegationChangeKey(delAddr sdk.AccAddress, height int64, valAddr sdk.ValAddress) []byte {
+	return append(GetDelegationChangesFromHeightKey(delAddr, height), valAddr.Bytes()...)
+}
+
+// GetDelegationChangesKey gets the prefix for all the delegation changes of a
+// delegator
+func GetDelegationChangesKey(delAddr sdk.AccAddress) []byte {
+	return append(DelegationChangeKey, delAddr.Bytes()...)
+}
+
+// GetDelegationChangesFromHeightKey gets the prefix for the delegation changes
+// of a delegator at a height, which also orders before all the changes of the
+// delegator at later heights
+func GetDelegationChangesFromHeightKey(delAddr sdk.AccAddress, height int64) []byte {
+	return append(GetDelegationChangesKey(delAddr), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetDelegationChangeByHeightKey gets the key for the index of a delegation
+// change by height
+// VALUE: none
+func GetDelegationChangeByHeightKey(height int64, delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
+	key := append(GetDelegationChangesByHeightKey(height), delAddr.Bytes()...)
+	return append(key, valAddr.Bytes()...)
+}
+
+// GetDelegationChangesByHeightKey gets the prefix for the index of all the
+// delegation changes at a height
+func GetDelegationChangesByHeightKey(height int64) []byte {
+	return append(DelegationChangeByHeightKey, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// ParseDelegationChangeByHeightKey returns the height, delegator and validator
+// of a delegation change from the key of its index by height
+func ParseDelegationChangeByHeightKey(key []byte) (height int64, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	addrs := key[9:] // remove prefix and height bytes
+	if len(addrs) != 2*sdk.AddrLen {
+		panic("unexpected key length")
+	}
+
+	height = int64(binary.BigEndian.Uint64(key[1:9]))
+	return height, sdk.AccAddress(addrs[:sdk.AddrLen]), sdk.ValAddress(addrs[sdk.AddrLen:])
+}
+
+//______________________________________________________________________________
+
+// GetDelegationLockKey gets the key for the lock of the delegation of a
+// delegator to a validator
+// VALUE: staking/DelegationLock
+func GetDelegationLockKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
+	return append(GetDelegationLocksKey(delAddr), valAddr.Bytes()...)
+}
+
+// GetDelegationLocksKey gets the prefix for the locks of all the delegations
+// of a delegator
+func GetDelegationLocksKey(delAddr sdk.AccAddress) []byte {
+	return append(DelegationLockKey, delAddr.Bytes()...)
+}
+
+// GetDelegationLockTimeKey gets the prefix for all the delegation locks ending
+// at a time
+func GetDelegationLockTimeKey(timestamp time.Time) []byte {
+	bz := sdk.FormatTimeBytes(timestamp)
+	return append(DelegationLockQueueKey, bz...)
+}
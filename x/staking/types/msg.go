@@ -2,6 +2,7 @@ package types
 
 import (
 	"bytes"
+	"time"
 
 	"github.com/tendermint/tendermint/crypto"
 
@@ -15,6 +16,12 @@ var (
 	_ sdk.Msg = &MsgDelegate{}
 	_ sdk.Msg = &MsgUndelegate{}
 	_ sdk.Msg = &MsgBeginRedelegate{}
+	_ sdk.Msg = &MsgCancelUnbondingDelegation{}
+	_ sdk.Msg = &MsgRotateConsPubKey{}
+	_ sdk.Msg = &MsgRetireValidator{}
+	_ sdk.Msg = &MsgTokenizeShares{}
+	_ sdk.Msg = &MsgRedeemTokensForShares{}
+	_ sdk.Msg = &MsgLockDelegation{}
 )
 
 // NewMsgCreateValidator creates a new MsgCreateValidator instance.
@@ -273,3 +280,249 @@ func (msg MsgUndelegate) ValidateBasic() error {
 	}
 	return nil
 }
+
+// NewMsgCancelUnbondingDelegation creates a new MsgCancelUnbondingDelegation
+// instance.
+func NewMsgCancelUnbondingDelegation(
+	delAddr sdk.AccAddress, valAddr sdk.ValAddress, creationHeight int64, amount sdk.Coin,
+) MsgCancelUnbondingDelegation {
+
+	return MsgCancelUnbondingDelegation{
+		DelegatorAddress: delAddr,
+		ValidatorAddress: valAddr,
+		Amount:           amount,
+		CreationHeight:   creationHeight,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgCancelUnbondingDelegation) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgCancelUnbondingDelegation) Type() string { return "cancel_unbonding_delegation" }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgCancelUnbondingDelegation) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelegatorAddress}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgCancelUnbondingDelegation) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgCancelUnbondingDelegation) ValidateBasic() error {
+	if msg.DelegatorAddress.Empty() {
+		return ErrEmptyDelegatorAddr
+	}
+	if msg.ValidatorAddress.Empty() {
+		return ErrEmptyValidatorAddr
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
+		return ErrBadSharesAmount
+	}
+	if msg.CreationHeight <= 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid creation height")
+	}
+	return nil
+}
+
+// NewMsgRotateConsPubKey creates a new MsgRotateConsPubKey instance.
+func NewMsgRotateConsPubKey(valAddr sdk.ValAddress, newPubKey crypto.PubKey) MsgRotateConsPubKey {
+	var pkStr string
+	if newPubKey != nil {
+		pkStr = sdk.MustBech32ifyPubKey(sdk.Bech32PubKeyTypeConsPub, newPubKey)
+	}
+
+	return MsgRotateConsPubKey{
+		ValidatorAddress: valAddr,
+		NewPubkey:        pkStr,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgRotateConsPubKey) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgRotateConsPubKey) Type() string { return "rotate_cons_pubkey" }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgRotateConsPubKey) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.ValidatorAddress)}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgRotateConsPubKey) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgRotateConsPubKey) ValidateBasic() error {
+	if msg.ValidatorAddress.Empty() {
+		return ErrEmptyValidatorAddr
+	}
+	if msg.NewPubkey == "" {
+		return ErrEmptyValidatorPubKey
+	}
+	if _, err := sdk.GetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, msg.NewPubkey); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
+	}
+	return nil
+}
+
+// NewMsgRetireValidator creates a new MsgRetireValidator instance.
+func NewMsgRetireValidator(valAddr sdk.ValAddress) MsgRetireValidator {
+	return MsgRetireValidator{
+		ValidatorAddress: valAddr,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgRetireValidator) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgRetireValidator) Type() string { return "retire_validator" }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgRetireValidator) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.ValidatorAddress)}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgRetireValidator) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgRetireValidator) ValidateBasic() error {
+	if msg.ValidatorAddress.Empty() {
+		return ErrEmptyValidatorAddr
+	}
+	return nil
+}
+
+// NewMsgTokenizeShares creates a new MsgTokenizeShares instance.
+func NewMsgTokenizeShares(delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin) MsgTokenizeShares {
+	return MsgTokenizeShares{
+		DelegatorAddress: delAddr,
+		ValidatorAddress: valAddr,
+		Amount:           amount,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgTokenizeShares) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgTokenizeShares) Type() string { return "tokenize_shares" }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgTokenizeShares) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelegatorAddress}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgTokenizeShares) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgTokenizeShares) ValidateBasic() error {
+	if msg.DelegatorAddress.Empty() {
+		return ErrEmptyDelegatorAddr
+	}
+	if msg.ValidatorAddress.Empty() {
+		return ErrEmptyValidatorAddr
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid shares amount")
+	}
+
+	return nil
+}
+
+// NewMsgRedeemTokensForShares creates a new MsgRedeemTokensForShares instance.
+func NewMsgRedeemTokensForShares(delAddr sdk.AccAddress, amount sdk.Coin) MsgRedeemTokensForShares {
+	return MsgRedeemTokensForShares{
+		DelegatorAddress: delAddr,
+		Amount:           amount,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgRedeemTokensForShares) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgRedeemTokensForShares) Type() string { return "redeem_tokens_for_shares" }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgRedeemTokensForShares) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelegatorAddress}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgRedeemTokensForShares) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgRedeemTokensForShares) ValidateBasic() error {
+	if msg.DelegatorAddress.Empty() {
+		return ErrEmptyDelegatorAddr
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid share tokens amount")
+	}
+	if _, err := ParseShareTokenDenom(msg.Amount.Denom); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+
+	return nil
+}
+
+// NewMsgLockDelegation creates a new MsgLockDelegation instance.
+func NewMsgLockDelegation(delAddr sdk.AccAddress, valAddr sdk.ValAddress, lockDuration time.Duration) MsgLockDelegation {
+	return MsgLockDelegation{
+		DelegatorAddress: delAddr,
+		ValidatorAddress: valAddr,
+		LockDuration:     lockDuration,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgLockDelegation) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgLockDelegation) Type() string { return "lock_delegation" }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgLockDelegation) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelegatorAddress}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgLockDelegation) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgLockDelegation) ValidateBasic() error {
+	if msg.DelegatorAddress.Empty() {
+		return ErrEmptyDelegatorAddr
+	}
+	if msg.ValidatorAddress.Empty() {
+		return ErrEmptyValidatorAddr
+	}
+	if msg.LockDuration <= 0 {
+		return ErrInvalidLockDuration
+	}
+
+	return nil
+}
@@ -28,6 +28,37 @@ const (
 	// DefaultHistorical entries is 0 since it must only be non-zero for
 	// IBC connected chains
 	DefaultHistoricalEntries uint32 = 0
+
+	// DefaultDelegationHistoryRetention is 0 since the delegation change log
+	// is only needed by nodes serving historical delegation queries
+	DefaultDelegationHistoryRetention uint32 = 0
+)
+
+var (
+	// DefaultKeyRotationFee is the default amount of bond denom tokens burned
+	// when a validator rotates its consensus public key.
+	DefaultKeyRotationFee = sdk.TokensFromConsensusPower(1)
+
+	// DefaultGlobalLiquidStakingCap is the default maximum fraction of the
+	// bonded tokens which can be tokenized; tokenizing is not limited.
+	DefaultGlobalLiquidStakingCap = sdk.OneDec()
+
+	// DefaultValidatorLiquidStakingCap is the default maximum fraction of the
+	// tokens of a validator which can be tokenized; tokenizing is not limited.
+	DefaultValidatorLiquidStakingCap = sdk.OneDec()
+
+	// DefaultMinCommissionRate is the default minimum commission rate of the
+	// validators; commission rates are not limited.
+	DefaultMinCommissionRate = sdk.ZeroDec()
+
+	// DefaultMaxCommissionChangeRate is the default maximum daily change of
+	// the commission rate of the validators; commission changes are not
+	// limited.
+	DefaultMaxCommissionChangeRate = sdk.OneDec()
+
+	// DefaultLockTiers are the default lock tiers; delegations cannot be
+	// locked.
+	DefaultLockTiers []LockTier
 )
 
 // nolint - Keys for parameter access
@@ -37,6 +68,14 @@ var (
 	KeyMaxEntries        = []byte("KeyMaxEntries")
 	KeyBondDenom         = []byte("BondDenom")
 	KeyHistoricalEntries = []byte("HistoricalEntries")
+	KeyKeyRotationFee    = []byte("KeyRotationFee")
+
+	KeyGlobalLiquidStakingCap     = []byte("GlobalLiquidStakingCap")
+	KeyValidatorLiquidStakingCap  = []byte("ValidatorLiquidStakingCap")
+	KeyMinCommissionRate          = []byte("MinCommissionRate")
+	KeyDelegationHistoryRetention = []byte("DelegationHistoryRetention")
+	KeyLockTiers                  = []byte("LockTiers")
+	KeyMaxCommissionChangeRate    = []byte("MaxCommissionChangeRate")
 )
 
 var _ params.ParamSet = (*Params)(nil)
@@ -44,14 +83,23 @@ var _ params.ParamSet = (*Params)(nil)
 // NewParams creates a new Params instance
 func NewParams(
 	unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string,
+	keyRotationFee sdk.Int, globalLiquidStakingCap, validatorLiquidStakingCap, minCommissionRate sdk.Dec,
+	delegationHistoryRetention uint32, lockTiers []LockTier, maxCommissionChangeRate sdk.Dec,
 ) Params {
 
 	return Params{
-		UnbondingTime:     unbondingTime,
-		MaxValidators:     maxValidators,
-		MaxEntries:        maxEntries,
-		HistoricalEntries: historicalEntries,
-		BondDenom:         bondDenom,
+		UnbondingTime:              unbondingTime,
+		MaxValidators:              maxValidators,
+		MaxEntries:                 maxEntries,
+		HistoricalEntries:          historicalEntries,
+		BondDenom:                  bondDenom,
+		KeyRotationFee:             keyRotationFee,
+		GlobalLiquidStakingCap:     globalLiquidStakingCap,
+		ValidatorLiquidStakingCap:  validatorLiquidStakingCap,
+		MinCommissionRate:          minCommissionRate,
+		DelegationHistoryRetention: delegationHistoryRetention,
+		LockTiers:                  lockTiers,
+		MaxCommissionChangeRate:    maxCommissionChangeRate,
 	}
 }
 
@@ -63,6 +111,13 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		params.NewParamSetPair(KeyMaxEntries, &p.MaxEntries, validateMaxEntries),
 		params.NewParamSetPair(KeyHistoricalEntries, &p.HistoricalEntries, validateHistoricalEntries),
 		params.NewParamSetPair(KeyBondDenom, &p.BondDenom, validateBondDenom),
+		params.NewParamSetPair(KeyKeyRotationFee, &p.KeyRotationFee, validateKeyRotationFee),
+		params.NewParamSetPair(KeyGlobalLiquidStakingCap, &p.GlobalLiquidStakingCap, validateLiquidStakingCap),
+		params.NewParamSetPair(KeyValidatorLiquidStakingCap, &p.ValidatorLiquidStakingCap, validateLiquidStakingCap),
+		params.NewParamSetPair(KeyMinCommissionRate, &p.MinCommissionRate, validateMinCommissionRate),
+		params.NewParamSetPair(KeyDelegationHistoryRetention, &p.DelegationHistoryRetention, validateDelegationHistoryRetention),
+		params.NewParamSetPair(KeyLockTiers, &p.LockTiers, validateLockTiers),
+		params.NewParamSetPair(KeyMaxCommissionChangeRate, &p.MaxCommissionChangeRate, validateMaxCommissionChangeRate),
 	}
 }
 
@@ -74,6 +129,13 @@ func DefaultParams() Params {
 		DefaultMaxEntries,
 		DefaultHistoricalEntries,
 		sdk.DefaultBondDenom,
+		DefaultKeyRotationFee,
+		DefaultGlobalLiquidStakingCap,
+		DefaultValidatorLiquidStakingCap,
+		DefaultMinCommissionRate,
+		DefaultDelegationHistoryRetention,
+		DefaultLockTiers,
+		DefaultMaxCommissionChangeRate,
 	)
 }
 
@@ -115,10 +177,39 @@ func (p Params) Validate() error {
 	if err := validateBondDenom(p.BondDenom); err != nil {
 		return err
 	}
+	if err := validateKeyRotationFee(p.KeyRotationFee); err != nil {
+		return err
+	}
+	if err := validateLiquidStakingCap(p.GlobalLiquidStakingCap); err != nil {
+		return err
+	}
+	if err := validateLiquidStakingCap(p.ValidatorLiquidStakingCap); err != nil {
+		return err
+	}
+	if err := validateMinCommissionRate(p.MinCommissionRate); err != nil {
+		return err
+	}
+	if err := validateLockTiers(p.LockTiers); err != nil {
+		return err
+	}
+	if err := validateMaxCommissionChangeRate(p.MaxCommissionChangeRate); err != nil {
+		return err
+	}
 
 	return nil
 }
 
+// GetLockTier returns the lock tier with the given duration, if it exists.
+func (p Params) GetLockTier(duration time.Duration) (LockTier, bool) {
+	for _, tier := range p.LockTiers {
+		if tier.Duration == duration {
+			return tier, true
+		}
+	}
+
+	return LockTier{}, false
+}
+
 func validateUnbondingTime(i interface{}) error {
 	v, ok := i.(time.Duration)
 	if !ok {
@@ -167,6 +258,15 @@ func validateHistoricalEntries(i interface{}) error {
 	return nil
 }
 
+func validateDelegationHistoryRetention(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
 func validateBondDenom(i interface{}) error {
 	v, ok := i.(string)
 	if !ok {
@@ -182,3 +282,103 @@ func validateBondDenom(i interface{}) error {
 
 	return nil
 }
+
+func validateKeyRotationFee(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.BigInt() == nil {
+		return errors.New("key rotation fee cannot be nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("key rotation fee cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateLiquidStakingCap(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return errors.New("liquid staking cap cannot be nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("liquid staking cap cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("liquid staking cap too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateMinCommissionRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return errors.New("minimum commission rate cannot be nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("minimum commission rate cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("minimum commission rate too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxCommissionChangeRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return errors.New("maximum commission change rate cannot be nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("maximum commission change rate cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("maximum commission change rate too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateLockTiers(i interface{}) error {
+	v, ok := i.([]LockTier)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	durations := make(map[time.Duration]bool, len(v))
+	for _, tier := range v {
+		if tier.Duration <= 0 {
+			return fmt.Errorf("lock tier duration must be positive: %s", tier.Duration)
+		}
+		if durations[tier.Duration] {
+			return fmt.Errorf("duplicate lock tier duration: %s", tier.Duration)
+		}
+		durations[tier.Duration] = true
+
+		if tier.RewardMultiplier.IsNil() {
+			return errors.New("lock tier reward multiplier cannot be nil")
+		}
+		if tier.RewardMultiplier.LT(sdk.OneDec()) {
+			return fmt.Errorf("lock tier reward multiplier cannot be less than one: %s", tier.RewardMultiplier)
+		}
+	}
+
+	return nil
+}
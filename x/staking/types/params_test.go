@@ -2,8 +2,11 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestParamsEqual(t *testing.T) {
@@ -19,3 +22,56 @@ func TestParamsEqual(t *testing.T) {
 	ok = p1.Equal(p2)
 	require.False(t, ok)
 }
+
+func TestParamsValidateLockTiers(t *testing.T) {
+	tier := NewLockTier(time.Hour, sdk.NewDecWithPrec(11, 1))
+
+	tests := []struct {
+		name       string
+		lockTiers  []LockTier
+		expectPass bool
+	}{
+		{"no tiers", nil, true},
+		{"regular", []LockTier{tier, NewLockTier(2*time.Hour, sdk.NewDecWithPrec(12, 1))}, true},
+		{"multiplier of one", []LockTier{NewLockTier(time.Hour, sdk.OneDec())}, true},
+		{"zero duration", []LockTier{NewLockTier(0, sdk.OneDec())}, false},
+		{"duplicate duration", []LockTier{tier, tier}, false},
+		{"nil multiplier", []LockTier{{Duration: time.Hour}}, false},
+		{"multiplier below one", []LockTier{NewLockTier(time.Hour, sdk.NewDecWithPrec(9, 1))}, false},
+	}
+
+	for _, tc := range tests {
+		params := DefaultParams()
+		params.LockTiers = tc.lockTiers
+		if tc.expectPass {
+			require.NoError(t, params.Validate(), "test: %v", tc.name)
+		} else {
+			require.Error(t, params.Validate(), "test: %v", tc.name)
+		}
+	}
+}
+
+func TestParamsValidateMaxCommissionChangeRate(t *testing.T) {
+	tests := []struct {
+		name                    string
+		maxCommissionChangeRate sdk.Dec
+		expectPass              bool
+	}{
+		{"zero", sdk.ZeroDec(), true},
+		{"regular", sdk.NewDecWithPrec(1, 2), true},
+		{"one", sdk.OneDec(), true},
+		{"nil", sdk.Dec{}, false},
+		{"negative", sdk.NewDecWithPrec(-1, 2), false},
+		{"above one", sdk.NewDecWithPrec(11, 1), false},
+	}
+
+	for _, tc := range tests {
+		params := DefaultParams()
+		params.MaxCommissionChangeRate = tc.maxCommissionChangeRate
+		if tc.expectPass {
+			require.NoError(t, params.Validate(), "test: %v", tc.name)
+		} else {
+			require.Error(t, params.Validate(), "test: %v", tc.name)
+		}
+	}
+}
@@ -11,9 +11,12 @@ import (
 // - NotBondedPool -> "not_bonded_tokens_pool"
 //
 // - BondedPool -> "bonded_tokens_pool"
+//
+// - TokenizeSharesPool -> "tokenize_shares_pool"
 const (
-	NotBondedPoolName = "not_bonded_tokens_pool"
-	BondedPoolName    = "bonded_tokens_pool"
+	NotBondedPoolName      = "not_bonded_tokens_pool"
+	BondedPoolName         = "bonded_tokens_pool"
+	TokenizeSharesPoolName = "tokenize_shares_pool"
 )
 
 // Pool - tracking bonded and not-bonded token supply of the bond denomination
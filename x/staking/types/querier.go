@@ -1,6 +1,9 @@
 package types
 
 import (
+	"fmt"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -21,6 +24,10 @@ const (
 	QueryPool                          = "pool"
 	QueryParameters                    = "parameters"
 	QueryHistoricalInfo                = "historicalInfo"
+	QueryTokenizeShareRecords          = "tokenizeShareRecords"
+	QueryDelegatorDelegationChanges    = "delegatorDelegationChanges"
+	QueryDelegatorDelegationsAtHeight  = "delegatorDelegationsAtHeight"
+	QueryDelegationLock                = "delegationLock"
 )
 
 // defines the params for the following queries:
@@ -28,13 +35,26 @@ const (
 // - 'custom/staking/delegatorUnbondingDelegations'
 // - 'custom/staking/delegatorRedelegations'
 // - 'custom/staking/delegatorValidators'
+//
+// The results are filtered on the status and the jail state of their
+// validator, see MatchesValidatorFilter.
 type QueryDelegatorParams struct {
 	DelegatorAddr sdk.AccAddress
+	Page, Limit   int
+	Status        string
+	Jailed        *bool
 }
 
-func NewQueryDelegatorParams(delegatorAddr sdk.AccAddress) QueryDelegatorParams {
+func NewQueryDelegatorParams(
+	delegatorAddr sdk.AccAddress, page, limit int, status string, jailed *bool,
+) QueryDelegatorParams {
+
 	return QueryDelegatorParams{
 		DelegatorAddr: delegatorAddr,
+		Page:          page,
+		Limit:         limit,
+		Status:        status,
+		Jailed:        jailed,
 	}
 }
 
@@ -45,11 +65,14 @@ func NewQueryDelegatorParams(delegatorAddr sdk.AccAddress) QueryDelegatorParams
 // - 'custom/staking/validatorRedelegations'
 type QueryValidatorParams struct {
 	ValidatorAddr sdk.ValAddress
+	Page, Limit   int
 }
 
-func NewQueryValidatorParams(validatorAddr sdk.ValAddress) QueryValidatorParams {
+func NewQueryValidatorParams(validatorAddr sdk.ValAddress, page, limit int) QueryValidatorParams {
 	return QueryValidatorParams{
 		ValidatorAddr: validatorAddr,
+		Page:          page,
+		Limit:         limit,
 	}
 }
 
@@ -75,27 +98,69 @@ type QueryRedelegationParams struct {
 	DelegatorAddr    sdk.AccAddress
 	SrcValidatorAddr sdk.ValAddress
 	DstValidatorAddr sdk.ValAddress
+	Page, Limit      int
 }
 
 func NewQueryRedelegationParams(delegatorAddr sdk.AccAddress,
-	srcValidatorAddr, dstValidatorAddr sdk.ValAddress) QueryRedelegationParams {
+	srcValidatorAddr, dstValidatorAddr sdk.ValAddress, page, limit int) QueryRedelegationParams {
 
 	return QueryRedelegationParams{
 		DelegatorAddr:    delegatorAddr,
 		SrcValidatorAddr: srcValidatorAddr,
 		DstValidatorAddr: dstValidatorAddr,
+		Page:             page,
+		Limit:            limit,
 	}
 }
 
 // QueryValidatorsParams defines the params for the following queries:
 // - 'custom/staking/validators'
+//
+// The validators are filtered on their status and jail state, see
+// MatchesValidatorFilter.
 type QueryValidatorsParams struct {
 	Page, Limit int
 	Status      string
+	Jailed      *bool
+}
+
+func NewQueryValidatorsParams(page, limit int, status string, jailed *bool) QueryValidatorsParams {
+	return QueryValidatorsParams{page, limit, status, jailed}
+}
+
+// QueryTokenizeShareRecordsParams defines the params for the following queries:
+// - 'custom/staking/tokenizeShareRecords'
+type QueryTokenizeShareRecordsParams struct {
+	Page, Limit int
+}
+
+// NewQueryTokenizeShareRecordsParams creates a new QueryTokenizeShareRecordsParams instance
+func NewQueryTokenizeShareRecordsParams(page, limit int) QueryTokenizeShareRecordsParams {
+	return QueryTokenizeShareRecordsParams{page, limit}
+}
+
+// ValidateValidatorFilter validates the validator status filter of a query,
+// which must be empty or one of the Bonded, Unbonding or Unbonded statuses.
+func ValidateValidatorFilter(status string) error {
+	switch {
+	case status == "",
+		strings.EqualFold(status, sdk.BondStatusBonded),
+		strings.EqualFold(status, sdk.BondStatusUnbonding),
+		strings.EqualFold(status, sdk.BondStatusUnbonded):
+		return nil
+	default:
+		return fmt.Errorf("invalid validator status filter: %s", status)
+	}
 }
 
-func NewQueryValidatorsParams(page, limit int, status string) QueryValidatorsParams {
-	return QueryValidatorsParams{page, limit, status}
+// MatchesValidatorFilter returns true if the validator has the given status
+// and jail state. An empty status and a nil jail state match all the
+// validators.
+func MatchesValidatorFilter(validator Validator, status string, jailed *bool) bool {
+	if status != "" && !strings.EqualFold(validator.GetStatus().String(), status) {
+		return false
+	}
+	return jailed == nil || validator.Jailed == *jailed
 }
 
 // QueryHistoricalInfoParams defines the params for the following queries:
@@ -108,3 +173,31 @@ type QueryHistoricalInfoParams struct {
 func NewQueryHistoricalInfoParams(height int64) QueryHistoricalInfoParams {
 	return QueryHistoricalInfoParams{height}
 }
+
+// QueryDelegatorDelegationChangesParams defines the params for the following queries:
+// - 'custom/staking/delegatorDelegationChanges'
+type QueryDelegatorDelegationChangesParams struct {
+	DelegatorAddr sdk.AccAddress
+	Page, Limit   int
+}
+
+// NewQueryDelegatorDelegationChangesParams creates a new QueryDelegatorDelegationChangesParams instance
+func NewQueryDelegatorDelegationChangesParams(delegatorAddr sdk.AccAddress, page, limit int) QueryDelegatorDelegationChangesParams {
+	return QueryDelegatorDelegationChangesParams{delegatorAddr, page, limit}
+}
+
+// QueryDelegatorDelegationsAtHeightParams defines the params for the following queries:
+// - 'custom/staking/delegatorDelegationsAtHeight'
+type QueryDelegatorDelegationsAtHeightParams struct {
+	DelegatorAddr sdk.AccAddress
+	Height        int64
+	Page, Limit   int
+}
+
+// NewQueryDelegatorDelegationsAtHeightParams creates a new QueryDelegatorDelegationsAtHeightParams instance
+func NewQueryDelegatorDelegationsAtHeightParams(
+	delegatorAddr sdk.AccAddress, height int64, page, limit int,
+) QueryDelegatorDelegationsAtHeightParams {
+
+	return QueryDelegatorDelegationsAtHeightParams{delegatorAddr, height, page, limit}
+}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/tendermint/tendermint/crypto"
+	yaml "gopkg.in/yaml.v2"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ShareTokenDenomPrefix is the prefix of the denominations of the share tokens
+// issued for the tokenized delegations of a validator.
+const ShareTokenDenomPrefix = "share"
+
+// NewTokenizeShareRecord creates a new TokenizeShareRecord instance. The
+// account holding the tokenized delegation is derived from the record id.
+func NewTokenizeShareRecord(id uint64, valAddr sdk.ValAddress) TokenizeShareRecord {
+	return TokenizeShareRecord{
+		Id:               id,
+		ModuleAccount:    sdk.AccAddress(crypto.AddressHash([]byte(fmt.Sprintf("%s_%d", TokenizeSharesPoolName, id)))),
+		ValidatorAddress: valAddr,
+	}
+}
+
+// GetShareTokenDenom returns the denomination of the share tokens of the record.
+func (r TokenizeShareRecord) GetShareTokenDenom() string {
+	return fmt.Sprintf("%s/%d", ShareTokenDenomPrefix, r.Id)
+}
+
+// String implements the Stringer interface for a TokenizeShareRecord object.
+func (r TokenizeShareRecord) String() string {
+	out, _ := yaml.Marshal(r)
+	return string(out)
+}
+
+// ParseShareTokenDenom returns the id of the record a share token denomination
+// was issued for.
+func ParseShareTokenDenom(denom string) (uint64, error) {
+	parts := strings.Split(denom, "/")
+	if len(parts) != 2 || parts[0] != ShareTokenDenomPrefix {
+		return 0, fmt.Errorf("invalid share token denom: %s", denom)
+	}
+
+	id, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid share token denom %s: %w", denom, err)
+	}
+	return id, nil
+}
+
+// MustMarshalTokenizeShareRecord returns the record bytes. Panics if fails.
+func MustMarshalTokenizeShareRecord(cdc codec.Marshaler, record TokenizeShareRecord) []byte {
+	return cdc.MustMarshalBinaryLengthPrefixed(&record)
+}
+
+// MustUnmarshalTokenizeShareRecord returns the unmarshaled record from bytes.
+// Panics if fails.
+func MustUnmarshalTokenizeShareRecord(cdc codec.Marshaler, value []byte) TokenizeShareRecord {
+	record, err := UnmarshalTokenizeShareRecord(cdc, value)
+	if err != nil {
+		panic(err)
+	}
+	return record
+}
+
+// UnmarshalTokenizeShareRecord returns the record from bytes.
+func UnmarshalTokenizeShareRecord(cdc codec.Marshaler, value []byte) (record TokenizeShareRecord, err error) {
+	err = cdc.UnmarshalBinaryLengthPrefixed(value, &record)
+	return record, err
+}
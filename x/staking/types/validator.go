@@ -28,6 +28,11 @@ const (
 	MaxDetailsLength         = 280
 )
 
+// MaxRetirementUnbondingsPerBlock is the maximum number of delegations to
+// retired validators unbonded in a block. The remaining delegations are
+// unbonded in the following blocks.
+const MaxRetirementUnbondingsPerBlock = 100
+
 var _ exported.ValidatorI = Validator{}
 
 func NewValidator(operator sdk.ValAddress, pubKey crypto.PubKey, description Description) Validator {
@@ -390,6 +395,7 @@ func (v Validator) MinEqual(other Validator) bool {
 
 // nolint - for ValidatorI
 func (v Validator) IsJailed() bool              { return v.Jailed }
+func (v Validator) IsRetired() bool             { return v.Retired }
 func (v Validator) GetMoniker() string          { return v.Description.Moniker }
 func (v Validator) GetStatus() sdk.BondStatus   { return v.Status }
 func (v Validator) GetOperator() sdk.ValAddress { return v.OperatorAddress }
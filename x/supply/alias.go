@@ -27,7 +27,9 @@ var (
 	TotalSupply           = keeper.TotalSupply
 	NewKeeper             = keeper.NewKeeper
 	NewQuerier            = keeper.NewQuerier
-	SupplyKey             = keeper.SupplyKey
+	LegacySupplyKey       = keeper.LegacySupplyKey
+	SupplyKeyPrefix       = keeper.SupplyKeyPrefix
+	SupplyStoreKey        = keeper.SupplyStoreKey
 	NewModuleAddress      = types.NewModuleAddress
 	NewEmptyModuleAccount = types.NewEmptyModuleAccount
 	NewModuleAccount      = types.NewModuleAccount
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -28,6 +29,7 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 
 	supplyQueryCmd.AddCommand(flags.GetCommands(
 		GetCmdQueryTotalSupply(cdc),
+		GetCmdQueryCirculatingSupply(cdc),
 	)...)
 
 	return supplyQueryCmd
@@ -35,7 +37,7 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 
 // GetCmdQueryTotalSupply implements the query total supply command.
 func GetCmdQueryTotalSupply(cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "total [denom]",
 		Args:  cobra.MaximumNArgs(1),
 		Short: "Query the total supply of coins of the chain",
@@ -48,23 +50,89 @@ $ %s query %s total
 
 To query for the total supply of a specific coin denomination use:
 $ %s query %s total stake
+
+To paginate through the total supply of every denomination use:
+$ %s query %s total --page=2 --limit=100
 `,
 				version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
+				version.ClientName, types.ModuleName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
 			if len(args) == 0 {
-				return queryTotalSupply(cliCtx, cdc)
+				return queryTotalSupply(cliCtx, cdc, viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit))
 			}
 			return querySupplyOf(cliCtx, cdc, args[0])
 		},
 	}
+
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of denominations to query for")
+	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit of denominations to query for")
+
+	return cmd
+}
+
+// GetCmdQueryCirculatingSupply implements the query circulating supply command.
+func GetCmdQueryCirculatingSupply(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "circulating [denom]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Query the circulating supply of coins of the chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the circulating supply of coins, i.e. the total supply minus the
+coins held by module accounts and the coins still locked in vesting accounts.
+
+Example:
+$ %s query %s circulating
+
+To query for the circulating supply of a specific coin denomination use:
+$ %s query %s circulating stake
+`,
+				version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var denom string
+			if len(args) > 0 {
+				denom = args[0]
+			}
+
+			params := types.NewQuerySupplyOfParams(denom)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCirculatingSupply), bz)
+			if err != nil {
+				return err
+			}
+
+			if denom == "" {
+				var circulating sdk.Coins
+				if err := cdc.UnmarshalJSON(res, &circulating); err != nil {
+					return err
+				}
+
+				return cliCtx.PrintOutput(circulating)
+			}
+
+			var circulating sdk.Int
+			if err := cdc.UnmarshalJSON(res, &circulating); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(circulating)
+		},
+	}
 }
 
-func queryTotalSupply(cliCtx context.CLIContext, cdc *codec.Codec) error {
-	params := types.NewQueryTotalSupplyParams(1, 0) // no pagination
+func queryTotalSupply(cliCtx context.CLIContext, cdc *codec.Codec, page, limit int) error {
+	params := types.NewQueryTotalSupplyParams(page, limit)
 	bz, err := cdc.MarshalJSON(params)
 	if err != nil {
 		return err
@@ -111,10 +111,7 @@ func (k Keeper) MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) err
 	}
 
 	// update total supply
-	supply := k.GetSupply(ctx)
-	supply = supply.Inflate(amt)
-
-	k.SetSupply(ctx, supply)
+	k.inflateSupply(ctx, amt)
 
 	logger := k.Logger(ctx)
 	logger.Info(fmt.Sprintf("minted %s from %s module account", amt.String(), moduleName))
@@ -140,12 +137,27 @@ func (k Keeper) BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) err
 	}
 
 	// update total supply
-	supply := k.GetSupply(ctx)
-	supply = supply.Deflate(amt)
-	k.SetSupply(ctx, supply)
+	k.deflateSupply(ctx, amt)
 
 	logger := k.Logger(ctx)
 	logger.Info(fmt.Sprintf("burned %s from %s module account", amt.String(), moduleName))
 
 	return nil
 }
+
+// BurnCoinsFromAccount burns coins from the balance of a regular account and
+// deflates the total supply accordingly.
+func (k Keeper) BurnCoinsFromAccount(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) error {
+	_, err := k.bk.SubtractCoins(ctx, addr, amt)
+	if err != nil {
+		return err
+	}
+
+	// update total supply
+	k.deflateSupply(ctx, amt)
+
+	logger := k.Logger(ctx)
+	logger.Info(fmt.Sprintf("burned %s from account %s", amt.String(), addr))
+
+	return nil
+}
@@ -158,3 +158,28 @@ func TestBurnCoins(t *testing.T) {
 	require.Equal(t, sdk.Coins(nil), getCoinsByName(ctx, keeper, ak, bk, multiPermAcc.GetName()))
 	require.Equal(t, initialSupply.GetTotal().Sub(initCoins), keeper.GetSupply(ctx).GetTotal())
 }
+
+func TestBurnCoinsFromAccount(t *testing.T) {
+	app, ctx := createTestApp(false)
+	keeper := app.SupplyKeeper
+	ak := app.AccountKeeper
+	bk := app.BankKeeper
+
+	baseAcc := ak.NewAccountWithAddress(ctx, types.NewModuleAddress("baseAcc"))
+	ak.SetAccount(ctx, baseAcc)
+
+	require.NoError(t, bk.SetBalances(ctx, baseAcc.GetAddress(), initCoins))
+	keeper.SetSupply(ctx, types.NewSupply(initCoins))
+
+	err := keeper.BurnCoinsFromAccount(ctx, baseAcc.GetAddress(), initCoins.Add(initCoins...))
+	require.Error(t, err, "insufficient coins")
+
+	burnCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initTokens.QuoRaw(2)))
+	err = keeper.BurnCoinsFromAccount(ctx, baseAcc.GetAddress(), burnCoins)
+	require.NoError(t, err)
+	require.Equal(t, initCoins.Sub(burnCoins), bk.GetAllBalances(ctx, baseAcc.GetAddress()))
+	require.Equal(t, initCoins.Sub(burnCoins), keeper.GetSupply(ctx).GetTotal())
+
+	_, broken := keep.TotalSupply(keeper)(ctx)
+	require.False(t, broken)
+}
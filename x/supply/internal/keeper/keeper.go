@@ -7,6 +7,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	"github.com/cosmos/cosmos-sdk/x/supply/exported"
 	"github.com/cosmos/cosmos-sdk/x/supply/internal/types"
 )
@@ -42,22 +44,123 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
-// GetSupply retrieves the Supply from store
-func (k Keeper) GetSupply(ctx sdk.Context) (supply exported.SupplyI) {
+// GetSupply retrieves the Supply from store by aggregating the total supply of
+// every denomination.
+func (k Keeper) GetSupply(ctx sdk.Context) exported.SupplyI {
+	var total sdk.Coins
+	k.IterateTotalSupply(ctx, func(coin sdk.Coin) bool {
+		total = append(total, coin)
+		return false
+	})
+
+	return types.NewSupply(total)
+}
+
+// SetSupply sets the Supply to store, replacing the total supply of every
+// denomination.
+func (k Keeper) SetSupply(ctx sdk.Context, supply exported.SupplyI) {
 	store := ctx.KVStore(k.storeKey)
-	b := store.Get(SupplyKey)
-	if b == nil {
-		panic("stored supply should not have been nil")
+
+	iterator := sdk.KVStorePrefixIterator(store, SupplyKeyPrefix)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	for _, coin := range supply.GetTotal() {
+		k.SetSupplyOf(ctx, coin)
 	}
-	k.cdc.MustUnmarshalBinaryLengthPrefixed(b, &supply)
-	return
 }
 
-// SetSupply sets the Supply to store
-func (k Keeper) SetSupply(ctx sdk.Context, supply exported.SupplyI) {
+// GetSupplyOf retrieves the total supply of a single denomination from store.
+// It returns zero if the denomination has no supply.
+func (k Keeper) GetSupplyOf(ctx sdk.Context, denom string) sdk.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(SupplyStoreKey(denom))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	var amount sdk.Int
+	k.cdc.MustUnmarshalBinaryBare(bz, &amount)
+	return amount
+}
+
+// SetSupplyOf sets the total supply of a single denomination to store. A zero
+// amount removes the denomination from store.
+func (k Keeper) SetSupplyOf(ctx sdk.Context, coin sdk.Coin) {
+	store := ctx.KVStore(k.storeKey)
+	if coin.Amount.IsZero() {
+		store.Delete(SupplyStoreKey(coin.Denom))
+		return
+	}
+
+	store.Set(SupplyStoreKey(coin.Denom), k.cdc.MustMarshalBinaryBare(coin.Amount))
+}
+
+// IterateTotalSupply iterates over the total supply of every denomination in
+// ascending denomination order and calls the provided callback. Iteration
+// stops when the callback returns true.
+func (k Keeper) IterateTotalSupply(ctx sdk.Context, cb func(sdk.Coin) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, SupplyKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdk.Int
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &amount)
+
+		if cb(sdk.NewCoin(DenomFromSupplyStoreKey(iterator.Key()), amount)) {
+			break
+		}
+	}
+}
+
+// inflateSupply adds the given coins to the total supply, only touching the
+// affected denominations.
+func (k Keeper) inflateSupply(ctx sdk.Context, amt sdk.Coins) {
+	for _, coin := range amt {
+		k.SetSupplyOf(ctx, sdk.NewCoin(coin.Denom, k.GetSupplyOf(ctx, coin.Denom).Add(coin.Amount)))
+	}
+}
+
+// deflateSupply subtracts the given coins from the total supply, only touching
+// the affected denominations. It panics if the supply of a denomination would
+// become negative.
+func (k Keeper) deflateSupply(ctx sdk.Context, amt sdk.Coins) {
+	for _, coin := range amt {
+		total := k.GetSupplyOf(ctx, coin.Denom).Sub(coin.Amount)
+		if total.IsNegative() {
+			panic(fmt.Sprintf("negative total supply of %s: %s", coin.Denom, total))
+		}
+
+		k.SetSupplyOf(ctx, sdk.NewCoin(coin.Denom, total))
+	}
+}
+
+// MigrateSupplyStore migrates the legacy single-entry Supply record into the
+// per-denomination supply layout. It is a no-op if the legacy record does not
+// exist and is meant to be called from an upgrade handler.
+func (k Keeper) MigrateSupplyStore(ctx sdk.Context) {
 	store := ctx.KVStore(k.storeKey)
-	b := k.cdc.MustMarshalBinaryLengthPrefixed(supply)
-	store.Set(SupplyKey, b)
+	bz := store.Get(LegacySupplyKey)
+	if bz == nil {
+		return
+	}
+
+	var supply exported.SupplyI
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &supply)
+
+	for _, coin := range supply.GetTotal() {
+		k.SetSupplyOf(ctx, coin)
+	}
+
+	store.Delete(LegacySupplyKey)
 }
 
 // ValidatePermissions validates that the module account has been granted
@@ -71,3 +174,23 @@ func (k Keeper) ValidatePermissions(macc exported.ModuleAccountI) error {
 	}
 	return nil
 }
+
+// GetCirculatingSupply returns the total supply minus the coins held by module
+// accounts and the coins still locked in vesting accounts at the current block
+// time.
+func (k Keeper) GetCirculatingSupply(ctx sdk.Context) sdk.Coins {
+	var nonCirculating sdk.Coins
+	k.ak.IterateAccounts(ctx, func(acc authexported.Account) bool {
+		switch acc := acc.(type) {
+		case exported.ModuleAccountI:
+			nonCirculating = nonCirculating.Add(k.bk.GetAllBalances(ctx, acc.GetAddress())...)
+
+		case vestexported.VestingAccount:
+			nonCirculating = nonCirculating.Add(acc.LockedCoins(ctx.BlockTime())...)
+		}
+
+		return false
+	})
+
+	return k.GetSupply(ctx).GetTotal().Sub(nonCirculating)
+}
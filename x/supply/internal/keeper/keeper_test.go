@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	keep "github.com/cosmos/cosmos-sdk/x/supply/internal/keeper"
 	"github.com/cosmos/cosmos-sdk/x/supply/internal/types"
 )
 
@@ -22,6 +23,45 @@ func TestSupply(t *testing.T) {
 	require.Equal(t, totalSupply, total)
 }
 
+func TestSupplyOf(t *testing.T) {
+	app, ctx := createTestApp(false)
+	keeper := app.SupplyKeeper
+
+	totalSupply := sdk.NewCoins(sdk.NewInt64Coin("atom", 100), sdk.NewInt64Coin("btc", 21))
+	keeper.SetSupply(ctx, types.NewSupply(totalSupply))
+
+	require.Equal(t, sdk.NewInt(100), keeper.GetSupplyOf(ctx, "atom"))
+	require.Equal(t, sdk.NewInt(21), keeper.GetSupplyOf(ctx, "btc"))
+	require.Equal(t, sdk.ZeroInt(), keeper.GetSupplyOf(ctx, "eth"))
+
+	// a zero amount removes the denomination
+	keeper.SetSupplyOf(ctx, sdk.NewInt64Coin("btc", 0))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 100)), keeper.GetSupply(ctx).GetTotal())
+
+	// replacing the supply drops denominations that are no longer present
+	keeper.SetSupply(ctx, types.NewSupply(sdk.NewCoins(sdk.NewInt64Coin("eth", 7))))
+	require.Equal(t, sdk.ZeroInt(), keeper.GetSupplyOf(ctx, "atom"))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("eth", 7)), keeper.GetSupply(ctx).GetTotal())
+}
+
+func TestMigrateSupplyStore(t *testing.T) {
+	app, ctx := createTestApp(false)
+	keeper := app.SupplyKeeper
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+
+	// no legacy record is a no-op
+	keeper.MigrateSupplyStore(ctx)
+	require.True(t, keeper.GetSupply(ctx).GetTotal().Empty())
+
+	legacySupply := types.NewSupply(sdk.NewCoins(sdk.NewInt64Coin("atom", 100), sdk.NewInt64Coin("btc", 21)))
+	store.Set(keep.LegacySupplyKey, app.Codec().MustMarshalBinaryLengthPrefixed(legacySupply))
+
+	keeper.MigrateSupplyStore(ctx)
+	require.Nil(t, store.Get(keep.LegacySupplyKey))
+	require.Equal(t, legacySupply.GetTotal(), keeper.GetSupply(ctx).GetTotal())
+	require.Equal(t, sdk.NewInt(21), keeper.GetSupplyOf(ctx, "btc"))
+}
+
 func TestValidatePermissions(t *testing.T) {
 	app, _ := createTestApp(false)
 
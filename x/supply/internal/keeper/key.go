@@ -3,7 +3,21 @@ package keeper
 // Keys for supply store
 // Items are stored with the following key: values
 //
-// - 0x00: Supply
+// - 0x00: Supply (legacy, only read by MigrateSupplyStore)
+//
+// - 0x01<denom_bytes>: sdk.Int
 var (
-	SupplyKey = []byte{0x00}
+	LegacySupplyKey = []byte{0x00}
+	SupplyKeyPrefix = []byte{0x01}
 )
+
+// SupplyStoreKey returns the store key used to persist the total supply of
+// the given denomination.
+func SupplyStoreKey(denom string) []byte {
+	return append(SupplyKeyPrefix, []byte(denom)...)
+}
+
+// DenomFromSupplyStoreKey returns the denomination from a supply store key.
+func DenomFromSupplyStoreKey(key []byte) string {
+	return string(key[len(SupplyKeyPrefix):])
+}
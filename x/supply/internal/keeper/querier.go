@@ -3,7 +3,6 @@ package keeper
 import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
-	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/supply/internal/types"
@@ -20,6 +19,9 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case types.QuerySupplyOf:
 			return querySupplyOf(ctx, req, k)
 
+		case types.QueryCirculatingSupply:
+			return queryCirculatingSupply(ctx, req, k)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint: %s", types.ModuleName, path[0])
 		}
@@ -34,13 +36,24 @@ func queryTotalSupply(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte,
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
-	totalSupply := k.GetSupply(ctx).GetTotal()
+	totalSupply := sdk.Coins{}
+	if params.Page > 0 {
+		limit := params.Limit
+		if limit == 0 {
+			limit = 100
+		}
 
-	start, end := client.Paginate(len(totalSupply), params.Page, params.Limit, 100)
-	if start < 0 || end < 0 {
-		totalSupply = sdk.Coins{}
-	} else {
-		totalSupply = totalSupply[start:end]
+		// only load the requested page of denominations from store
+		start, end := (params.Page-1)*limit, params.Page*limit
+		i := 0
+		k.IterateTotalSupply(ctx, func(coin sdk.Coin) bool {
+			if i >= start {
+				totalSupply = append(totalSupply, coin)
+			}
+
+			i++
+			return i >= end
+		})
 	}
 
 	res, err := totalSupply.MarshalJSON()
@@ -59,7 +72,7 @@ func querySupplyOf(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, er
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
-	supply := k.GetSupply(ctx).GetTotal().AmountOf(params.Denom)
+	supply := k.GetSupplyOf(ctx, params.Denom)
 
 	res, err := supply.MarshalJSON()
 	if err != nil {
@@ -68,3 +81,27 @@ func querySupplyOf(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, er
 
 	return res, nil
 }
+
+func queryCirculatingSupply(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QuerySupplyOfParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	circulating := k.GetCirculatingSupply(ctx)
+
+	var res []byte
+	if params.Denom == "" {
+		res, err = circulating.MarshalJSON()
+	} else {
+		res, err = circulating.AmountOf(params.Denom).MarshalJSON()
+	}
+
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
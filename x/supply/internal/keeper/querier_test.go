@@ -3,11 +3,14 @@ package keeper_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
 	keep "github.com/cosmos/cosmos-sdk/x/supply/internal/keeper"
 	"github.com/cosmos/cosmos-sdk/x/supply/internal/types"
 )
@@ -110,3 +113,98 @@ func TestQuerySupply(t *testing.T) {
 	require.True(sdk.IntEq(t, sdk.NewInt(100), supply))
 
 }
+
+func TestQueryTotalSupplyPagination(t *testing.T) {
+	app, ctx := createTestApp(false)
+	keeper := app.SupplyKeeper
+	cdc := app.Codec()
+
+	supplyCoins := sdk.NewCoins(
+		sdk.NewCoin("atom", sdk.NewInt(2000)),
+		sdk.NewCoin("btc", sdk.NewInt(21000000)),
+		sdk.NewCoin("photon", sdk.NewInt(50)),
+		sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)),
+	)
+	keeper.SetSupply(ctx, types.NewSupply(supplyCoins))
+
+	querier := keep.NewQuerier(keeper)
+
+	testCases := []struct {
+		page, limit int
+		expected    sdk.Coins
+	}{
+		{1, 2, supplyCoins[:2]},
+		{2, 2, supplyCoins[2:]},
+		{2, 3, supplyCoins[3:]},
+		{3, 2, sdk.Coins(nil)},
+		{0, 2, sdk.Coins(nil)},
+		{1, 0, supplyCoins},
+	}
+
+	for i, tc := range testCases {
+		bz, err := cdc.MarshalJSON(types.NewQueryTotalSupplyParams(tc.page, tc.limit))
+		require.NoError(t, err)
+
+		res, err := querier(ctx, []string{types.QueryTotalSupply}, abci.RequestQuery{Data: bz})
+		require.NoError(t, err)
+
+		var totalCoins sdk.Coins
+		require.NoError(t, cdc.UnmarshalJSON(res, &totalCoins))
+		require.Equal(t, tc.expected, totalCoins, "test case #%d", i)
+	}
+}
+
+func TestQueryCirculatingSupply(t *testing.T) {
+	app, ctx := createTestApp(false)
+	keeper := app.SupplyKeeper
+	ak := app.AccountKeeper
+	bk := app.BankKeeper
+	cdc := app.Codec()
+
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0))
+
+	// the holder module account keeps 100 stake out of circulation
+	holderCoins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	keeper.SetModuleAccount(ctx, holderAcc)
+	require.NoError(t, bk.SetBalances(ctx, holderAcc.GetAddress(), holderCoins))
+
+	// the vesting account has half of its 200 stake still locked
+	vestingCoins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 200))
+	baseAcc := authtypes.NewBaseAccountWithAddress(sdk.AccAddress([]byte("vesting")))
+	vestingAcc := vesting.NewContinuousVestingAccount(&baseAcc, vestingCoins, 0, 2000)
+	ak.SetAccount(ctx, vestingAcc)
+	require.NoError(t, bk.SetBalances(ctx, vestingAcc.GetAddress(), vestingCoins))
+
+	// a regular account holds 50 stake and 10 photon
+	regularCoins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50), sdk.NewInt64Coin("photon", 10))
+	regularAcc := ak.NewAccountWithAddress(ctx, sdk.AccAddress([]byte("regular")))
+	ak.SetAccount(ctx, regularAcc)
+	require.NoError(t, bk.SetBalances(ctx, regularAcc.GetAddress(), regularCoins))
+
+	keeper.SetSupply(ctx, types.NewSupply(holderCoins.Add(vestingCoins...).Add(regularCoins...)))
+
+	expected := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 150), sdk.NewInt64Coin("photon", 10))
+	require.Equal(t, expected, keeper.GetCirculatingSupply(ctx))
+
+	querier := keep.NewQuerier(keeper)
+
+	bz, err := cdc.MarshalJSON(types.NewQuerySupplyOfParams(""))
+	require.NoError(t, err)
+
+	res, err := querier(ctx, []string{types.QueryCirculatingSupply}, abci.RequestQuery{Data: bz})
+	require.NoError(t, err)
+
+	var circulating sdk.Coins
+	require.NoError(t, cdc.UnmarshalJSON(res, &circulating))
+	require.Equal(t, expected, circulating)
+
+	bz, err = cdc.MarshalJSON(types.NewQuerySupplyOfParams(sdk.DefaultBondDenom))
+	require.NoError(t, err)
+
+	res, err = querier(ctx, []string{types.QueryCirculatingSupply}, abci.RequestQuery{Data: bz})
+	require.NoError(t, err)
+
+	var circulatingOf sdk.Int
+	require.NoError(t, circulatingOf.UnmarshalJSON(res))
+	require.True(sdk.IntEq(t, sdk.NewInt(150), circulatingOf))
+}
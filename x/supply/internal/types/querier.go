@@ -2,8 +2,9 @@ package types
 
 // query endpoints supported by the supply Querier
 const (
-	QueryTotalSupply = "total_supply"
-	QuerySupplyOf    = "supply_of"
+	QueryTotalSupply       = "total_supply"
+	QuerySupplyOf          = "supply_of"
+	QueryCirculatingSupply = "circulating_supply"
 )
 
 // QueryTotalSupply defines the params for the following queries:
@@ -21,6 +22,9 @@ func NewQueryTotalSupplyParams(page, limit int) QueryTotalSupplyParams {
 // QuerySupplyOfParams defines the params for the following queries:
 //
 // - 'custom/supply/totalSupplyOf'
+// - 'custom/supply/circulating_supply'
+//
+// An empty denomination queries the circulating supply of every denomination.
 type QuerySupplyOfParams struct {
 	Denom string
 }
@@ -7,18 +7,19 @@ import (
 	tmkv "github.com/tendermint/tendermint/libs/kv"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/supply/internal/keeper"
-	"github.com/cosmos/cosmos-sdk/x/supply/internal/types"
 )
 
 // DecodeStore unmarshals the KVPair's Value to the corresponding supply type
 func DecodeStore(cdc *codec.Codec, kvA, kvB tmkv.Pair) string {
 	switch {
-	case bytes.Equal(kvA.Key[:1], keeper.SupplyKey):
-		var supplyA, supplyB types.Supply
-		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &supplyA)
-		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &supplyB)
-		return fmt.Sprintf("%v\n%v", supplyB, supplyB)
+	case bytes.Equal(kvA.Key[:1], keeper.SupplyKeyPrefix):
+		var supplyA, supplyB sdk.Int
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &supplyA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &supplyB)
+		denom := keeper.DenomFromSupplyStoreKey(kvA.Key)
+		return fmt.Sprintf("%v\n%v", sdk.NewCoin(denom, supplyA), sdk.NewCoin(denom, supplyB))
 	default:
 		panic(fmt.Sprintf("invalid supply key %X", kvA.Key))
 	}
@@ -24,10 +24,10 @@ func makeTestCodec() (cdc *codec.Codec) {
 func TestDecodeStore(t *testing.T) {
 	cdc := makeTestCodec()
 
-	totalSupply := types.NewSupply(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)))
+	totalSupply := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
 
 	kvPairs := tmkv.Pairs{
-		tmkv.Pair{Key: keeper.SupplyKey, Value: cdc.MustMarshalBinaryLengthPrefixed(totalSupply)},
+		tmkv.Pair{Key: keeper.SupplyStoreKey(totalSupply.Denom), Value: cdc.MustMarshalBinaryBare(totalSupply.Amount)},
 		tmkv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
 	}
 
@@ -0,0 +1,32 @@
+package upgrade
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// UpgradeStoreLoader returns a store loader applying the given store upgrades
+// when the named upgrade is pending, and loading the stores as usual otherwise.
+//
+// The binary registering the handler of an upgrade cannot run before the
+// upgrade is due, so the upgrade is only pending when the stores are loaded for
+// the block executing it.
+func UpgradeStoreLoader(k Keeper, upgradeName string, storeUpgrades *storetypes.StoreUpgrades) baseapp.StoreLoader {
+	return func(ms sdk.CommitMultiStore) error {
+		if err := baseapp.DefaultStoreLoader(ms); err != nil {
+			return err
+		}
+
+		ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+		plan, found := k.GetUpgradePlan(ctx)
+		if !found || plan.Name != upgradeName || k.IsSkipHeight(ms.LastCommitID().Version+1) {
+			return nil
+		}
+
+		return ms.LoadLatestVersionAndUpgrade(storeUpgrades)
+	}
+}
@@ -0,0 +1,51 @@
+package upgrade_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
+)
+
+func TestUpgradeStoreLoader(t *testing.T) {
+	db := dbm.NewMemDB()
+	upgradeKey, addedKey := sdk.NewKVStoreKey(upgrade.StoreKey), sdk.NewKVStoreKey("added")
+	cdc := codec.New()
+	upgrade.RegisterCodec(cdc)
+	k := upgrade.NewKeeper(map[int64]bool{}, upgradeKey, cdc)
+
+	ms := rootmulti.NewStore(db)
+	ms.MountStoreWithDB(upgradeKey, sdk.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(ms, abci.Header{Height: 1}, false, log.NewNopLogger())
+	require.NoError(t, k.ScheduleUpgrade(ctx, upgrade.Plan{Name: "test", Height: 2}))
+	ms.Commit()
+
+	newStore := func() sdk.CommitMultiStore {
+		ms := rootmulti.NewStore(db)
+		ms.MountStoreWithDB(upgradeKey, sdk.StoreTypeIAVL, nil)
+		ms.MountStoreWithDB(addedKey, sdk.StoreTypeIAVL, nil)
+		return ms
+	}
+
+	// the store upgrades of other upgrades are not applied
+	existing := &storetypes.StoreUpgrades{Added: []string{upgrade.StoreKey}}
+	require.NoError(t, upgrade.UpgradeStoreLoader(k, "other", existing)(newStore()))
+
+	// the store upgrades of the pending upgrade are
+	require.Error(t, upgrade.UpgradeStoreLoader(k, "test", existing)(newStore()))
+
+	upgraded := newStore()
+	added := &storetypes.StoreUpgrades{Added: []string{"added"}}
+	require.NoError(t, upgrade.UpgradeStoreLoader(k, "test", added)(upgraded))
+	require.NotNil(t, upgraded.GetKVStore(addedKey))
+}